@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+const searchLimit = 10
+
+func dex(ctx context.Context, mdl *model.Model, w io.Writer, args []string) error {
+	pokemon, err := mdl.PokemonByName(ctx, args[0])
+	if err != nil {
+		return lookupMessage("pokemon", err)
+	}
+
+	data, err := pokemon.MarshalData(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting data for pokemon: %w", err)
+	}
+
+	fmt.Fprintf(w, "#%d %s\n", data.DexNumber, data.LocalizedName)
+	combo, err := pokemon.TypeCombo(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting type combo for pokemon: %w", err)
+	}
+
+	types, err := comboString(ctx, combo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Types: %s\n", types)
+
+	abilities := make([]string, len(data.Abilities))
+	for i, ability := range data.Abilities {
+		abilities[i] = ability.LocalizedName
+		if ability.IsHidden {
+			abilities[i] += " (hidden)"
+		}
+	}
+	if len(abilities) > 0 {
+		fmt.Fprintf(w, "Abilities: %s\n", strings.Join(abilities, ", "))
+	}
+
+	total := 0
+	for _, stat := range data.Stats {
+		fmt.Fprintf(w, "  %-16s %3d\n", stat.LocalizedName, stat.BaseStat)
+		total += stat.BaseStat
+	}
+	fmt.Fprintf(w, "  %-16s %3d\n", "Total", total)
+
+	return nil
+}
+
+func printEfficacies(ctx context.Context, w io.Writer, effs []model.TypeEfficacy, skip model.EfficacyLevel) error {
+	groups := make(map[model.EfficacyLevel][]string)
+	for _, eff := range effs {
+		if eff.EfficacyLevel() == skip {
+			continue
+		}
+
+		typ, err := eff.OpposingType(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting opposing type: %w", err)
+		}
+
+		name, err := typ.LocalizedName(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting localized name for type: %w", err)
+		}
+		groups[eff.EfficacyLevel()] = append(groups[eff.EfficacyLevel()], name)
+	}
+
+	levels := make([]model.EfficacyLevel, 0, len(groups))
+	for level := range groups {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i] > levels[j]
+	})
+
+	for _, level := range levels {
+		fmt.Fprintf(w, "%gx: %s\n", float64(level)/100, strings.Join(groups[level], ", "))
+	}
+
+	return nil
+}
+
+func weak(ctx context.Context, mdl *model.Model, w io.Writer, args []string) error {
+	if len(args) > 2 {
+		return fmt.Errorf("expected at most two types: %w", ErrUsage)
+	}
+
+	var name2 *string
+	if len(args) == 2 {
+		name2 = &args[1]
+	}
+
+	combo, err := mdl.TypeComboByNames(ctx, args[0], name2)
+	if err != nil {
+		return lookupMessage("type", err)
+	}
+
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting defending efficacies: %w", err)
+	}
+
+	name, err := comboString(ctx, combo)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Damage taken by %s\n", name)
+
+	return printEfficacies(ctx, w, effs, model.NormalEffective)
+}
+
+func coverage(ctx context.Context, mdl *model.Model, w io.Writer, args []string) error {
+	typ, err := mdl.TypeByName(ctx, args[0])
+	if err != nil {
+		return lookupMessage("type", err)
+	}
+
+	effs, err := typ.AttackingEfficacies(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting attacking efficacies: %w", err)
+	}
+
+	name, err := typ.LocalizedName(ctx)
+	if err != nil {
+		return fmt.Errorf("error while getting localized name for type: %w", err)
+	}
+	fmt.Fprintf(w, "Damage dealt by %s\n", name)
+
+	return printEfficacies(ctx, w, effs, model.NormalEffective)
+}
+
+func search(ctx context.Context, mdl *model.Model, w io.Writer, args []string) error {
+	results, err := mdl.SearchAll(ctx, args[0], searchLimit)
+	if err != nil && len(results) == 0 {
+		return fmt.Errorf("error while searching: %w", err)
+	}
+
+	for _, res := range results {
+		name, err := res.LocalizedName(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting localized name for search result: %w", err)
+		}
+		fmt.Fprintf(w, "%-8s %s (%s)\n", res.Category, name, res.Name)
+	}
+
+	if len(results) == 0 {
+		return errors.New("no results found")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+// explainWorkload exercises the model's hot paths: name lookups, prefix
+// searches, type efficacies and learnsets.
+func explainWorkload(mdl *model.Model) func(context.Context) error {
+	return func(ctx context.Context) error {
+		pokemon, err := mdl.PokemonByName(ctx, "pikachu")
+		if err != nil {
+			return fmt.Errorf("error while getting pokemon: %w", err)
+		}
+		_, err = pokemon.MarshalData(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting data for pokemon: %w", err)
+		}
+
+		_, err = mdl.SearchAll(ctx, "pi", searchLimit)
+		if err != nil {
+			return fmt.Errorf("error while searching: %w", err)
+		}
+
+		typ, err := mdl.TypeByName(ctx, "fire")
+		if err != nil {
+			return fmt.Errorf("error while getting type: %w", err)
+		}
+		_, err = typ.AttackingEfficacies(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting attacking efficacies: %w", err)
+		}
+
+		combo, err := pokemon.TypeCombo(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting type combo: %w", err)
+		}
+		_, err = combo.DefendingEfficacies(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting defending efficacies: %w", err)
+		}
+
+		move, err := mdl.MoveByName(ctx, "thunderbolt")
+		if err != nil {
+			return fmt.Errorf("error while getting move: %w", err)
+		}
+		_, err = move.MarshalData(ctx)
+		if err != nil {
+			return fmt.Errorf("error while getting data for move: %w", err)
+		}
+
+		methods, err := mdl.LearnMethodsByName(ctx, []model.LearnMethodName{model.LevelUp, model.Machine})
+		if err != nil {
+			return fmt.Errorf("error while getting learn methods: %w", err)
+		}
+		_, _, err = pokemon.SearchPokemonMoves(ctx, methods, nil, nil, searchLimit, 0)
+		if err != nil {
+			return fmt.Errorf("error while searching pokemon moves: %w", err)
+		}
+
+		_, _, err = mdl.SearchMovesFiltered(ctx, model.MoveFilters{Type: typ}, searchLimit, 0)
+		if err != nil {
+			return fmt.Errorf("error while searching moves: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func explain(ctx context.Context, mdl *model.Model, w io.Writer, _ []string) error {
+	plans, err := mdl.ExplainQueries(ctx, explainWorkload(mdl))
+	if err != nil {
+		return err
+	}
+
+	scanning := 0
+	for _, plan := range plans {
+		if len(plan.FullScans) == 0 {
+			continue
+		}
+
+		scanning++
+		fmt.Fprintf(w, "%s\n", plan.Query)
+		for _, scan := range plan.FullScans {
+			fmt.Fprintf(w, "  %s\n", scan)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "%d of %d queries scan full tables\n", scanning, len(plans))
+	if scanning > 0 {
+		return errors.New("found queries without index usage")
+	}
+
+	return nil
+}
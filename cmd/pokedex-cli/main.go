@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type settings struct {
+	dbPath   string
+	version  string
+	language string
+}
+
+type cliCommand struct {
+	usage string
+	args  int
+	run   func(context.Context, *model.Model, io.Writer, []string) error
+}
+
+var cliCommands = map[string]cliCommand{
+	"dex": {
+		usage: "dex <pokemon>",
+		args:  1,
+		run:   dex,
+	},
+	"weak": {
+		usage: "weak <type> [type]",
+		args:  1,
+		run:   weak,
+	},
+	"coverage": {
+		usage: "coverage <type>",
+		args:  1,
+		run:   coverage,
+	},
+	"search": {
+		usage: "search <prefix>",
+		args:  1,
+		run:   search,
+	},
+	"explain": {
+		usage: "explain",
+		run:   explain,
+	},
+}
+
+var ErrUsage = errors.New("invalid usage")
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: pokedex-cli <command> <arguments> [--db path] [--version name] [--lang code]")
+	fmt.Fprintln(w, "commands:")
+	for _, name := range []string{"dex", "weak", "coverage", "search", "explain"} {
+		fmt.Fprintf(w, "  %s\n", cliCommands[name].usage)
+	}
+}
+
+// parseArgs allows flags to appear before, between or after the positional
+// arguments, which the flag package does not support on its own.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func run(ctx context.Context, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	cmd, ok := cliCommands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q: %w", args[0], ErrUsage)
+	}
+
+	var s settings
+	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&s.dbPath, "db", "db.sqlite3", "path to the pokedex database")
+	fs.StringVar(&s.version, "version", model.VersionNameSword, "game version to pull data from")
+	fs.StringVar(&s.language, "lang", string(model.LocalizationCodeEnglish), "language code for names")
+
+	positional, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, ErrUsage)
+	}
+	if len(positional) < cmd.args {
+		return fmt.Errorf("expected %q: %w", cmd.usage, ErrUsage)
+	}
+
+	mdl, err := model.New(ctx, s.dbPath)
+	if err != nil {
+		return fmt.Errorf("error while creating model: %w", err)
+	}
+	defer mdl.Close()
+
+	err = mdl.SetLanguageByLocalizationCode(ctx, model.LocalizationCode(s.language))
+	if err != nil {
+		return fmt.Errorf("error while setting language: %w", err)
+	}
+
+	err = mdl.SetVersionByName(ctx, s.version)
+	if err != nil {
+		return fmt.Errorf("error while setting version: %w", err)
+	}
+
+	return cmd.run(ctx, mdl, w, positional)
+}
+
+func main() {
+	err := run(context.Background(), os.Args[1:], os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, ErrUsage) {
+			usage(os.Stderr)
+		}
+		os.Exit(1)
+	}
+}
+
+func comboString(ctx context.Context, combo *model.TypeCombo) (string, error) {
+	names := make([]string, 0, 3)
+	for _, typ := range combo.Types() {
+		name, err := typ.LocalizedName(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error while getting localized name for type: %w", err)
+		}
+		names = append(names, name)
+	}
+
+	return strings.Join(names, "/"), nil
+}
+
+func lookupMessage(resource string, err error) error {
+	if errors.Is(err, model.ErrWrongGeneration) {
+		return fmt.Errorf("the specified %s does not exist in this generation", resource)
+	}
+
+	return fmt.Errorf("no %s found with that name", resource)
+}
@@ -8,8 +8,10 @@ import (
 	"syscall"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/notjagan/pokedex/pkg/api"
 	"github.com/notjagan/pokedex/pkg/bot"
 	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/rpc"
 )
 
 func main() {
@@ -21,6 +23,34 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if cfg.HTTP.Enabled {
+		srv, err := api.New(ctx, *cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		go func() {
+			err := srv.Run(ctx)
+			if err != nil {
+				log.Printf("error while running http server: %v", err)
+			}
+		}()
+	}
+
+	if cfg.GRPC.Enabled {
+		srv, err := rpc.New(ctx, *cfg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		go func() {
+			err := srv.Run(ctx)
+			if err != nil {
+				log.Printf("error while running grpc server: %v", err)
+			}
+		}()
+	}
+
 	bot, err := bot.New(ctx, *cfg)
 	if err != nil {
 		log.Fatal(err)
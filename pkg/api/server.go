@@ -0,0 +1,297 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+const (
+	defaultVersion     = model.VersionNameSword
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+type Server struct {
+	config config.HTTPConfig
+	model  *model.Model
+	server *http.Server
+}
+
+func New(ctx context.Context, cfg config.Config) (*Server, error) {
+	mdl, err := model.New(ctx, cfg.DB.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating model for http server: %w", err)
+	}
+	mdl.LogSlowQueries(time.Duration(cfg.DB.SlowQueryThreshold) * time.Millisecond)
+	mdl.ConfigureCaches(model.CacheOptions{
+		Disabled:   cfg.DB.Cache.Disabled,
+		TTL:        time.Duration(cfg.DB.Cache.TTL) * time.Second,
+		MaxEntries: cfg.DB.Cache.MaxEntries,
+	})
+
+	srv := &Server{
+		config: cfg.HTTP,
+		model:  mdl,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/pokemon/", srv.handle(srv.pokemon))
+	mux.HandleFunc("/api/move/", srv.handle(srv.move))
+	mux.HandleFunc("/api/search", srv.handle(srv.search))
+
+	timeout := srv.timeout()
+	srv.server = &http.Server{
+		Addr:         cfg.HTTP.Address,
+		Handler:      mux,
+		ReadTimeout:  timeout,
+		WriteTimeout: 2 * timeout,
+	}
+
+	return srv, nil
+}
+
+func (srv *Server) timeout() time.Duration {
+	return time.Duration(srv.config.Timeout) * time.Millisecond
+}
+
+func (srv *Server) Close() {
+	err := srv.model.Close()
+	if err != nil {
+		log.Printf("error while closing http server model: %v", err)
+	}
+}
+
+func (srv *Server) Run(ctx context.Context) error {
+	defer srv.Close()
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("Serving HTTP API on %s.", srv.config.Address)
+		errs <- srv.server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("http server stopped unexpectedly: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), srv.timeout())
+	defer cancel()
+
+	err := srv.server.Shutdown(shutdownCtx)
+	if err != nil {
+		return fmt.Errorf("error while shutting down http server: %w", err)
+	}
+
+	return nil
+}
+
+type apiError struct {
+	status  int
+	message string
+	err     error
+}
+
+func (e *apiError) Error() string {
+	if e.err == nil {
+		return e.message
+	}
+	return fmt.Sprintf("%s: %v", e.message, e.err)
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+func badRequest(message string, err error) error {
+	return &apiError{status: http.StatusBadRequest, message: message, err: err}
+}
+
+func lookupError(resource string, err error) error {
+	switch {
+	case errors.Is(err, model.ErrWrongGeneration):
+		return &apiError{
+			status:  http.StatusNotFound,
+			message: fmt.Sprintf("%s does not exist in this generation", resource),
+			err:     err,
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		return &apiError{
+			status:  http.StatusNotFound,
+			message: fmt.Sprintf("no %s found with that name", resource),
+			err:     err,
+		}
+	default:
+		return err
+	}
+}
+
+type handlerFunc func(context.Context, *model.Model, *http.Request) (any, error)
+
+func (srv *Server) handle(f handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), srv.timeout())
+		defer cancel()
+
+		var body any
+		mdl, err := srv.requestModel(ctx, r)
+		if err == nil {
+			body, err = f(ctx, mdl, r)
+		}
+		if err == nil {
+			writeJSON(w, http.StatusOK, body)
+			return
+		}
+
+		var apiErr *apiError
+		switch {
+		case errors.As(err, &apiErr):
+			writeError(w, apiErr.status, apiErr.message)
+		case errors.Is(err, context.DeadlineExceeded):
+			writeError(w, http.StatusGatewayTimeout, "request timed out")
+		default:
+			log.Printf("error while handling api request %q: %v", r.URL.Path, err)
+			writeError(w, http.StatusInternalServerError, "internal server error")
+		}
+	}
+}
+
+func (srv *Server) requestModel(ctx context.Context, r *http.Request) (*model.Model, error) {
+	mdl := srv.model.Fork()
+	query := r.URL.Query()
+
+	code := model.LocalizationCodeEnglish
+	if lang := query.Get("lang"); lang != "" {
+		code = model.LocalizationCode(lang)
+	}
+	err := mdl.SetLanguageByLocalizationCode(ctx, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, badRequest(fmt.Sprintf("unknown language %q", code), err)
+	} else if err != nil {
+		return nil, fmt.Errorf("error while setting request language: %w", err)
+	}
+
+	version := defaultVersion
+	if v := query.Get("version"); v != "" {
+		version = v
+	}
+	err = mdl.SetVersionByName(ctx, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, badRequest(fmt.Sprintf("unknown version %q", version), err)
+	} else if err != nil {
+		return nil, fmt.Errorf("error while setting request version: %w", err)
+	}
+
+	return mdl, nil
+}
+
+func pathName(r *http.Request, prefix string) (string, error) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	if name == "" || strings.Contains(name, "/") {
+		return "", badRequest("expected a single resource name in the path", nil)
+	}
+
+	return strings.ToLower(name), nil
+}
+
+func (srv *Server) pokemon(ctx context.Context, mdl *model.Model, r *http.Request) (any, error) {
+	name, err := pathName(r, "/api/pokemon/")
+	if err != nil {
+		return nil, err
+	}
+
+	pokemon, err := mdl.PokemonByName(ctx, name)
+	if err != nil {
+		return nil, lookupError("pokemon", err)
+	}
+
+	return pokemon.MarshalData(ctx)
+}
+
+func (srv *Server) move(ctx context.Context, mdl *model.Model, r *http.Request) (any, error) {
+	name, err := pathName(r, "/api/move/")
+	if err != nil {
+		return nil, err
+	}
+
+	move, err := mdl.MoveByName(ctx, name)
+	if err != nil {
+		return nil, lookupError("move", err)
+	}
+
+	return move.MarshalData(ctx)
+}
+
+type searchResult struct {
+	Category      model.SearchCategory `json:"category"`
+	Name          string               `json:"name"`
+	LocalizedName string               `json:"localized_name"`
+}
+
+func (srv *Server) search(ctx context.Context, mdl *model.Model, r *http.Request) (any, error) {
+	query := r.URL.Query()
+	prefix := query.Get("q")
+	if prefix == "" {
+		return nil, badRequest("missing search query parameter \"q\"", nil)
+	}
+
+	limit := defaultSearchLimit
+	if l := query.Get("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 || limit > maxSearchLimit {
+			return nil, badRequest(fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit), err)
+		}
+	}
+
+	results, err := mdl.SearchAll(ctx, prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while searching: %w", err)
+	}
+
+	body := make([]searchResult, len(results))
+	for i, res := range results {
+		name, err := res.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for search result: %w", err)
+		}
+
+		body[i] = searchResult{
+			Category:      res.Category,
+			Name:          res.Name,
+			LocalizedName: name,
+		}
+	}
+
+	return body, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		log.Printf("error while writing api response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestRequestModelStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		closed bool
+		want   int
+	}{
+		{name: "defaults", query: "", want: http.StatusOK},
+		{name: "unknown language", query: "?lang=xx", want: http.StatusBadRequest},
+		{name: "unknown version", query: "?version=nonexistent", want: http.StatusBadRequest},
+		{name: "database error", query: "", closed: true, want: http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := &Server{
+				config: config.HTTPConfig{Timeout: 1000},
+				model:  modeltest.New(t),
+			}
+			if test.closed {
+				srv.Close()
+			}
+
+			target := "/api/pokemon/charizard" + test.query
+			w := httptest.NewRecorder()
+			srv.handle(srv.pokemon)(w, httptest.NewRequest(http.MethodGet, target, nil))
+			if w.Code != test.want {
+				t.Errorf("GET %s = %d: %s, want %d", target, w.Code, w.Body.String(), test.want)
+			}
+		})
+	}
+}
@@ -3,15 +3,19 @@ package bot
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/notjagan/pokedex/pkg/command"
 	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 type Bot struct {
@@ -20,41 +24,171 @@ type Bot struct {
 	commands map[string]command.Command
 	models   map[string]*model.Model
 	emojis   command.Emojis
+
+	// modelsMu guards models, which interaction handlers add to while the health
+	// server reads them
+	modelsMu sync.RWMutex
+
+	emojisLoaded atomic.Bool
+
+	// rate limiters are nil when rate limiting is disabled
+	commandLimiter      *rateLimiter
+	autocompleteLimiter *rateLimiter
+	// debouncer is nil when autocomplete debouncing is disabled
+	debouncer *debouncer
+	// store is nil when no user database is configured
+	store *store.UserStore
+
+	// handlers tracks interactions that are still being handled, so that
+	// shutdown can wait for them before closing the models they use
+	handlers       sync.WaitGroup
+	handlersMu     sync.Mutex
+	closing        bool
+	cancelHandlers context.CancelFunc
 }
 
+// shutdownTimeout bounds how long Close waits for in-flight interactions.
+const shutdownTimeout = 10 * time.Second
+
+// cancelTimeout bounds how long Close waits for interactions to return once
+// they have been cancelled.
+const cancelTimeout = 2 * time.Second
+
 func New(ctx context.Context, config config.Config) (*Bot, error) {
 	sess, err := discordgo.New("Bot " + config.Discord.Token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate discord bot: %w", err)
 	}
 
-	emojis := make(command.Emojis)
-	cmds, err := command.All(ctx, config, emojis)
+	var st *store.UserStore
+	if config.DB.UserPath != "" {
+		st, err = store.Open(ctx, config.DB.UserPath)
+		if err != nil {
+			return nil, fmt.Errorf("error while opening user store: %w", err)
+		}
+	}
+
+	emojis := command.NewEmojis()
+	cmds, err := command.All(ctx, config, emojis, st)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting all commands for bot: %w", err)
 	}
 
-	return &Bot{
+	bot := &Bot{
 		session:  sess,
 		config:   config,
 		commands: cmds,
 		models:   make(map[string]*model.Model),
 		emojis:   emojis,
-	}, nil
+		store:    st,
+	}
+	if config.RateLimit.Enabled {
+		bot.commandLimiter = newRateLimiter(config.RateLimit.Commands)
+		bot.autocompleteLimiter = newRateLimiter(config.RateLimit.Autocomplete)
+	}
+	if debounce := config.Discord.CommandConfig.AutocompleteDebounce; debounce > 0 {
+		bot.debouncer = newDebouncer(time.Duration(debounce) * time.Millisecond)
+	}
+
+	return bot, nil
 }
 
-func (bot *Bot) Close() {
+// Close shuts the bot down, returning a channel that emits each error hit while
+// closing the session, models and user store. The channel is closed once
+// shutdown finishes.
+func (bot *Bot) Close() <-chan error {
 	log.Println("Shutting down.")
-	for _, model := range bot.models {
+	// interactions that finish while draining can still add models, so errors
+	// are collected first and the channel is sized once they are all known
+	var errs []error
+
+	// closing the session stops new interactions from arriving, while responses
+	// to in-flight ones still go through the REST client
+	err := bot.session.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error while closing discord session: %w", err))
+	}
+	bot.drainHandlers()
+
+	bot.modelsMu.RLock()
+	for ID, model := range bot.models {
 		err := model.Close()
 		if err != nil {
-			log.Printf("error while closing model: %v", err)
+			errs = append(errs, fmt.Errorf("error while closing model for %q: %w", ID, err))
 		}
 	}
-	err := bot.session.Close()
-	if err != nil {
-		log.Printf("error while closing discord session: %v", err)
+	bot.modelsMu.RUnlock()
+	if bot.store != nil {
+		err := bot.store.Close()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error while closing user store: %w", err))
+		}
+	}
+
+	ch := make(chan error, len(errs))
+	for _, err := range errs {
+		ch <- err
+	}
+	close(ch)
+
+	return ch
+}
+
+// startHandler marks an interaction as in flight. It reports false once the bot
+// is shutting down, in which case the interaction should be dropped.
+func (bot *Bot) startHandler() bool {
+	bot.handlersMu.Lock()
+	defer bot.handlersMu.Unlock()
+
+	if bot.closing {
+		return false
 	}
+	bot.handlers.Add(1)
+
+	return true
+}
+
+// drainHandlers waits for in-flight interactions to finish, cancelling any that
+// are still running after the shutdown timeout and giving them a moment to
+// return so they do not run against closed models.
+func (bot *Bot) drainHandlers() {
+	bot.handlersMu.Lock()
+	bot.closing = true
+	bot.handlersMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		bot.handlers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		log.Printf("timed out after %v waiting for interactions to finish", shutdownTimeout)
+		if bot.cancelHandlers != nil {
+			bot.cancelHandlers()
+		}
+
+		select {
+		case <-done:
+		case <-time.After(cancelTimeout):
+			log.Printf("interactions still running %v after being cancelled", cancelTimeout)
+		}
+	}
+
+	if bot.cancelHandlers != nil {
+		bot.cancelHandlers()
+	}
+}
+
+// model returns the model for a guild or user, if one has been added.
+func (bot *Bot) model(ID string) (*model.Model, bool) {
+	bot.modelsMu.RLock()
+	defer bot.modelsMu.RUnlock()
+
+	mdl, ok := bot.models[ID]
+	return mdl, ok
 }
 
 func (bot *Bot) addModel(ctx context.Context, ID string, locale discordgo.Locale) (*model.Model, error) {
@@ -62,43 +196,102 @@ func (bot *Bot) addModel(ctx context.Context, ID string, locale discordgo.Locale
 	if err != nil {
 		return nil, fmt.Errorf("error while instantiating model: %w", err)
 	}
+	mdl.LogSlowQueries(time.Duration(bot.config.DB.SlowQueryThreshold) * time.Millisecond)
+	mdl.ConfigureCaches(model.CacheOptions{
+		Disabled:   bot.config.DB.Cache.Disabled,
+		TTL:        time.Duration(bot.config.DB.Cache.TTL) * time.Second,
+		MaxEntries: bot.config.DB.Cache.MaxEntries,
+	})
+	bot.modelsMu.Lock()
 	bot.models[ID] = mdl
+	bot.modelsMu.Unlock()
 
 	err = mdl.SetLanguageByLocale(ctx, locale)
 	if err != nil {
 		return nil, fmt.Errorf("error while setting language: %w", err)
 	}
 
-	err = mdl.SetVersionByName(ctx, string(model.VersionNameSword))
+	err = mdl.SetDefaultVersion(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while setting default version: %w", err)
 	}
 
+	if bot.store != nil {
+		err = bot.applySettings(ctx, ID, mdl)
+		if err != nil {
+			log.Printf("could not apply saved settings for %q: %v", ID, err)
+		}
+	}
+
 	return mdl, nil
 }
 
-var ErrNoMatchingModel = errors.New("no matching model")
+// applySettings restores the language and version saved for a guild or user.
+func (bot *Bot) applySettings(ctx context.Context, ID string, mdl *model.Model) error {
+	settings, err := bot.store.Settings(ctx, ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
 
-func (bot *Bot) initialize(ctx context.Context) error {
+	if settings.Language != nil {
+		err := mdl.SetLanguageByLocalizationCode(ctx, model.LocalizationCode(*settings.Language))
+		if err != nil {
+			return fmt.Errorf("error while setting saved language: %w", err)
+		}
+	}
+
+	if settings.Version != nil {
+		err := mdl.SetVersionByName(ctx, *settings.Version)
+		if err != nil {
+			return fmt.Errorf("error while setting saved version: %w", err)
+		}
+	}
+
+	return nil
+}
+
+var (
+	ErrNoMatchingModel = errors.New("no matching model")
+	ErrUncleanShutdown = errors.New("bot did not shut down cleanly")
+)
+
+// initialize connects the bot and registers its commands. Interactions are
+// handled with handlerCtx rather than ctx, so that ones already in flight when
+// ctx is cancelled can still finish during shutdown.
+func (bot *Bot) initialize(ctx context.Context, handlerCtx context.Context) error {
 	err := bot.session.Open()
 	if err != nil {
 		return fmt.Errorf("failed to start discord session: %w", err)
 	}
 
-	connected := make(chan error)
+	connected := make(chan error, 1)
+	var once sync.Once
 
 	bot.session.AddHandler(func(_ *discordgo.Session, create *discordgo.GuildCreate) {
-		_, err := bot.addModel(ctx, create.Guild.ID, discordgo.Locale(create.PreferredLocale))
-		if err != nil {
-			log.Printf("failed to add guild %q: %v", create.Guild.Name, err)
-			return
+		// guilds are sent again after the gateway reconnects, so keep existing models
+		// rather than resetting their language and version
+		if _, ok := bot.model(create.Guild.ID); !ok {
+			_, err := bot.addModel(ctx, create.Guild.ID, discordgo.Locale(create.PreferredLocale))
+			if err != nil {
+				log.Printf("failed to add guild %q: %v", create.Guild.Name, err)
+				return
+			}
 		}
 
 		if create.Guild.ID == bot.config.Discord.CommandConfig.ResourceGuildID {
-			connected <- err
-			for _, emoji := range create.Guild.Emojis {
-				bot.emojis[emoji.Name] = emoji
-			}
+			bot.loadEmojis(create.Guild.Emojis)
+			once.Do(func() {
+				connected <- nil
+			})
+		}
+	})
+
+	bot.session.AddHandler(func(_ *discordgo.Session, update *discordgo.GuildEmojisUpdate) {
+		if update.GuildID == bot.config.Discord.CommandConfig.ResourceGuildID {
+			log.Println("Reloading emojis from resource guild.")
+			bot.loadEmojis(update.Emojis)
 		}
 	})
 
@@ -111,6 +304,8 @@ func (bot *Bot) initialize(ctx context.Context) error {
 		return fmt.Errorf("timeout while connecting to resource server")
 	}
 
+	bot.handleInteractions(handlerCtx)
+
 	err = bot.registerCommands(ctx)
 	if err != nil {
 		return fmt.Errorf("error while registering commands: %w", err)
@@ -121,42 +316,94 @@ func (bot *Bot) initialize(ctx context.Context) error {
 		return fmt.Errorf("error while unregistering removed commands: %w", err)
 	}
 
+	bot.session.AddHandler(func(_ *discordgo.Session, _ *discordgo.Ready) {
+		bot.verifyCommands(ctx)
+	})
+	bot.session.AddHandler(func(_ *discordgo.Session, _ *discordgo.Resumed) {
+		bot.verifyCommands(ctx)
+	})
+
 	return nil
 }
 
 func (bot *Bot) Run(ctx context.Context) error {
-	err := bot.initialize(ctx)
+	if bot.config.Health.Enabled {
+		go func() {
+			err := bot.serveHealth(ctx)
+			if err != nil {
+				log.Printf("error while running health server: %v", err)
+			}
+		}()
+	}
+
+	var handlerCtx context.Context
+	handlerCtx, bot.cancelHandlers = context.WithCancel(context.Background())
+	defer bot.cancelHandlers()
+
+	err := bot.initialize(ctx, handlerCtx)
 	if err != nil {
 		return fmt.Errorf("error while initializing bot: %w", err)
 	}
 
 	log.Println("Hosting Pokedex bot.")
-	defer bot.Close()
 	<-ctx.Done()
 
+	failures := 0
+	for err := range bot.Close() {
+		log.Println(err)
+		failures++
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d errors while closing bot: %w", failures, ErrUncleanShutdown)
+	}
+
 	return nil
 }
 
-func (bot *Bot) registerCommands(ctx context.Context) error {
+func (bot *Bot) loadEmojis(emojis []*discordgo.Emoji) {
+	byKey := make(map[string]*discordgo.Emoji, len(emojis))
+	for _, emoji := range emojis {
+		key, ok := command.EmojiKey(emoji.Name, bot.config.Discord.CommandConfig.Emojis)
+		if !ok {
+			continue
+		}
+		byKey[key] = emoji
+	}
+	bot.emojis.Store(byKey)
+
+	bot.emojisLoaded.Store(true)
+}
+
+func (bot *Bot) handleInteractions(ctx context.Context) {
 	bot.session.AddHandler(func(sess *discordgo.Session, interaction *discordgo.InteractionCreate) {
+		if !bot.startHandler() {
+			return
+		}
+		defer bot.handlers.Done()
+
 		var mdl *model.Model
+		var userID string
 		switch {
 		case interaction.Member != nil:
+			if interaction.Member.User != nil {
+				userID = interaction.Member.User.ID
+			}
 			guild, err := sess.State.Guild(interaction.GuildID)
 			if err != nil {
 				log.Printf("could not find guild while handling interaction: %v", err)
 				return
 			}
 			var ok bool
-			mdl, ok = bot.models[guild.ID]
+			mdl, ok = bot.model(guild.ID)
 			if !ok {
 				log.Printf("no model found for guild %q while handling interaction: %v", guild.Name, ErrNoMatchingModel)
 				return
 			}
 		case interaction.User != nil:
 			user := interaction.User
+			userID = user.ID
 			var ok bool
-			mdl, ok = bot.models[user.ID]
+			mdl, ok = bot.model(user.ID)
 			if !ok {
 				var err error
 				mdl, err = bot.addModel(ctx, user.ID, discordgo.Locale(user.Locale))
@@ -181,6 +428,10 @@ func (bot *Bot) registerCommands(ctx context.Context) error {
 
 			switch interaction.Type {
 			case discordgo.InteractionApplicationCommand:
+				if !bot.allow(bot.commandLimiter, userID) {
+					bot.rateLimited(sess, interaction)
+					return
+				}
 				log.Printf("Handling command %q.", cmd.Name())
 				err := cmd.Handle(ctx, mdl, sess, interaction)
 				if err != nil {
@@ -188,8 +439,32 @@ func (bot *Bot) registerCommands(ctx context.Context) error {
 				}
 				return
 			case discordgo.InteractionApplicationCommandAutocomplete:
+				// excess autocomplete requests are dropped, since the next keystroke
+				// will send another
+				if !bot.allow(bot.autocompleteLimiter, userID) {
+					return
+				}
+				ctx := ctx
+				if bot.debouncer != nil {
+					key := debounceKey{
+						userID:  userID,
+						command: data.Name,
+						option:  focusedOption(data.Options),
+					}
+					var done func()
+					var latest bool
+					ctx, done, latest = bot.debouncer.wait(ctx, key)
+					if !latest {
+						return
+					}
+					defer done()
+				}
 				err := cmd.Autocomplete(ctx, mdl, sess, interaction)
-				if err != nil {
+				if err != nil && ctx.Err() != nil {
+					// superseded by a newer request for the same option, which can
+					// surface as an interrupted query rather than context.Canceled
+					return
+				} else if err != nil {
 					log.Printf("error while generating autocompletions for command %q: %v", cmd.Name(), err)
 				}
 				return
@@ -200,6 +475,10 @@ func (bot *Bot) registerCommands(ctx context.Context) error {
 			data := interaction.MessageComponentData()
 			switch data.ComponentType {
 			case discordgo.ButtonComponent:
+				if !bot.allow(bot.commandLimiter, userID) {
+					bot.rateLimited(sess, interaction)
+					return
+				}
 				reader := bytes.NewReader([]byte(data.CustomID))
 				followUp, err := command.ButtonFollowUp(reader)
 				if err != nil {
@@ -228,10 +507,56 @@ func (bot *Bot) registerCommands(ctx context.Context) error {
 			default:
 				log.Println("unrecognized component type for message interaction")
 			}
+		case discordgo.InteractionModalSubmit:
+			if !bot.allow(bot.commandLimiter, userID) {
+				bot.rateLimited(sess, interaction)
+				return
+			}
+			reader := bytes.NewReader([]byte(interaction.ModalSubmitData().CustomID))
+			name, err := command.ButtonFollowUp(reader)
+			if err != nil || name == nil {
+				log.Printf("could not read command for modal: %v", err)
+				return
+			}
+			cmd, ok := bot.commands[*name]
+			if !ok {
+				log.Printf("unrecognized command %q", *name)
+				return
+			}
+
+			err = cmd.Modal(ctx, mdl, sess, interaction, reader)
+			if err != nil {
+				log.Printf("error while handling modal for command %q: %v", cmd.Name(), err)
+			}
+			return
 		default:
 			log.Printf("unrecognized interaction type %s", interaction.Type.String())
 		}
 	})
+}
+
+func (bot *Bot) allow(limiter *rateLimiter, userID string) bool {
+	return limiter == nil || limiter.allow(userID)
+}
+
+func (bot *Bot) rateLimited(sess *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	err := sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "You're sending commands too quickly, try again in a moment.",
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		log.Printf("error while responding to rate limited interaction: %v", err)
+	}
+}
+
+// registerCommands overwrites the registered commands with the built ones, but
+// only if they differ, since overwriting re-creates every command. If the
+// registered commands cannot be fetched, they are overwritten regardless.
+func (bot *Bot) registerCommands(ctx context.Context) error {
+	appID := bot.session.State.User.ID
 
 	cmds := make([]*discordgo.ApplicationCommand, len(bot.commands))
 	i := 0
@@ -241,7 +566,15 @@ func (bot *Bot) registerCommands(ctx context.Context) error {
 		i++
 	}
 
-	_, err := bot.session.ApplicationCommandBulkOverwrite(bot.session.State.User.ID, "", cmds)
+	registered, err := bot.session.ApplicationCommands(appID, "")
+	if err != nil {
+		log.Printf("failed to get registered commands, overwriting them: %v", err)
+	} else if !commandsChanged(registered, cmds) {
+		log.Println("Registered commands are up to date.")
+		return nil
+	}
+
+	_, err = bot.session.ApplicationCommandBulkOverwrite(appID, "", cmds)
 	if err != nil {
 		return fmt.Errorf("failed to create commands: %w", err)
 	}
@@ -268,3 +601,34 @@ func (bot *Bot) unregisterRemovedCommands(ctx context.Context) error {
 
 	return nil
 }
+
+// verifyCommands re-registers commands after a reconnect if any are missing from
+// discord.
+func (bot *Bot) verifyCommands(ctx context.Context) {
+	registered, err := bot.session.ApplicationCommands(bot.session.State.User.ID, "")
+	if err != nil {
+		log.Printf("failed to get registered commands after reconnect: %v", err)
+		return
+	}
+
+	names := make(map[string]bool, len(registered))
+	for _, cmd := range registered {
+		names[cmd.Name] = true
+	}
+
+	for name := range bot.commands {
+		if !names[name] {
+			log.Printf("Command %q missing after reconnect, re-registering commands.", name)
+			err := bot.registerCommands(ctx)
+			if err != nil {
+				log.Printf("error while re-registering commands: %v", err)
+			}
+			break
+		}
+	}
+
+	err = bot.unregisterRemovedCommands(ctx)
+	if err != nil {
+		log.Printf("error while unregistering removed commands after reconnect: %v", err)
+	}
+}
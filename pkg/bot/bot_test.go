@@ -0,0 +1,63 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestCloseModelsAddedWhileDraining(t *testing.T) {
+	ctx := context.Background()
+	bot := &Bot{session: &discordgo.Session{}, models: map[string]*model.Model{}}
+	if !bot.startHandler() {
+		t.Fatal("startHandler refused an interaction before shutdown")
+	}
+
+	added := make([]*model.Model, 3)
+	for i := range added {
+		added[i] = modeltest.New(t)
+	}
+	go func() {
+		// wait for Close to start draining, as an interaction still in flight
+		// would, before adding models to the bot
+		for {
+			bot.handlersMu.Lock()
+			closing := bot.closing
+			bot.handlersMu.Unlock()
+			if closing {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		bot.modelsMu.Lock()
+		for i, mdl := range added {
+			bot.models[fmt.Sprint(i)] = mdl
+		}
+		bot.modelsMu.Unlock()
+		bot.handlers.Done()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		for err := range bot.Close() {
+			t.Errorf("Close: %v", err)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(shutdownTimeout):
+		t.Fatal("Close did not finish")
+	}
+
+	for i, mdl := range added {
+		if mdl.Ping(ctx) == nil {
+			t.Errorf("model %d added while draining was not closed", i)
+		}
+	}
+}
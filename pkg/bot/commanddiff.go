@@ -0,0 +1,121 @@
+package bot
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commandShape is the part of an application command that registration
+// changes, normalized so that a command read back from discord compares equal
+// to the one that was registered. Discord fills in defaults and returns numbers
+// as floats. Options and choices are shown in the order they are registered, so
+// reordering them is a change.
+type commandShape struct {
+	Type        discordgo.ApplicationCommandType
+	Name        string
+	Description string
+	Options     []optionShape
+}
+
+type optionShape struct {
+	Type         discordgo.ApplicationCommandOptionType
+	Name         string
+	Description  string
+	Required     bool
+	Autocomplete bool
+	Choices      []choiceShape
+	Options      []optionShape
+	MinValue     *float64
+	MaxValue     float64
+	MinLength    int
+	MaxLength    int
+	ChannelTypes []discordgo.ChannelType
+}
+
+type choiceShape struct {
+	Name  string
+	Value string
+}
+
+func newCommandShape(cmd *discordgo.ApplicationCommand) commandShape {
+	typ := cmd.Type
+	if typ == 0 {
+		typ = discordgo.ChatApplicationCommand
+	}
+
+	return commandShape{
+		Type:        typ,
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		Options:     newOptionShapes(cmd.Options),
+	}
+}
+
+func newOptionShapes(opts []*discordgo.ApplicationCommandOption) []optionShape {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	shapes := make([]optionShape, len(opts))
+	for i, opt := range opts {
+		shape := optionShape{
+			Type:         opt.Type,
+			Name:         opt.Name,
+			Description:  opt.Description,
+			Required:     opt.Required,
+			Autocomplete: opt.Autocomplete,
+			Options:      newOptionShapes(opt.Options),
+			MinValue:     opt.MinValue,
+			MaxValue:     opt.MaxValue,
+			MaxLength:    opt.MaxLength,
+		}
+		if opt.MinLength != nil {
+			shape.MinLength = *opt.MinLength
+		}
+		if len(opt.ChannelTypes) > 0 {
+			shape.ChannelTypes = make([]discordgo.ChannelType, len(opt.ChannelTypes))
+			copy(shape.ChannelTypes, opt.ChannelTypes)
+			sort.Slice(shape.ChannelTypes, func(i, j int) bool {
+				return shape.ChannelTypes[i] < shape.ChannelTypes[j]
+			})
+		}
+
+		// choice values are decoded from discord as strings or float64s, so
+		// they are compared by their formatted value
+		for _, choice := range opt.Choices {
+			shape.Choices = append(shape.Choices, choiceShape{
+				Name:  choice.Name,
+				Value: fmt.Sprint(choice.Value),
+			})
+		}
+
+		shapes[i] = shape
+	}
+
+	return shapes
+}
+
+// commandsChanged reports whether the registered commands differ from the built
+// ones, including any command only present on one side.
+func commandsChanged(registered []*discordgo.ApplicationCommand, built []*discordgo.ApplicationCommand) bool {
+	if len(registered) != len(built) {
+		return true
+	}
+
+	shapes := make(map[string]commandShape, len(registered))
+	for _, cmd := range registered {
+		shapes[cmd.Name] = newCommandShape(cmd)
+	}
+
+	for _, cmd := range built {
+		shape, ok := shapes[cmd.Name]
+		if !ok || !reflect.DeepEqual(shape, newCommandShape(cmd)) {
+			return true
+		}
+	}
+
+	return false
+}
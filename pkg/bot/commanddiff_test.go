@@ -0,0 +1,109 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func testCommand(options ...*discordgo.ApplicationCommandOption) *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        "dex",
+		Description: "Look up a pokemon",
+		Options:     options,
+	}
+}
+
+func TestCommandsChanged(t *testing.T) {
+	name := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "name",
+		Description: "Name of the pokemon",
+		Required:    true,
+	}
+	level := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        "level",
+		Description: "Level of the pokemon",
+		MaxValue:    100,
+	}
+	choices := func(names ...string) *discordgo.ApplicationCommandOption {
+		opt := &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionString,
+			Name:        "order",
+			Description: "Order of the results",
+		}
+		for _, name := range names {
+			opt.Choices = append(opt.Choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+		}
+		return opt
+	}
+	channels := func(types ...discordgo.ChannelType) *discordgo.ApplicationCommandOption {
+		return &discordgo.ApplicationCommandOption{
+			Type:         discordgo.ApplicationCommandOptionChannel,
+			Name:         "channel",
+			Description:  "Channel to post in",
+			ChannelTypes: types,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		registered *discordgo.ApplicationCommand
+		built      *discordgo.ApplicationCommand
+		want       bool
+	}{
+		{
+			name:       "same",
+			registered: testCommand(name, level),
+			built:      testCommand(name, level),
+			want:       false,
+		},
+		{
+			name: "discord defaults",
+			registered: &discordgo.ApplicationCommand{
+				Type:        discordgo.ChatApplicationCommand,
+				Name:        "dex",
+				Description: "Look up a pokemon",
+				Options:     []*discordgo.ApplicationCommandOption{name, level},
+			},
+			built: testCommand(name, level),
+			want:  false,
+		},
+		{
+			name:       "reordered options",
+			registered: testCommand(level, name),
+			built:      testCommand(name, level),
+			want:       true,
+		},
+		{
+			name:       "reordered choices",
+			registered: testCommand(choices("dex", "name")),
+			built:      testCommand(choices("name", "dex")),
+			want:       true,
+		},
+		{
+			name:       "reordered channel types",
+			registered: testCommand(channels(discordgo.ChannelTypeGuildNews, discordgo.ChannelTypeGuildText)),
+			built:      testCommand(channels(discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildNews)),
+			want:       false,
+		},
+		{
+			name:       "added option",
+			registered: testCommand(name),
+			built:      testCommand(name, level),
+			want:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changed := commandsChanged(
+				[]*discordgo.ApplicationCommand{test.registered},
+				[]*discordgo.ApplicationCommand{test.built},
+			)
+			if changed != test.want {
+				t.Errorf("commandsChanged = %t, want %t", changed, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package bot
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+type debounceKey struct {
+	userID  string
+	command string
+	option  string
+}
+
+type debounceEntry struct {
+	id     uint64
+	cancel context.CancelFunc
+}
+
+// debouncer lets only the latest of a burst of autocomplete requests for the same
+// user, command and option run, canceling any earlier request still waiting or in
+// flight.
+type debouncer struct {
+	mu      sync.Mutex
+	delay   time.Duration
+	nextID  uint64
+	pending map[debounceKey]debounceEntry
+}
+
+func newDebouncer(delay time.Duration) *debouncer {
+	return &debouncer{
+		delay:   delay,
+		pending: make(map[debounceKey]debounceEntry),
+	}
+}
+
+// wait cancels any earlier request with the same key and waits out the debounce
+// delay. The returned context is canceled if a newer request arrives, and done
+// must be called once the request has been handled.
+func (d *debouncer) wait(ctx context.Context, key debounceKey) (context.Context, func(), bool) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	d.mu.Lock()
+	if entry, ok := d.pending[key]; ok {
+		entry.cancel()
+	}
+	d.nextID++
+	id := d.nextID
+	d.pending[key] = debounceEntry{id: id, cancel: cancel}
+	d.mu.Unlock()
+
+	done := func() {
+		cancel()
+		d.mu.Lock()
+		if entry, ok := d.pending[key]; ok && entry.id == id {
+			delete(d.pending, key)
+		}
+		d.mu.Unlock()
+	}
+
+	select {
+	case <-time.After(d.delay):
+		return ctx, done, true
+	case <-ctx.Done():
+		done()
+		return ctx, done, false
+	}
+}
+
+// focusedOption finds the name of the option being autocompleted, including
+// options nested in subcommands and subcommand groups.
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	for _, opt := range options {
+		if opt.Focused {
+			return opt.Name
+		}
+		if name := focusedOption(opt.Options); name != "" {
+			return opt.Name + " " + name
+		}
+	}
+
+	return ""
+}
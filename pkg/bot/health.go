@@ -0,0 +1,132 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+const healthTimeout = 2 * time.Second
+
+type healthStatus struct {
+	Session  bool `json:"session"`
+	Emojis   bool `json:"emojis"`
+	Database bool `json:"database"`
+	// Caches totals the lookups in each cache across the guild and user models
+	Caches map[string]model.CacheStats `json:"caches"`
+}
+
+func (status healthStatus) ready() bool {
+	return status.Session && status.Emojis && status.Database
+}
+
+func (bot *Bot) healthStatus(ctx context.Context, mdl *model.Model) healthStatus {
+	bot.session.RLock()
+	connected := bot.session.DataReady
+	bot.session.RUnlock()
+
+	err := mdl.Ping(ctx)
+	if err != nil {
+		log.Printf("health check failed to ping database: %v", err)
+	}
+
+	return healthStatus{
+		Session:  connected,
+		Emojis:   bot.emojisLoaded.Load(),
+		Database: err == nil,
+		Caches:   bot.cacheStats(),
+	}
+}
+
+// cacheStats sums the stats of each cache over every guild and user model.
+func (bot *Bot) cacheStats() map[string]model.CacheStats {
+	bot.modelsMu.RLock()
+	defer bot.modelsMu.RUnlock()
+
+	total := make(map[string]model.CacheStats)
+	for _, mdl := range bot.models {
+		for name, stats := range mdl.CacheStats() {
+			sum := total[name]
+			sum.Hits += stats.Hits
+			sum.Misses += stats.Misses
+			sum.Entries += stats.Entries
+			total[name] = sum
+		}
+	}
+
+	return total
+}
+
+// serveHealth reports liveness on /healthz, which only requires the database to
+// be reachable, and readiness on /readyz, which also requires the discord
+// session to be connected and the resource guild emojis to be loaded.
+func (bot *Bot) serveHealth(ctx context.Context) error {
+	mdl, err := model.New(ctx, bot.config.DB.Path)
+	if err != nil {
+		return fmt.Errorf("error while creating model for health server: %w", err)
+	}
+	defer func() {
+		err := mdl.Close()
+		if err != nil {
+			log.Printf("error while closing health server model: %v", err)
+		}
+	}()
+
+	handler := func(healthy func(healthStatus) bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithTimeout(r.Context(), healthTimeout)
+			defer cancel()
+
+			status := bot.healthStatus(ctx, mdl)
+			code := http.StatusOK
+			if !healthy(status) {
+				code = http.StatusServiceUnavailable
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			err := json.NewEncoder(w).Encode(status)
+			if err != nil {
+				log.Printf("error while writing health response: %v", err)
+			}
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handler(func(status healthStatus) bool { return status.Database }))
+	mux.HandleFunc("/readyz", handler(healthStatus.ready))
+
+	server := &http.Server{
+		Addr:              bot.config.Health.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: healthTimeout,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("Serving health checks on %s.", bot.config.Health.Address)
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("health server stopped unexpectedly: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+	defer cancel()
+
+	err = server.Shutdown(shutdownCtx)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error while shutting down health server: %w", err)
+	}
+
+	return nil
+}
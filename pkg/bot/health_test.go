@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestCacheStats(t *testing.T) {
+	ctx := context.Background()
+	guild := modeltest.New(t)
+	user := modeltest.New(t)
+	bot := &Bot{models: map[string]*model.Model{"guild": guild, "user": user}}
+
+	lookup := func(mdl *model.Model, name string) {
+		t.Helper()
+		pokemon, err := mdl.PokemonByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = pokemon.LocalizedName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	lookup(guild, "charmander")
+	lookup(guild, "charmander")
+	lookup(user, "charizard")
+
+	var want model.CacheStats
+	for _, mdl := range []*model.Model{guild, user} {
+		stats := mdl.CacheStats()["names"]
+		want.Hits += stats.Hits
+		want.Misses += stats.Misses
+		want.Entries += stats.Entries
+	}
+	if want.Hits == 0 || want.Entries < 2 {
+		t.Fatalf("lookups did not use the names cache: %+v", want)
+	}
+
+	stats := bot.cacheStats()
+	if stats["names"] != want {
+		t.Errorf("names cache stats = %+v, want %+v", stats["names"], want)
+	}
+	if len(stats) != len(guild.CacheStats()) {
+		t.Errorf("cacheStats reported %d caches, want %d", len(stats), len(guild.CacheStats()))
+	}
+}
@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/notjagan/pokedex/pkg/config"
+)
+
+// rateLimitSweep is how often idle buckets are dropped from a rate limiter.
+const rateLimitSweep = 10 * time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a per-user token bucket. Each user starts with a full bucket of
+// burst tokens, which refills at rate tokens per second.
+type rateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(cfg config.RateLimit) *rateLimiter {
+	return &rateLimiter{
+		rate:      cfg.Rate,
+		burst:     float64(cfg.Burst),
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// allow takes a token from the user's bucket, reporting whether one was
+// available.
+func (rl *rateLimiter) allow(userID string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(rl.lastSweep) > rateLimitSweep {
+		rl.sweep(now)
+	}
+
+	b, ok := rl.buckets[userID]
+	if !ok {
+		b = &bucket{tokens: rl.burst, last: now}
+		rl.buckets[userID] = b
+	} else {
+		b.tokens = rl.refill(b, now)
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
+
+func (rl *rateLimiter) refill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + now.Sub(b.last).Seconds()*rl.rate
+	if tokens > rl.burst {
+		return rl.burst
+	}
+
+	return tokens
+}
+
+// sweep drops buckets that have refilled completely, since they are no different
+// from a new bucket.
+func (rl *rateLimiter) sweep(now time.Time) {
+	for userID, b := range rl.buckets {
+		if rl.refill(b, now) >= rl.burst {
+			delete(rl.buckets, userID)
+		}
+	}
+	rl.lastSweep = now
+}
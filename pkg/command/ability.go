@@ -0,0 +1,197 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type abilityOptions struct {
+	Pokemon *struct {
+		AbilityName discordField[string] `option:"ability"`
+		HiddenOnly  *bool                `option:"hidden_only"`
+	} `option:"pokemon"`
+}
+
+type abilityResponder struct {
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	commands           commands
+	pagination         config.PaginationConfig
+}
+
+func (resp abilityResponder) Paginate(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	p paginator[abilityOptions],
+) (*discordgo.InteractionResponseData, error) {
+	if p.Options.Pokemon == nil {
+		return nil, fmt.Errorf("unrecognized subcommand for command \"ability\": %w", ErrCommandFormat)
+	}
+	opt := p.Options.Pokemon
+	hiddenOnly := opt.HiddenOnly != nil && *opt.HiddenOnly
+
+	ability, err := mdl.AbilityByName(ctx, opt.AbilityName.Value)
+	if err != nil {
+		return abilityNotFound(ctx, mdl, opt.AbilityName.Value, err)
+	}
+
+	abilityName, err := ability.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for ability %q: %w", ability.Name, err)
+	}
+
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	gen, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+	if hiddenOnly && !gen.HasHiddenAbilities() {
+		hiddenGen, err := mdl.GenerationByID(ctx, model.HiddenAbilityGeneration)
+		if err != nil {
+			return nil, fmt.Errorf("could not get generation %d: %w", model.HiddenAbilityGeneration, err)
+		}
+		hiddenGenName, err := hiddenGen.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for generation %d: %w", hiddenGen.ID, err)
+		}
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Hidden abilities are not available in Pokemon %s, only from %s.", version, hiddenGenName),
+		}, nil
+	}
+
+	holders, hasNext, err := mdl.PokemonByAbility(ctx, ability, hiddenOnly, p.Page.Limit, p.Page.Offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(holders) == 0 && p.Page.Offset == 0 {
+		have := "have"
+		if hiddenOnly {
+			have = "have as a hidden ability"
+		}
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("No Pokemon can %s %s in Pokemon %s.", have, abilityName, version),
+		}, nil
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, len(holders))
+	for i, holder := range holders {
+		name, err := holder.LocalizedFormName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", holder.Name, err)
+		}
+
+		value := "Regular ability"
+		if holder.IsHidden {
+			value = "Hidden ability"
+		}
+
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("#%d %s", holder.SpeciesID, name),
+			Value:  value,
+			Inline: true,
+		}
+	}
+
+	descriptions := []string{fmt.Sprintf("Pokemon %s", version)}
+	if hiddenOnly {
+		descriptions = append(descriptions, "Hidden ability only")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("Pokemon with %s", abilityName),
+		Description: strings.Join(descriptions, "\n"),
+		Fields:      fields,
+	}
+
+	buttons, err := p.moveButtons(hasNext, resp.commands, resp.pagination, interaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pagination buttons: %w", err)
+	}
+	var components []discordgo.MessageComponent
+	if buttons != nil {
+		components = []discordgo.MessageComponent{buttons}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}, nil
+}
+
+func (resp abilityResponder) Initial() Page {
+	return Page{
+		Offset: 0,
+		Limit:  resp.queryLimit,
+	}
+}
+
+func (resp abilityResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *abilityOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Pokemon != nil && opt.Pokemon.AbilityName.Focused:
+		s := abilitySearcher{
+			model:  mdl,
+			prefix: opt.Pokemon.AbilityName.Value,
+			limit:  resp.autocompleteLimits.Ability,
+		}
+		return searchChoices[*model.Ability](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) ability(ctx context.Context) (Command, error) {
+	resp := abilityResponder{
+		queryLimit:         builder.config.MoveLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		commands:           builder.commands,
+		pagination:         builder.config.Pagination,
+	}
+
+	return command[abilityOptions]{
+		pager:         resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "ability",
+			Description: "Look up Pokemon by ability.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "pokemon",
+					Description: "Pokemon that can have an ability",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "ability",
+							Description:  "Name of the ability",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "hidden_only",
+							Description: "Only list Pokemon that have it as a hidden ability",
+							Required:    false,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type aboutOptions struct{}
+
+type aboutResponder struct{}
+
+func (resp aboutResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *aboutOptions,
+) (*discordgo.InteractionResponseData, error) {
+	if mdl.Version == nil {
+		return nil, fmt.Errorf("could not get current generation: %w", model.ErrUnsetVersion)
+	}
+	if mdl.Language == nil {
+		return nil, fmt.Errorf("could not get current language: %w", model.ErrUnsetLanguage)
+	}
+
+	language, err := mdl.Language.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current language name: %w", err)
+	}
+
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	current, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+	currentName, err := current.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", current.ID, err)
+	}
+
+	latest, err := mdl.LatestGeneration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest generation: %w", err)
+	}
+	latestName, err := latest.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", latest.ID, err)
+	}
+
+	counts, err := current.Counts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not count data for current generation: %w", err)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title: "About this Pokedex",
+				Fields: []*discordgo.MessageEmbedField{
+					{
+						Name:   "Language",
+						Value:  language,
+						Inline: true,
+					},
+					{
+						Name:   "Version",
+						Value:  fmt.Sprintf("Pokemon %s (%s)", version, currentName),
+						Inline: true,
+					},
+					{
+						Name:   "Latest Data",
+						Value:  latestName,
+						Inline: true,
+					},
+					{
+						Name:   "Species",
+						Value:  fmt.Sprintf("%d", counts.Species),
+						Inline: true,
+					},
+					{
+						Name:   "Moves",
+						Value:  fmt.Sprintf("%d", counts.Moves),
+						Inline: true,
+					},
+					{
+						Name:   "Types",
+						Value:  fmt.Sprintf("%d", counts.Types),
+						Inline: true,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (builder *Builder) about(ctx context.Context) (Command, error) {
+	return command[aboutOptions]{
+		handler: aboutResponder{},
+		command: discordgo.ApplicationCommand{
+			Name:        "about",
+			Description: "Show the game version, language and data the Pokedex is using.",
+		},
+	}, nil
+}
@@ -0,0 +1,362 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type analysisTab string
+
+const (
+	analysisTabDefensive analysisTab = "defensive"
+	analysisTabOffensive analysisTab = "offensive"
+	analysisTabStats     analysisTab = "stats"
+	analysisTabLearnset  analysisTab = "learnset"
+)
+
+var analysisTabs = []struct {
+	tab   analysisTab
+	label string
+}{
+	{analysisTabDefensive, "Defensive"},
+	{analysisTabOffensive, "Offensive"},
+	{analysisTabStats, "Base Stats"},
+	{analysisTabLearnset, "Learnset"},
+}
+
+type analysisOptions struct {
+	PokemonName discordField[string] `option:"pokemon"`
+	Tab         *string              `option:"tab"`
+}
+
+func (opt analysisOptions) tab() analysisTab {
+	if opt.Tab == nil {
+		return analysisTabDefensive
+	}
+
+	return analysisTab(*opt.Tab)
+}
+
+// analysisResponder shows one tab of a combined analysis of a pokemon, with
+// buttons to switch between tabs. The active tab is part of the paginator state
+// in each button's custom ID, so switching tabs edits the message in place.
+type analysisResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+	commands           commands
+	pagination         config.PaginationConfig
+
+	weak      weakResponder
+	baseStats baseStatsResponder
+	learnset  learnsetResponder
+}
+
+func (resp analysisResponder) Paginate(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	p paginator[analysisOptions],
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, p.Options.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, p.Options.PokemonName.Value, err)
+	}
+
+	var embed *discordgo.MessageEmbed
+	switch p.Options.tab() {
+	case analysisTabDefensive:
+		data, err := resp.weak.Handle(ctx, mdl, sess, interaction, &weakOptions{
+			Pokemon: &weakPokemonOptions{Name: p.Options.PokemonName},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not create defensive tab: %w", err)
+		}
+		embed = data.Embeds[0]
+	case analysisTabOffensive:
+		embed, err = resp.stabCoverage(ctx, mdl, pokemon)
+		if err != nil {
+			return nil, fmt.Errorf("could not create offensive tab: %w", err)
+		}
+	case analysisTabStats:
+		data, err := resp.baseStats.Handle(ctx, mdl, sess, interaction, &baseStatsOptions{
+			PokemonName: p.Options.PokemonName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not create base stats tab: %w", err)
+		}
+		embed = data.Embeds[0]
+	case analysisTabLearnset:
+		embed, err = resp.learnsetSummary(ctx, mdl, pokemon)
+		if err != nil {
+			return nil, fmt.Errorf("could not create learnset tab: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown analysis tab %q: %w", *p.Options.Tab, ErrCommandFormat)
+	}
+
+	// every tab shares the sprite attached to the original message, since
+	// editing the message keeps its attachments
+	sprite, err := pokemonSpriteFile(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
+	}
+	embed.Thumbnail = &discordgo.MessageEmbedThumbnail{
+		URL: fmt.Sprintf("attachment://%s", sprite.Name),
+	}
+
+	buttons, err := resp.tabButtons(p, interaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate tab buttons: %w", err)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: []discordgo.MessageComponent{buttons},
+		Files: []*discordgo.File{
+			sprite,
+		},
+	}, nil
+}
+
+// tabButtons has a button for each tab, with the active one highlighted and
+// disabled.
+func (resp analysisResponder) tabButtons(
+	p paginator[analysisOptions],
+	interaction *discordgo.InteractionCreate,
+) (*discordgo.ActionsRow, error) {
+	cmd, err := optionCommand[analysisOptions](resp.commands)
+	if err != nil {
+		return nil, fmt.Errorf("could not find command in registry: %w", err)
+	}
+	userID := interactionUserID(interaction)
+
+	components := make([]discordgo.MessageComponent, 0, len(analysisTabs)+1)
+	for _, t := range analysisTabs {
+		tab := string(t.tab)
+		next := paginator[analysisOptions]{
+			Options: analysisOptions{
+				PokemonName: p.Options.PokemonName,
+				Tab:         &tab,
+			},
+			Page: p.Page,
+		}
+		id, err := customID(next, cmd.Name(), userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create button for tab %q: %w", tab, err)
+		}
+
+		button := discordgo.Button{
+			Label:    t.label,
+			Style:    discordgo.SecondaryButton,
+			CustomID: id,
+		}
+		if t.tab == p.Options.tab() {
+			button.Style = discordgo.PrimaryButton
+			button.Disabled = true
+		}
+		components = append(components, button)
+	}
+
+	if resp.pagination.CloseButton {
+		closeID, err := customID(closer{}, cmd.Name(), userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create close button: %w", err)
+		}
+		closeButton := paginationButton(resp.pagination.Close, closeID, false)
+		closeButton.Style = discordgo.DangerButton
+		components = append(components, closeButton)
+	}
+
+	return &discordgo.ActionsRow{
+		Components: components,
+	}, nil
+}
+
+// stabCoverage is the offensive type chart for attacks sharing the pokemon's
+// types.
+func (resp analysisResponder) stabCoverage(
+	ctx context.Context,
+	mdl *model.Model,
+	pokemon *model.Pokemon,
+) (*discordgo.MessageEmbed, error) {
+	name, err := pokemon.LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
+	}
+
+	combo, err := pokemon.TypeCombo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get type combo for pokemon: %w", err)
+	}
+
+	types := combo.Types()
+
+	titleStrings := []string{name}
+	for _, typ := range types {
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+		titleStrings = append(titleStrings, emoji)
+	}
+
+	effs, err := mdl.BestAttackingEfficacies(ctx, types)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting combined efficacies: %w", err)
+	}
+
+	fields, err := efficaciesToFields(ctx, effs, true, efficacyFilterAll, efficacyNames{
+		strong:  "Super Effective (by at least one)",
+		neutral: "Neutral (at best)",
+		weak:    "Resists All",
+		immune:  "Immune to All",
+	}, resp.emojis)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode type efficacies: %w", err)
+	}
+
+	description, err := withExcludedTypesNote(ctx, mdl, "Offensive type chart for same-type attacks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       strings.Join(titleStrings, " "),
+		Description: description,
+		Fields:      fields,
+	}, nil
+}
+
+// learnsetSummary counts the moves the pokemon learns by each method, along
+// with its strongest level-up moves.
+func (resp analysisResponder) learnsetSummary(
+	ctx context.Context,
+	mdl *model.Model,
+	pokemon *model.Pokemon,
+) (*discordgo.MessageEmbed, error) {
+	name, err := pokemon.LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
+	}
+
+	methodNames := []model.LearnMethodName{model.LevelUp, model.Egg, model.Tutor, model.Machine}
+	methods, err := mdl.LearnMethodsByName(ctx, methodNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learn methods: %w", err)
+	}
+
+	// moves are counted one method at a time, since a search across methods
+	// lists each move only once
+	fields := make([]*discordgo.MessageEmbedField, 0, len(methods))
+	for _, method := range methods {
+		pms, _, err := pokemon.SearchPokemonMoves(ctx, []*model.LearnMethod{method}, nil, nil, maxLearnsetMoves, 0)
+		if err != nil {
+			return nil, fmt.Errorf("could not get moves for pokemon %q: %w", pokemon.Name, err)
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   learnMethodLabel(method),
+			Value:  fmt.Sprintf("%d", len(pms)),
+			Inline: true,
+		})
+	}
+
+	// the best moves are from level-up moves only, the first of the methods
+	best, err := resp.learnset.bestMoves(ctx, mdl, pokemon, methods[:1], paginator[learnsetOptions]{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       name,
+		Description: fmt.Sprintf("Learnset summary\n%s", best),
+		Fields:      fields,
+	}, nil
+}
+
+func (resp analysisResponder) Initial() Page {
+	return Page{}
+}
+
+func (resp analysisResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *analysisOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) analysis(ctx context.Context) (Command, error) {
+	resp := analysisResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+		commands:           builder.commands,
+		pagination:         builder.config.Pagination,
+		weak: weakResponder{
+			emojis: builder.emojis,
+		},
+		baseStats: baseStatsResponder{
+			level: builder.metadata.MaxLevel,
+		},
+		learnset: learnsetResponder{
+			emojis: builder.emojis,
+		},
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(analysisTabs))
+	for i, t := range analysisTabs {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  t.label,
+			Value: string(t.tab),
+		}
+	}
+
+	return command[analysisOptions]{
+		pager:         resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "analysis",
+			Description: "Defensive and offensive type charts, base stats and learnset of a Pokemon, in tabs.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "tab",
+					Description: "Tab to open first",
+					Required:    false,
+					Choices:     choices,
+				},
+			},
+		},
+	}, nil
+}
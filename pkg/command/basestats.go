@@ -0,0 +1,151 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type baseStatsOptions struct {
+	PokemonName discordField[string] `option:"pokemon"`
+}
+
+type baseStatsResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	level              int
+}
+
+func (resp baseStatsResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *baseStatsOptions,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, opt.PokemonName.Value, err)
+	}
+
+	name, err := pokemon.LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
+	}
+
+	is, err := mdl.IntrinsicStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting all intrinsic stats: %w", err)
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, 0, len(is)+1)
+	total := 0
+	for _, stat := range is {
+		bs, err := pokemon.BaseStat(ctx, stat)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+		}
+		total += bs
+
+		sr, err := pokemon.StatRange(ctx, stat, resp.level)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting stat range for pokemon: %w", err)
+		}
+
+		statName, err := stat.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for stat: %w", err)
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   statName,
+			Value:  fmt.Sprintf("**%d**\n%d–%d", bs, sr.Min, sr.Max),
+			Inline: true,
+		})
+	}
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:  "Total",
+		Value: fmt.Sprintf("**%d**", total),
+	})
+
+	sprite, err := pokemonSpriteFile(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       name,
+		Description: fmt.Sprintf("Base stats, with the range of each stat at Lv. %d.", resp.level),
+		Fields:      fields,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: fmt.Sprintf("attachment://%s", sprite.Name),
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf(
+				"Minimum: 0 IVs, 0 EVs, hindering nature. Maximum: %d IVs, %d EVs, boosting nature.",
+				model.MaxIV,
+				model.MaxEV,
+			),
+		},
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+		Files: []*discordgo.File{
+			sprite,
+		},
+	}, nil
+}
+
+func (resp baseStatsResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *baseStatsOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) baseStats(ctx context.Context) (Command, error) {
+	resp := baseStatsResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		level:              builder.metadata.MaxLevel,
+	}
+
+	return command[baseStatsOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "basestats",
+			Description: "Base stats of a Pokemon and how low or high each stat can get.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
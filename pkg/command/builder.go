@@ -6,12 +6,15 @@ import (
 
 	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 type commands map[string]Command
 
 type Builder struct {
 	model *model.Model
+	// store is nil when no user database is configured
+	store *store.UserStore
 
 	config   config.CommandConfig
 	metadata config.PokemonMetadata
@@ -20,7 +23,13 @@ type Builder struct {
 	commands commands
 }
 
-func NewBuilder(ctx context.Context, mdl *model.Model, cfg config.Config, emojis Emojis) *Builder {
+func NewBuilder(
+	ctx context.Context,
+	mdl *model.Model,
+	st *store.UserStore,
+	cfg config.Config,
+	emojis Emojis,
+) *Builder {
 	mdl.SetLanguageByLocalizationCode(ctx, model.LocalizationCodeEnglish)
 	funcs := []func(*Builder, context.Context) (Command, error){
 		(*Builder).language,
@@ -30,9 +39,32 @@ func NewBuilder(ctx context.Context, mdl *model.Model, cfg config.Config, emojis
 		(*Builder).weak,
 		(*Builder).coverage,
 		(*Builder).dex,
+		(*Builder).export,
+		(*Builder).showdown,
+		(*Builder).team,
+		(*Builder).shiny,
+		(*Builder).tutors,
+		(*Builder).form,
+		(*Builder).generations,
+		(*Builder).versions,
+		(*Builder).compare,
+		(*Builder).baseStats,
+		(*Builder).moveSearch,
+		(*Builder).setup,
+		(*Builder).about,
+		(*Builder).canLearn,
+		(*Builder).analysis,
+		(*Builder).typeInfo,
+		(*Builder).whoCanLearn,
+		(*Builder).ability,
+		(*Builder).surprise,
+	}
+	if st != nil {
+		funcs = append(funcs, (*Builder).favorite)
 	}
 	return &Builder{
 		model:    mdl,
+		store:    st,
 		config:   cfg.Discord.CommandConfig,
 		metadata: cfg.Pokemon.Metadata,
 		funcs:    funcs,
@@ -62,13 +94,15 @@ func (builder *Builder) all(ctx context.Context) (commands, error) {
 	return builder.commands, nil
 }
 
-func All(ctx context.Context, cfg config.Config, emojis Emojis) (commands, error) {
+// All builds every command. Commands that save user data are only included when
+// a user store is given.
+func All(ctx context.Context, cfg config.Config, emojis Emojis, st *store.UserStore) (commands, error) {
 	mdl, err := model.New(ctx, cfg.DB.Path)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating model for command builder: %w", err)
 	}
 
-	builder := NewBuilder(ctx, mdl, cfg, emojis)
+	builder := NewBuilder(ctx, mdl, st, cfg, emojis)
 	defer builder.Close(ctx)
 
 	return builder.all(ctx)
@@ -0,0 +1,233 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type canLearnOptions struct {
+	PokemonName discordField[string] `option:"pokemon"`
+	MoveName    discordField[string] `option:"move"`
+	PreEvos     *bool                `option:"pre_evolutions"`
+}
+
+type canLearnResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+}
+
+// learnedBy describes how a pokemon move is learned, including the level for
+// level-up moves.
+func learnedBy(ctx context.Context, pm *model.PokemonMove) (string, error) {
+	method, err := pm.LearnMethod(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get learn method for move %q: %w", pm.Name, err)
+	}
+
+	if method.Name == string(model.LevelUp) {
+		return fmt.Sprintf("%s at Lv. %d", learnMethodLabel(method), pm.Level), nil
+	}
+
+	return learnMethodLabel(method), nil
+}
+
+func (resp canLearnResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *canLearnOptions,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, opt.PokemonName.Value, err)
+	}
+
+	move, err := mdl.MoveByName(ctx, opt.MoveName.Value)
+	if err != nil {
+		return moveNotFound(ctx, mdl, opt.MoveName.Value, err)
+	}
+
+	pokemonName, err := pokemon.LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	moveName, err := move.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for move %q: %w", move.Name, err)
+	}
+
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	pms, err := mdl.CanLearn(ctx, pokemon, move)
+	if err != nil {
+		return nil, err
+	}
+
+	// pre-evolutions are checked unless turned off, since moves they learn can be
+	// kept after evolving
+	var lms []model.LineMove
+	if opt.PreEvos == nil || *opt.PreEvos {
+		lms, err = mdl.PreEvolutionsCanLearn(ctx, pokemon, move)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(pms) == 0 && len(lms) == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("%s cannot learn %s in Pokemon %s.", pokemonName, moveName, version),
+		}, nil
+	}
+
+	var description string
+	if len(pms) > 0 {
+		lines := make([]string, len(pms))
+		for i := range pms {
+			label, err := learnedBy(ctx, &pms[i])
+			if err != nil {
+				return nil, err
+			}
+			lines[i] = fmt.Sprintf("• %s", label)
+		}
+		description = fmt.Sprintf("Learned in Pokemon %s by:\n%s", version, strings.Join(lines, "\n"))
+	} else {
+		description = fmt.Sprintf("Not learned directly in Pokemon %s, but can be learned before evolving.", version)
+	}
+
+	fields, err := preEvolutionFields(ctx, lms)
+	if err != nil {
+		return nil, err
+	}
+
+	sprite, err := pokemonSpriteFile(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       fmt.Sprintf("%s ▸ %s", pokemonName, moveName),
+				Description: description,
+				Fields:      fields,
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: fmt.Sprintf("attachment://%s", sprite.Name),
+				},
+			},
+		},
+		Files: []*discordgo.File{
+			sprite,
+		},
+	}, nil
+}
+
+// preEvolutionFields lists the ways each pre-evolution learns a move, with one
+// field per stage.
+func preEvolutionFields(ctx context.Context, lms []model.LineMove) ([]*discordgo.MessageEmbedField, error) {
+	var fields []*discordgo.MessageEmbedField
+	var field *discordgo.MessageEmbedField
+	stageID := 0
+	for i := range lms {
+		lm := &lms[i]
+		if field == nil || lm.StageID != stageID {
+			stageName, err := lm.Stage().LocalizedName(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", lm.StageName, err)
+			}
+			field = &discordgo.MessageEmbedField{
+				Name: fmt.Sprintf("As %s", stageName),
+			}
+			fields = append(fields, field)
+			stageID = lm.StageID
+		} else {
+			field.Value += "\n"
+		}
+
+		label, err := learnedBy(ctx, &lm.PokemonMove)
+		if err != nil {
+			return nil, err
+		}
+		field.Value += fmt.Sprintf("• %s", label)
+	}
+
+	return fields, nil
+}
+
+func (resp canLearnResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *canLearnOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	case opt.MoveName.Focused:
+		s := moveSearcher{
+			model:  mdl,
+			prefix: opt.MoveName.Value,
+			limit:  resp.autocompleteLimits.Move,
+		}
+		return searchChoices[*model.Move](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) canLearn(ctx context.Context) (Command, error) {
+	resp := canLearnResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+	}
+
+	return command[canLearnOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "canlearn",
+			Description: "Check whether a Pokemon can learn a move, and how.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "move",
+					Description:  "Name of the move",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "pre_evolutions",
+					Description: "Also check the Pokemon's pre-evolutions (on by default)",
+					Required:    false,
+				},
+			},
+		},
+	}, nil
+}
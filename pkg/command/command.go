@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"unicode/utf8"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/notjagan/pokedex/pkg/model"
@@ -25,6 +26,7 @@ type (
 		Handle(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate) error
 		Autocomplete(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate) error
 		Button(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate, io.Reader) error
+		Modal(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate, io.Reader) error
 		Name() string
 	}
 
@@ -40,6 +42,10 @@ type (
 		Options T
 		Page    Page
 	}
+	paste[T options] struct {
+		Options T
+	}
+	closer struct{}
 
 	handler[T options] interface {
 		Handle(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate, *T) (*discordgo.InteractionResponseData, error)
@@ -51,11 +57,16 @@ type (
 		Paginate(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate, paginator[T]) (*discordgo.InteractionResponseData, error)
 		Initial() Page
 	}
+	// paster handles text submitted through a modal opened with pasteModal.
+	paster[T options] interface {
+		Paste(context.Context, *model.Model, *discordgo.Session, *discordgo.InteractionCreate, *T, string) (*discordgo.InteractionResponseData, error)
+	}
 
 	command[T options] struct {
 		handler       handler[T]
 		autocompleter autocompleter[T]
 		pager         pager[T]
+		paster        paster[T]
 
 		command discordgo.ApplicationCommand
 	}
@@ -69,12 +80,33 @@ func (followUp[T]) Name() byte {
 	return 'f'
 }
 
-func customID(a action, cmdName string) (string, error) {
+func (closer) Name() byte {
+	return 'x'
+}
+
+func (paste[T]) Name() byte {
+	return 'm'
+}
+
+// maxCustomIDLength is the most characters discord accepts in a custom ID.
+const maxCustomIDLength = 100
+
+var ErrCustomIDTooLong = errors.New("custom id too long")
+
+// customID encodes a button action for a command, along with the ID of the user
+// allowed to press the button. Actions whose state does not fit in a custom ID
+// are rejected with ErrCustomIDTooLong.
+func customID(a action, cmdName string, userID string) (string, error) {
 	cmdData, err := marshal(cmdName)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal follow-up command: %w", err)
 	}
 
+	ownerData, err := marshal(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal button owner: %w", err)
+	}
+
 	actionData, err := marshal(a)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal button data: %w", err)
@@ -83,7 +115,32 @@ func customID(a action, cmdName string) (string, error) {
 	var uuid [4]byte
 	rand.Reader.Read(uuid[:])
 
-	return cmdData + string(a.Name()) + actionData + string(uuid[:]), nil
+	id := cmdData + ownerData + string(a.Name()) + actionData + string(uuid[:])
+	// bytes that are not valid UTF-8 are each sent as a single replacement
+	// character, so counting runes matches discord's count
+	length := utf8.RuneCountInString(id)
+	if length > maxCustomIDLength {
+		return "", fmt.Errorf("custom id for command %q has %d characters: %w", cmdName, length, ErrCustomIDTooLong)
+	}
+
+	return id, nil
+}
+
+// respondCustomIDTooLong tells the user that a response could not be sent
+// because the state for its buttons was too long for discord.
+func respondCustomIDTooLong(sess *discordgo.Session, interaction *discordgo.InteractionCreate) error {
+	err := sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: "Those options are too long to fit in the reply's buttons, try shorter or fewer ones.",
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to respond to interaction with too long a custom id: %w", err)
+	}
+
+	return nil
 }
 
 func ButtonFollowUp(reader io.Reader) (*string, error) {
@@ -129,14 +186,19 @@ func optionCommand[T options](cmds commands) (*command[T], error) {
 	return &c, nil
 }
 
-func followUpButton[T options](cmds commands, opt T, button discordgo.Button) (*discordgo.Button, error) {
+func followUpButton[T options](
+	cmds commands,
+	opt T,
+	button discordgo.Button,
+	interaction *discordgo.InteractionCreate,
+) (*discordgo.Button, error) {
 	c, err := optionCommand[T](cmds)
 	if err != nil {
 		return nil, fmt.Errorf("could not find matching command: %w", err)
 	}
 
 	name := c.Name()
-	id, err := customID(followUp[T]{opt}, name)
+	id, err := customID(followUp[T]{opt}, name, interactionUserID(interaction))
 	if err != nil {
 		return nil, fmt.Errorf("could not create custom id for follow-up button: %w", err)
 	}
@@ -145,6 +207,61 @@ func followUpButton[T options](cmds commands, opt T, button discordgo.Button) (*
 	return &button, nil
 }
 
+// pasteInputID identifies the text input of a paste modal.
+const pasteInputID = "paste"
+
+// pasteModal creates a response that opens a modal for pasting text, which is
+// passed to the paster of the command with the options, and only accepted from
+// the user of the interaction. A response with a custom ID is sent as a modal
+// rather than a message.
+func pasteModal[T options](
+	cmds commands,
+	opt T,
+	title string,
+	input discordgo.TextInput,
+	interaction *discordgo.InteractionCreate,
+) (*discordgo.InteractionResponseData, error) {
+	c, err := optionCommand[T](cmds)
+	if err != nil {
+		return nil, fmt.Errorf("could not find matching command: %w", err)
+	}
+
+	id, err := customID(paste[T]{opt}, c.Name(), interactionUserID(interaction))
+	if err != nil {
+		return nil, fmt.Errorf("could not create custom id for paste modal: %w", err)
+	}
+	input.CustomID = pasteInputID
+	input.Style = discordgo.TextInputParagraph
+
+	return &discordgo.InteractionResponseData{
+		CustomID: id,
+		Title:    title,
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{input},
+			},
+		},
+	}, nil
+}
+
+// pastedText finds the value of the paste input in a submitted modal.
+func pastedText(data discordgo.ModalSubmitInteractionData) (string, bool) {
+	for _, component := range data.Components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range row.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if ok && input.CustomID == pasteInputID {
+				return input.Value, true
+			}
+		}
+	}
+
+	return "", false
+}
+
 func (cmd command[T]) responseBody(
 	ctx context.Context,
 	mdl *model.Model,
@@ -172,6 +289,7 @@ func (cmd command[T]) responseBody(
 	default:
 		return nil, fmt.Errorf("no handler for command %q: %w", cmd.Name(), ErrUnrecognizedInteraction)
 	}
+	body.Embeds = limitEmbeds(body.Embeds)
 
 	return body, nil
 }
@@ -191,12 +309,22 @@ func (cmd command[T]) Handle(
 	}
 
 	body, err := cmd.responseBody(ctx, mdl, sess, interaction, structure)
+	if errors.Is(err, ErrCustomIDTooLong) {
+		respErr := respondCustomIDTooLong(sess, interaction)
+		if respErr != nil {
+			return respErr
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("could not handle command %q: %w", cmd.Name(), err)
 	}
 
+	typ := discordgo.InteractionResponseChannelMessageWithSource
+	if body.CustomID != "" {
+		typ = discordgo.InteractionResponseModal
+	}
 	err = sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Type: typ,
 		Data: body,
 	})
 	if err != nil {
@@ -206,6 +334,67 @@ func (cmd command[T]) Handle(
 	return nil
 }
 
+func (cmd command[T]) Modal(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	reader io.Reader,
+) error {
+	if cmd.paster == nil {
+		return fmt.Errorf("command %q does not support pasting: %w", cmd.Name(), ErrUnrecognizedInteraction)
+	}
+
+	owner, err := unmarshal[string](reader)
+	if err != nil {
+		return fmt.Errorf("could not read owner from modal state: %w", err)
+	}
+	if *owner != interactionUserID(interaction) {
+		return fmt.Errorf("modal for command %q submitted by another user: %w", cmd.Name(), ErrUnrecognizedInteraction)
+	}
+
+	var action [1]byte
+	_, err = io.ReadFull(reader, action[:])
+	if err != nil {
+		return fmt.Errorf("could not read action from modal state: %w", err)
+	}
+	if action[0] != (paste[T]{}).Name() {
+		return fmt.Errorf("unknown modal action %q: %w", action, ErrUnrecognizedInteraction)
+	}
+
+	state, err := buttonState[paste[T]](reader)
+	if err != nil {
+		return fmt.Errorf("error while deserializing paste data: %w", err)
+	}
+
+	text, ok := pastedText(interaction.ModalSubmitData())
+	if !ok {
+		return fmt.Errorf("no pasted text in modal for command %q: %w", cmd.Name(), ErrCommandFormat)
+	}
+
+	body, err := cmd.paster.Paste(ctx, mdl, sess, interaction, &state.Options, text)
+	if errors.Is(err, ErrCustomIDTooLong) {
+		respErr := respondCustomIDTooLong(sess, interaction)
+		if respErr != nil {
+			return respErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error while calling paste handler for command %q: %w", cmd.Name(), err)
+	}
+	body.Embeds = limitEmbeds(body.Embeds)
+
+	err = sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: body,
+	})
+	if err != nil {
+		return fmt.Errorf("error while responding to modal for command %q: %w", cmd.Name(), err)
+	}
+
+	return nil
+}
+
 func (cmd command[T]) Button(
 	ctx context.Context,
 	mdl *model.Model,
@@ -213,8 +402,26 @@ func (cmd command[T]) Button(
 	interaction *discordgo.InteractionCreate,
 	reader io.Reader,
 ) error {
+	owner, err := unmarshal[string](reader)
+	if err != nil {
+		return fmt.Errorf("could not read owner from button state: %w", err)
+	}
+	if *owner != "" && *owner != interactionUserID(interaction) {
+		err = sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "These buttons aren't for you.",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to respond to button press from another user: %w", err)
+		}
+		return nil
+	}
+
 	var action [1]byte
-	_, err := io.ReadFull(reader, action[:])
+	_, err = io.ReadFull(reader, action[:])
 	if err != nil {
 		return fmt.Errorf("could not read action from button state: %w", err)
 	}
@@ -231,9 +438,16 @@ func (cmd command[T]) Button(
 		}
 
 		body, err := cmd.pager.Paginate(ctx, mdl, sess, interaction, *page)
+		if errors.Is(err, ErrCustomIDTooLong) {
+			respErr := respondCustomIDTooLong(sess, interaction)
+			if respErr != nil {
+				return respErr
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("error while calling pagination handler: %w", err)
 		}
+		body.Embeds = limitEmbeds(body.Embeds)
 
 		_, err = sess.ChannelMessageEditComplex(&discordgo.MessageEdit{
 			Channel:    interaction.ChannelID,
@@ -260,6 +474,12 @@ func (cmd command[T]) Button(
 		}
 
 		body, err := cmd.responseBody(ctx, mdl, sess, interaction, s.Options)
+		if errors.Is(err, ErrCustomIDTooLong) {
+			respErr := respondCustomIDTooLong(sess, interaction)
+			if respErr != nil {
+				return respErr
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("could not handle command %q: %w", cmd.Name(), err)
 		}
@@ -282,6 +502,19 @@ func (cmd command[T]) Button(
 			return fmt.Errorf("failed to complete interaction: %w", err)
 		}
 
+	case closer{}.Name():
+		err = sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseDeferredMessageUpdate,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to complete interaction: %w", err)
+		}
+
+		err = sess.ChannelMessageDelete(interaction.ChannelID, interaction.Message.ID)
+		if err != nil {
+			return fmt.Errorf("failed to delete message: %w", err)
+		}
+
 	default:
 		return fmt.Errorf("unknown button action %q: %w", action, ErrUnrecognizedInteraction)
 	}
@@ -310,7 +543,13 @@ func (cmd command[T]) Autocomplete(
 		return fmt.Errorf("error while calling autocompletion handler: %w", err)
 	}
 
-	sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+	// the request may have been superseded by a newer one while it was running
+	err = ctx.Err()
+	if err != nil {
+		return fmt.Errorf("autocompletion canceled before responding: %w", err)
+	}
+
+	err = sess.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
 		Data: &discordgo.InteractionResponseData{
 			Choices: choices,
@@ -340,6 +579,13 @@ var fieldTypes = map[reflect.Type]bool{
 	reflect.TypeOf(discordField[bool]{}):   true,
 }
 
+// decodeOptions sets the fields of structure from the options of an
+// interaction, matching options to fields by their option tags. Values decode
+// to plain fields, pointers for optional values or discordFields for focus.
+// Subcommands decode to pointers to structs of their own options, and
+// subcommand groups the same way to structs of their subcommands, so a command
+// with groups has options nested as group, subcommand and then value, with only
+// the chosen path set.
 func decodeOptions(options []*discordgo.ApplicationCommandInteractionDataOption, structure any) (ret error) {
 	defer func() {
 		r := recover()
@@ -407,6 +653,13 @@ func decodeOptions(options []*discordgo.ApplicationCommandInteractionDataOption,
 				field.SetBool(option.BoolValue())
 				continue
 			}
+		case discordgo.ApplicationCommandOptionAttachment:
+			// attachments are decoded to their ID, which can be looked up in the
+			// interaction's resolved data
+			if id, ok := option.Value.(string); ok && field.Kind() == reflect.String {
+				field.SetString(id)
+				continue
+			}
 		case discordgo.ApplicationCommandOptionSubCommand:
 			if field.Kind() == reflect.Struct {
 				err := decodeOptions(option.Options, field.Addr().Interface())
@@ -414,6 +667,15 @@ func decodeOptions(options []*discordgo.ApplicationCommandInteractionDataOption,
 					return fmt.Errorf("error while decoding options for subcommand %q: %w", option.Name, err)
 				}
 
+				continue
+			}
+		case discordgo.ApplicationCommandOptionSubCommandGroup:
+			if field.Kind() == reflect.Struct {
+				err := decodeOptions(option.Options, field.Addr().Interface())
+				if err != nil {
+					return fmt.Errorf("error while decoding options for subcommand group %q: %w", option.Name, err)
+				}
+
 				continue
 			}
 		default:
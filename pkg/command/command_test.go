@@ -0,0 +1,29 @@
+package command
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCustomIDLength(t *testing.T) {
+	userID := "123456789012345678"
+	followUpID := func(name string) (string, error) {
+		opt := dexOptions{Pokemon: &dexPokemonOptions{Name: discordField[string]{Value: name}}}
+		return customID(followUp[dexOptions]{opt}, "dex", userID)
+	}
+
+	id, err := followUpID("charizard")
+	if err != nil {
+		t.Fatalf("customID for a short follow-up: %v", err)
+	}
+	if length := utf8.RuneCountInString(id); length > maxCustomIDLength {
+		t.Errorf("customID returned %d characters, want at most %d", length, maxCustomIDLength)
+	}
+
+	_, err = followUpID(strings.Repeat("a", maxCustomIDLength))
+	if !errors.Is(err, ErrCustomIDTooLong) {
+		t.Errorf("customID for a follow-up over the limit = %v, want %v", err, ErrCustomIDTooLong)
+	}
+}
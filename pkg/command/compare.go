@@ -0,0 +1,403 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type compareOptions struct {
+	Move *struct {
+		Name     discordField[string] `option:"move"`
+		Version1 discordField[string] `option:"version_1"`
+		Version2 discordField[string] `option:"version_2"`
+	} `option:"move"`
+	Pokemon *struct {
+		Name     discordField[string] `option:"pokemon"`
+		Version1 discordField[string] `option:"version_1"`
+		Version2 discordField[string] `option:"version_2"`
+	} `option:"pokemon"`
+}
+
+type compareResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+}
+
+// comparison is a pair of models set to the versions being compared.
+type comparison struct {
+	models [2]*model.Model
+	names  [2]string
+}
+
+func (cmp *comparison) description() string {
+	return fmt.Sprintf("Pokemon %s → Pokemon %s", cmp.names[0], cmp.names[1])
+}
+
+// compareValues formats a value in both versions, highlighting it if it changed.
+func compareValues(before, after string) (string, bool) {
+	if before == after {
+		return before, false
+	}
+
+	return fmt.Sprintf("%s → **%s**", before, after), true
+}
+
+func (resp compareResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *compareOptions,
+) (*discordgo.InteractionResponseData, error) {
+	var versions [2]string
+	switch {
+	case opt.Move != nil:
+		versions = [2]string{opt.Move.Version1.Value, opt.Move.Version2.Value}
+	case opt.Pokemon != nil:
+		versions = [2]string{opt.Pokemon.Version1.Value, opt.Pokemon.Version2.Value}
+	default:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"compare\": %w", ErrCommandFormat)
+	}
+
+	var cmp comparison
+	for i, version := range versions {
+		// compare on forks so the user's selected version is left untouched
+		fork := mdl.Fork()
+		fork.Language = mdl.Language
+		err := fork.SetVersionByName(ctx, version)
+		if err != nil {
+			return &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("No game version found with the name %q.", version),
+			}, nil
+		}
+
+		name, err := fork.Version.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not localize version name: %w", err)
+		}
+
+		cmp.models[i] = fork
+		cmp.names[i] = name
+	}
+
+	switch {
+	case opt.Move != nil:
+		return resp.handleMove(ctx, mdl, &cmp, opt.Move.Name.Value)
+	default:
+		return resp.handlePokemon(ctx, mdl, &cmp, opt.Pokemon.Name.Value)
+	}
+}
+
+func (resp compareResponder) typeString(types ...*model.Type) (string, error) {
+	emojis := make([]string, 0, len(types))
+	for _, typ := range types {
+		if typ == nil || typ.IsPseudo() {
+			continue
+		}
+
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return "", fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+		emojis = append(emojis, emoji)
+	}
+
+	if len(emojis) == 0 {
+		return "—", nil
+	}
+
+	return strings.Join(emojis, " "), nil
+}
+
+func (resp compareResponder) handleMove(
+	ctx context.Context,
+	mdl *model.Model,
+	cmp *comparison,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	var moves [2]*model.Move
+	for i, m := range cmp.models {
+		move, err := m.MoveByName(ctx, name)
+		if errors.Is(err, model.ErrWrongGeneration) {
+			return &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("The specified move does not exist in Pokemon %s.", cmp.names[i]),
+			}, nil
+		} else if err != nil {
+			return moveNotFound(ctx, mdl, name, err)
+		}
+		moves[i] = move
+	}
+
+	moveName, err := moves[1].LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get localized name for move %q: %w", moves[1].Name, err)
+	}
+
+	optionalStat := func(stat *int, format string) string {
+		if stat == nil {
+			return "—"
+		}
+		return fmt.Sprintf(format, *stat)
+	}
+
+	var types [2]string
+	for i, move := range moves {
+		typ, err := move.Type(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+		}
+
+		types[i], err = resp.typeString(typ)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows := []struct {
+		name          string
+		before, after string
+	}{
+		{"Type", types[0], types[1]},
+		{"Power", optionalStat(moves[0].Power, "%d"), optionalStat(moves[1].Power, "%d")},
+		{"Accuracy", optionalStat(moves[0].Accuracy, "%d%%"), optionalStat(moves[1].Accuracy, "%d%%")},
+		{"PP", optionalStat(moves[0].PP, "%d"), optionalStat(moves[1].PP, "%d")},
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, len(rows))
+	changed := false
+	for i, row := range rows {
+		value, diff := compareValues(row.before, row.after)
+		changed = changed || diff
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:   row.name,
+			Value:  value,
+			Inline: true,
+		}
+	}
+
+	return compareResponse(moveName, cmp, fields, changed), nil
+}
+
+func (resp compareResponder) handlePokemon(
+	ctx context.Context,
+	mdl *model.Model,
+	cmp *comparison,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	var pokemon [2]*model.Pokemon
+	for i, m := range cmp.models {
+		p, err := m.PokemonByName(ctx, name)
+		if errors.Is(err, model.ErrWrongGeneration) {
+			return &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("The specified Pokemon does not exist in Pokemon %s.", cmp.names[i]),
+			}, nil
+		} else if err != nil {
+			return pokemonNotFound(ctx, mdl, name, err)
+		}
+		pokemon[i] = p
+	}
+
+	pokemonName, err := pokemon[1].LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get localized name for pokemon %q: %w", pokemon[1].Name, err)
+	}
+
+	var types [2]string
+	for i, p := range pokemon {
+		combo, err := p.TypeCombo(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get type combo for pokemon: %w", err)
+		}
+
+		types[i], err = resp.typeString(combo.Types()...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	typeValue, changed := compareValues(types[0], types[1])
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:  "Type",
+			Value: typeValue,
+		},
+	}
+
+	is, err := mdl.IntrinsicStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting all intrinsic stats: %w", err)
+	}
+
+	for _, stat := range is {
+		var values [2]string
+		for i, p := range pokemon {
+			bs, err := p.BaseStat(ctx, stat)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+			}
+			values[i] = strconv.Itoa(bs)
+		}
+
+		statName, err := stat.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for stat: %w", err)
+		}
+
+		value, diff := compareValues(values[0], values[1])
+		changed = changed || diff
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   statName,
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	return compareResponse(pokemonName, cmp, fields, changed), nil
+}
+
+func compareResponse(
+	title string,
+	cmp *comparison,
+	fields []*discordgo.MessageEmbedField,
+	changed bool,
+) *discordgo.InteractionResponseData {
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: cmp.description(),
+		Fields:      fields,
+	}
+	if !changed {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "No differences between these versions.",
+		}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}
+}
+
+func (resp compareResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *compareOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	var versions []discordField[string]
+	switch {
+	case opt.Move != nil:
+		if opt.Move.Name.Focused {
+			s := moveSearcher{
+				model:  mdl,
+				prefix: opt.Move.Name.Value,
+				limit:  resp.autocompleteLimits.Move,
+			}
+			return searchChoices[*model.Move](ctx, s)
+		}
+		versions = []discordField[string]{opt.Move.Version1, opt.Move.Version2}
+	case opt.Pokemon != nil:
+		if opt.Pokemon.Name.Focused {
+			s := pokemonSearcher{
+				model:      mdl,
+				prefix:     opt.Pokemon.Name.Value,
+				limit:      resp.autocompleteLimits.Pokemon,
+				order:      resp.pokemonOrder,
+				dexNumbers: resp.dexNumbers,
+			}
+			return searchChoices[*model.Pokemon](ctx, s)
+		}
+		versions = []discordField[string]{opt.Pokemon.Version1, opt.Pokemon.Version2}
+	default:
+		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
+	}
+
+	for _, version := range versions {
+		if version.Focused {
+			s := versionSearcher{
+				model:  mdl,
+				prefix: version.Value,
+				limit:  resp.autocompleteLimits.Version,
+			}
+			return searchChoices[*model.Version](ctx, s)
+		}
+	}
+
+	return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+}
+
+func compareVersionOptions() []*discordgo.ApplicationCommandOption {
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         "version_1",
+			Description:  "Game version to compare from",
+			Required:     true,
+			Autocomplete: true,
+		},
+		{
+			Type:         discordgo.ApplicationCommandOptionString,
+			Name:         "version_2",
+			Description:  "Game version to compare to",
+			Required:     true,
+			Autocomplete: true,
+		},
+	}
+}
+
+func (builder *Builder) compare(ctx context.Context) (Command, error) {
+	resp := compareResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+	}
+
+	return command[compareOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "compare",
+			Description: "Compare a move or Pokemon between two game versions.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "move",
+					Description: "Compare a move's type, power, accuracy and PP between two versions",
+					Options: append([]*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "move",
+							Description:  "Name of the move",
+							Required:     true,
+							Autocomplete: true,
+						},
+					}, compareVersionOptions()...),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "pokemon",
+					Description: "Compare a Pokemon's typing and base stats between two versions",
+					Options: append([]*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "pokemon",
+							Description:  "Name of the Pokemon",
+							Required:     true,
+							Autocomplete: true,
+						},
+					}, compareVersionOptions()...),
+				},
+			},
+		},
+	}, nil
+}
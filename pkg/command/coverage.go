@@ -4,24 +4,58 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
 )
 
+type coverageMoveOptions struct {
+	Name    discordField[string]  `option:"move"`
+	Dex     *bool                 `option:"dex"`
+	Weather *string               `option:"weather"`
+	Terrain *string               `option:"terrain"`
+	AsType  *discordField[string] `option:"as_type"`
+}
+
 type coverageOptions struct {
-	Move *struct {
-		Name discordField[string] `option:"move"`
-	} `option:"move"`
+	Move *coverageMoveOptions `option:"move"`
 	Type *struct {
 		Name discordField[string] `option:"type"`
 	} `option:"type"`
+	Pokemon *struct {
+		Name discordField[string] `option:"pokemon"`
+	} `option:"pokemon"`
+	Combo *struct {
+		Attack1 discordField[string]  `option:"attack_1"`
+		Attack2 *discordField[string] `option:"attack_2"`
+		Attack3 *discordField[string] `option:"attack_3"`
+		Attack4 *discordField[string] `option:"attack_4"`
+	} `option:"combo"`
+}
+
+func (opt *coverageOptions) comboAttacks() []*discordField[string] {
+	if opt.Combo == nil {
+		return nil
+	}
+
+	attacks := []*discordField[string]{&opt.Combo.Attack1}
+	for _, attack := range []*discordField[string]{opt.Combo.Attack2, opt.Combo.Attack3, opt.Combo.Attack4} {
+		if attack != nil {
+			attacks = append(attacks, attack)
+		}
+	}
+
+	return attacks
 }
 
 type coverageResponder struct {
-	autocompleteLimit int
-	emojis            Emojis
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
 }
 
 func (resp coverageResponder) Handle(
@@ -32,20 +66,13 @@ func (resp coverageResponder) Handle(
 	opt *coverageOptions,
 ) (*discordgo.InteractionResponseData, error) {
 	titleStrings := make([]string, 0, 2)
+	description := "Offensive type chart"
 	var typ *model.Type
 	switch {
 	case opt.Move != nil:
 		move, err := mdl.MoveByName(ctx, opt.Move.Name.Value)
 		if err != nil {
-			if errors.Is(err, model.ErrWrongGeneration) {
-				return &discordgo.InteractionResponseData{
-					Content: "The specified Pokemon does not exist in this generation.",
-				}, nil
-			} else {
-				return &discordgo.InteractionResponseData{
-					Content: "No Pokemon found with that name.",
-				}, nil
-			}
+			return moveNotFound(ctx, mdl, opt.Move.Name.Value, err)
 		}
 
 		name, err := move.LocalizedName(ctx)
@@ -54,16 +81,30 @@ func (resp coverageResponder) Handle(
 		}
 		titleStrings = append(titleStrings, name)
 
-		typ, err = move.Type(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not get type for move: %w", err)
+		var note string
+		var res *discordgo.InteractionResponseData
+		typ, note, res, err = resp.moveType(ctx, mdl, move, name, opt.Move)
+		if res != nil || err != nil {
+			return res, err
+		}
+		if typ.IsPseudo() {
+			return &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("%s has no type, so it has no type coverage.", name),
+			}, nil
+		}
+		if note != "" {
+			description = fmt.Sprintf("%s\n%s", description, note)
 		}
 	case opt.Type != nil:
 		var err error
 		typ, err = mdl.TypeByName(ctx, opt.Type.Name.Value)
 		if err != nil {
-			return nil, fmt.Errorf("could not get first type by name: %w", err)
+			return typeNotFound(ctx, mdl, opt.Type.Name.Value, err)
 		}
+	case opt.Combo != nil:
+		return resp.handleCombo(ctx, mdl, opt)
+	case opt.Pokemon != nil:
+		return resp.handlePokemon(ctx, mdl, opt.Pokemon.Name.Value)
 	default:
 		return nil, fmt.Errorf("unrecognized subcommand for command \"weak\": %w", ErrCommandFormat)
 	}
@@ -79,7 +120,7 @@ func (resp coverageResponder) Handle(
 	}
 	titleStrings = append(titleStrings, typeString)
 
-	fields, err := efficaciesToFields(ctx, effs, true, efficacyNames{
+	fields, err := efficaciesToFields(ctx, effs, true, efficacyFilterAll, efficacyNames{
 		doubleStrong: "Super Effective (4x)",
 		strong:       "Super Effective (2x)",
 		neutral:      "Neutral (1x)",
@@ -91,17 +132,448 @@ func (resp coverageResponder) Handle(
 		return nil, fmt.Errorf("could not encode type efficacies: %w", err)
 	}
 
+	description, err = withExcludedTypesNote(ctx, mdl, description)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Move != nil && opt.Move.Dex != nil && *opt.Move.Dex {
+		field, err := dexCoverageField(ctx, typ)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
 	return &discordgo.InteractionResponseData{
 		Embeds: []*discordgo.MessageEmbed{
 			{
 				Title:       strings.Join(titleStrings, " "),
-				Description: "Offensive type chart",
+				Description: description,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+// withExcludedTypesNote adds a note to a type chart description listing the
+// types left out because they do not exist yet in the model version.
+func withExcludedTypesNote(ctx context.Context, mdl *model.Model, description string) (string, error) {
+	types, err := mdl.ExcludedTypes(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get excluded types: %w", err)
+	}
+	if len(types) == 0 {
+		return description, nil
+	}
+
+	names := make([]string, len(types))
+	for i, typ := range types {
+		names[i], err = typ.LocalizedName(ctx)
+		if err != nil {
+			return "", fmt.Errorf("could not get localized name for type %q: %w", typ.Name, err)
+		}
+	}
+
+	gen, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get generation for model version: %w", err)
+	}
+	genName, err := gen.LocalizedName(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+	}
+
+	return fmt.Sprintf("%s\n_Types not yet in %s are left out: %s_", description, genName, strings.Join(names, ", ")), nil
+}
+
+var typeVarianceDescriptions = map[model.TypeVariance]string{
+	model.TypeVarianceIVs:     "its user's IVs",
+	model.TypeVarianceWeather: "the weather",
+	model.TypeVarianceTerrain: "the terrain",
+	model.TypeVarianceItem:    "its user's held item",
+	model.TypeVarianceUser:    "its user",
+}
+
+// moveType resolves the type of a move for its coverage, applying the weather,
+// terrain or type options to moves whose type varies. For a variable move with no
+// option set, it either notes that the stored type is only the default or, if
+// the stored type is never used, responds asking for a type.
+func (resp coverageResponder) moveType(
+	ctx context.Context,
+	mdl *model.Model,
+	move *model.Move,
+	name string,
+	opt *coverageMoveOptions,
+) (*model.Type, string, *discordgo.InteractionResponseData, error) {
+	variance, variable := move.TypeVariance()
+	message := func(content string) (*model.Type, string, *discordgo.InteractionResponseData, error) {
+		return nil, "", &discordgo.InteractionResponseData{Content: content}, nil
+	}
+
+	switch {
+	case opt.Weather != nil:
+		if variance != model.TypeVarianceWeather {
+			return message(fmt.Sprintf("The weather does not change the type of %s.", name))
+		}
+		typ, err := move.TypeInWeather(ctx, model.Weather(*opt.Weather))
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("could not get type for move %q in weather: %w", move.Name, err)
+		}
+		return typ, "", nil, nil
+	case opt.Terrain != nil:
+		if variance != model.TypeVarianceTerrain {
+			return message(fmt.Sprintf("The terrain does not change the type of %s.", name))
+		}
+		typ, err := move.TypeOnTerrain(ctx, model.Terrain(*opt.Terrain))
+		if err != nil {
+			return nil, "", nil, fmt.Errorf("could not get type for move %q on terrain: %w", move.Name, err)
+		}
+		return typ, "", nil, nil
+	case opt.AsType != nil:
+		typ, err := mdl.TypeByName(ctx, opt.AsType.Value)
+		if err != nil {
+			res, err := typeNotFound(ctx, mdl, opt.AsType.Value, err)
+			return nil, "", res, err
+		}
+		if errors.Is(move.ValidateVariableType(typ), model.ErrInvalidVariableType) {
+			return message(fmt.Sprintf("%s cannot be that type.", name))
+		}
+		return typ, "", nil, nil
+	}
+
+	typ, err := move.Type(ctx)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("could not get type for move: %w", err)
+	}
+	if !variable {
+		return typ, "", nil, nil
+	}
+
+	option := "as_type"
+	switch variance {
+	case model.TypeVarianceWeather:
+		option = "weather"
+	case model.TypeVarianceTerrain:
+		option = "terrain"
+	}
+	if !move.HasMeaningfulDefaultType() {
+		return message(fmt.Sprintf(
+			"The type of %s depends on %s. Set the `%s` option to see its coverage.",
+			name,
+			typeVarianceDescriptions[variance],
+			option,
+		))
+	}
+
+	return typ, fmt.Sprintf(
+		"_The type of %s depends on %s, so this is only its default type. Set the `%s` option to change it._",
+		name,
+		typeVarianceDescriptions[variance],
+		option,
+	), nil, nil
+}
+
+// dexCoverageField summarizes how many fully evolved pokemon an attacking type
+// hits super effectively.
+func dexCoverageField(ctx context.Context, typ *model.Type) (*discordgo.MessageEmbedField, error) {
+	cov, err := typ.DexCoverage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get dex coverage for type %q: %w", typ.Name, err)
+	}
+
+	percent := func(n int) int {
+		if cov.Total == 0 {
+			return 0
+		}
+		return int(math.Round(100 * float64(n) / float64(cov.Total)))
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name: "Fully Evolved Pokemon",
+		Value: fmt.Sprintf(
+			"Super effective against **%d/%d** (%d%%)\nNeutral: %d ▸ Resisted: %d ▸ Immune: %d",
+			cov.SuperEffective,
+			cov.Total,
+			percent(cov.SuperEffective),
+			cov.Neutral,
+			cov.Resisted,
+			cov.Immune,
+		),
+	}, nil
+}
+
+var (
+	ErrNoMatchingAttack = errors.New("no matching move or type")
+	ErrPseudoTypeAttack = errors.New("move has a pseudo-type")
+	ErrVariableAttack   = errors.New("move has a variable type")
+)
+
+func (resp coverageResponder) attackingType(ctx context.Context, mdl *model.Model, name string) (*model.Type, string, error) {
+	typ, err := mdl.TypeByName(ctx, name)
+	if err == nil {
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+
+		return typ, emoji, nil
+	}
+
+	move, err := mdl.MoveByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, model.ErrWrongGeneration) {
+			return nil, "", err
+		}
+		return nil, "", ErrNoMatchingAttack
+	}
+
+	moveName, err := move.LocalizedName(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get localized name for move %q: %w", move.Name, err)
+	}
+
+	typ, err = move.Type(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get type for move: %w", err)
+	}
+	if typ.IsPseudo() {
+		return nil, "", fmt.Errorf("move %q has type %q: %w", move.Name, typ.Name, ErrPseudoTypeAttack)
+	}
+	if _, variable := move.TypeVariance(); variable && !move.HasMeaningfulDefaultType() {
+		return nil, "", fmt.Errorf("move %q has no default type: %w", move.Name, ErrVariableAttack)
+	}
+
+	emoji, err := resp.emojis.Emoji(typ.Name)
+	if err != nil {
+		return nil, "", fmt.Errorf("error while constructing type emoji string: %w", err)
+	}
+
+	return typ, fmt.Sprintf("%s %s", moveName, emoji), nil
+}
+
+func (resp coverageResponder) handleCombo(
+	ctx context.Context,
+	mdl *model.Model,
+	opt *coverageOptions,
+) (*discordgo.InteractionResponseData, error) {
+	attacks := opt.comboAttacks()
+	titleStrings := make([]string, 0, len(attacks))
+	types := make([]*model.Type, 0, len(attacks))
+	for _, attack := range attacks {
+		typ, title, err := resp.attackingType(ctx, mdl, attack.Value)
+		if err != nil {
+			switch {
+			case errors.Is(err, model.ErrWrongGeneration):
+				return &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("The move %q does not exist in this generation.", attack.Value),
+				}, nil
+			case errors.Is(err, ErrVariableAttack):
+				return &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("The type of the move %q varies, so add the type it would have instead.", attack.Value),
+				}, nil
+			case errors.Is(err, ErrPseudoTypeAttack):
+				return &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("The move %q has no type, so it has no type coverage.", attack.Value),
+				}, nil
+			case errors.Is(err, ErrNoMatchingAttack):
+				return &discordgo.InteractionResponseData{
+					Content: fmt.Sprintf("No move or type found with the name %q.", attack.Value),
+				}, nil
+			default:
+				return nil, fmt.Errorf("could not get attacking type for %q: %w", attack.Value, err)
+			}
+		}
+
+		types = append(types, typ)
+		titleStrings = append(titleStrings, title)
+	}
+
+	effs, err := mdl.BestAttackingEfficacies(ctx, types)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting combined efficacies: %w", err)
+	}
+
+	fields, err := efficaciesToFields(ctx, effs, true, efficacyFilterAll, efficacyNames{
+		strong:  "Super Effective (by at least one)",
+		neutral: "Neutral (at best)",
+		weak:    "Resists All",
+		immune:  "Immune to All",
+	}, resp.emojis)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode type efficacies: %w", err)
+	}
+
+	description, err := withExcludedTypesNote(ctx, mdl, "Combined offensive type chart")
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       strings.Join(titleStrings, " / "),
+				Description: description,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+// damageClassCoverage is the set of attacking types a pokemon has damaging moves
+// for within one damage class.
+type damageClassCoverage struct {
+	label string
+	names efficacyNames
+	types []*model.Type
+	seen  map[int]bool
+}
+
+func (resp coverageResponder) handlePokemon(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, name)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, name, err)
+	}
+
+	pokemonName, err := pokemon.LocalizedFormName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
+	}
+
+	moves, err := pokemon.LearnableMoves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get learnable moves for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	classes := map[string]*damageClassCoverage{
+		"physical": {
+			label: "Physical",
+			names: efficacyNames{
+				strong:  "Physical: Super Effective",
+				neutral: "Physical: Neutral (at best)",
+				weak:    "Physical: Resisted",
+				immune:  "Physical: Immune",
+			},
+			seen: make(map[int]bool),
+		},
+		"special": {
+			label: "Special",
+			names: efficacyNames{
+				strong:  "Special: Super Effective",
+				neutral: "Special: Neutral (at best)",
+				weak:    "Special: Resisted",
+				immune:  "Special: Immune",
+			},
+			seen: make(map[int]bool),
+		},
+	}
+
+	// fixed damage moves have no power and ignore type matchups, so only moves
+	// with power count towards coverage
+	for _, move := range moves {
+		if move.Power == nil {
+			continue
+		}
+
+		class, err := move.AttackingDamageClass(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+		}
+		cov, ok := classes[class.Name]
+		if !ok {
+			continue
+		}
+
+		typ, err := move.Type(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+		}
+		if typ.IsPseudo() || cov.seen[typ.ID] {
+			continue
+		}
+		cov.seen[typ.ID] = true
+		cov.types = append(cov.types, typ)
+	}
+
+	var fields []*discordgo.MessageEmbedField
+	for _, cov := range []*damageClassCoverage{classes["physical"], classes["special"]} {
+		if len(cov.types) == 0 {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  fmt.Sprintf("%s Move Types", cov.label),
+				Value: "_None_",
+			})
+			continue
+		}
+
+		emojis := make([]string, len(cov.types))
+		for i, typ := range cov.types {
+			emojis[i], err = resp.emojis.Emoji(typ.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+			}
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s Move Types", cov.label),
+			Value: strings.Join(emojis, " "),
+		})
+
+		effs, err := mdl.BestAttackingEfficacies(ctx, cov.types)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting combined efficacies: %w", err)
+		}
+
+		effFields, err := efficaciesToFields(ctx, effs, true, efficacyFilterAll, cov.names, resp.emojis)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode type efficacies: %w", err)
+		}
+		fields = append(fields, effFields...)
+	}
+
+	description, err := withExcludedTypesNote(ctx, mdl, "Offensive coverage from learnable moves")
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       pokemonName,
+				Description: description,
 				Fields:      fields,
 			},
 		},
 	}, nil
 }
 
+func comboChoices(ctx context.Context, mdl *model.Model, prefix string, limit int) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	choices, err := searchChoices[*model.Type](ctx, typeSearcher{
+		model:  mdl,
+		prefix: prefix,
+		limit:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while searching types: %w", err)
+	}
+
+	if len(choices) < limit {
+		moveChoices, err := searchChoices[*model.Move](ctx, moveSearcher{
+			model:  mdl,
+			prefix: prefix,
+			limit:  limit - len(choices),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while searching moves: %w", err)
+		}
+		choices = append(choices, moveChoices...)
+	}
+
+	return choices, nil
+}
+
 func (resp coverageResponder) Autocomplete(
 	ctx context.Context,
 	mdl *model.Model,
@@ -115,19 +587,44 @@ func (resp coverageResponder) Autocomplete(
 			s := moveSearcher{
 				model:  mdl,
 				prefix: opt.Move.Name.Value,
-				limit:  resp.autocompleteLimit,
+				limit:  resp.autocompleteLimits.Move,
 			}
 			return searchChoices[*model.Move](ctx, s)
 		}
+		if opt.Move.AsType != nil && opt.Move.AsType.Focused {
+			s := typeSearcher{
+				model:  mdl,
+				prefix: opt.Move.AsType.Value,
+				limit:  resp.autocompleteLimits.Type,
+			}
+			return searchChoices[*model.Type](ctx, s)
+		}
 	case opt.Type != nil:
 		if opt.Type.Name.Focused {
 			s := typeSearcher{
 				model:  mdl,
 				prefix: opt.Type.Name.Value,
-				limit:  resp.autocompleteLimit,
+				limit:  resp.autocompleteLimits.Type,
 			}
 			return searchChoices[*model.Type](ctx, s)
 		}
+	case opt.Pokemon != nil:
+		if opt.Pokemon.Name.Focused {
+			s := pokemonSearcher{
+				model:      mdl,
+				prefix:     opt.Pokemon.Name.Value,
+				limit:      resp.autocompleteLimits.Pokemon,
+				order:      resp.pokemonOrder,
+				dexNumbers: resp.dexNumbers,
+			}
+			return searchChoices[*model.Pokemon](ctx, s)
+		}
+	case opt.Combo != nil:
+		for _, attack := range opt.comboAttacks() {
+			if attack.Focused {
+				return comboChoices(ctx, mdl, attack.Value, resp.autocompleteLimits.Type)
+			}
+		}
 	default:
 		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
 	}
@@ -135,10 +632,38 @@ func (resp coverageResponder) Autocomplete(
 	return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
 }
 
+func weatherChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(model.AllWeather))
+	for i, weather := range model.AllWeather {
+		name := string(weather)
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  strings.ToUpper(name[:1]) + name[1:],
+			Value: name,
+		}
+	}
+
+	return choices
+}
+
+func terrainChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(model.AllTerrains))
+	for i, terrain := range model.AllTerrains {
+		name := string(terrain)
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  fmt.Sprintf("%s%s Terrain", strings.ToUpper(name[:1]), name[1:]),
+			Value: name,
+		}
+	}
+
+	return choices
+}
+
 func (builder *Builder) coverage(ctx context.Context) (Command, error) {
 	resp := coverageResponder{
-		autocompleteLimit: builder.config.AutocompleteLimit,
-		emojis:            builder.emojis,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
 	}
 
 	return command[coverageOptions]{
@@ -160,6 +685,33 @@ func (builder *Builder) coverage(ctx context.Context) (Command, error) {
 							Required:     true,
 							Autocomplete: true,
 						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "dex",
+							Description: "Also count the fully evolved Pokemon the move hits super effectively",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "weather",
+							Description: "Weather for moves whose type depends on it, like Weather Ball",
+							Required:    false,
+							Choices:     weatherChoices(),
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "terrain",
+							Description: "Terrain for moves whose type depends on it, like Terrain Pulse",
+							Required:    false,
+							Choices:     terrainChoices(),
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "as_type",
+							Description:  "Type for moves like Hidden Power or Judgment whose type varies",
+							Required:     false,
+							Autocomplete: true,
+						},
 					},
 				},
 				{
@@ -176,6 +728,55 @@ func (builder *Builder) coverage(ctx context.Context) (Command, error) {
 						},
 					},
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "combo",
+					Description: "View combined type chart for up to four attacking moves/types",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "attack_1",
+							Description:  "Name of the first move or type",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "attack_2",
+							Description:  "Name of the second move or type",
+							Required:     false,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "attack_3",
+							Description:  "Name of the third move or type",
+							Required:     false,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "attack_4",
+							Description:  "Name of the fourth move or type",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "pokemon",
+					Description: "View physical and special coverage from the moves a Pokemon can learn",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "pokemon",
+							Description:  "Name of the Pokemon",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
 			},
 		},
 	}, nil
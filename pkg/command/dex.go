@@ -6,21 +6,88 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
+type dexPokemonOptions struct {
+	Name                 discordField[string] `option:"pokemon"`
+	StatBars             *bool                `option:"stat_bars"`
+	StatRanks            *bool                `option:"stat_ranks"`
+	DefensiveRating      *bool                `option:"defensive_rating"`
+	UnavailableAbilities *bool                `option:"unavailable_abilities"`
+}
+
 type dexOptions struct {
-	Pokemon *struct {
-		Name discordField[string] `option:"pokemon"`
-	} `option:"pokemon"`
+	Pokemon *dexPokemonOptions `option:"pokemon"`
+	Move    *struct {
+		Name discordField[string] `option:"move"`
+	} `option:"move"`
+	Number *struct {
+		Number               int   `option:"number"`
+		StatBars             *bool `option:"stat_bars"`
+		StatRanks            *bool `option:"stat_ranks"`
+		DefensiveRating      *bool `option:"defensive_rating"`
+		UnavailableAbilities *bool `option:"unavailable_abilities"`
+	} `option:"number"`
+}
+
+func (opt *dexOptions) statBars() bool {
+	switch {
+	case opt.Pokemon != nil && opt.Pokemon.StatBars != nil:
+		return *opt.Pokemon.StatBars
+	case opt.Number != nil && opt.Number.StatBars != nil:
+		return *opt.Number.StatBars
+	default:
+		return false
+	}
+}
+
+func (opt *dexOptions) statRanks() bool {
+	switch {
+	case opt.Pokemon != nil && opt.Pokemon.StatRanks != nil:
+		return *opt.Pokemon.StatRanks
+	case opt.Number != nil && opt.Number.StatRanks != nil:
+		return *opt.Number.StatRanks
+	default:
+		return false
+	}
+}
+
+func (opt *dexOptions) defensiveRating() bool {
+	switch {
+	case opt.Pokemon != nil && opt.Pokemon.DefensiveRating != nil:
+		return *opt.Pokemon.DefensiveRating
+	case opt.Number != nil && opt.Number.DefensiveRating != nil:
+		return *opt.Number.DefensiveRating
+	default:
+		return false
+	}
+}
+
+func (opt *dexOptions) unavailableAbilities() bool {
+	switch {
+	case opt.Pokemon != nil && opt.Pokemon.UnavailableAbilities != nil:
+		return *opt.Pokemon.UnavailableAbilities
+	case opt.Number != nil && opt.Number.UnavailableAbilities != nil:
+		return *opt.Number.UnavailableAbilities
+	default:
+		return false
+	}
 }
 
 type dexResponder struct {
-	autocompleteLimit int
-	emojis            Emojis
-	commands          commands
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+	commands           commands
+	moveSections       config.MoveSections
+	store              *store.UserStore
 }
 
 func (resp dexResponder) Handle(
@@ -30,22 +97,35 @@ func (resp dexResponder) Handle(
 	interaction *discordgo.InteractionCreate,
 	opt *dexOptions,
 ) (*discordgo.InteractionResponseData, error) {
-	pokemon, err := mdl.PokemonByName(ctx, opt.Pokemon.Name.Value)
-	if err != nil {
+	var pokemon *model.Pokemon
+	switch {
+	case opt.Move != nil:
+		return resp.handleMove(ctx, mdl, opt.Move.Name.Value)
+	case opt.Number != nil:
+		var err error
+		pokemon, err = mdl.PokemonByDexNumber(ctx, opt.Number.Number)
 		if errors.Is(err, model.ErrWrongGeneration) {
 			return &discordgo.InteractionResponseData{
-				Content: "The specified Pokemon does not exist in this generation.",
+				Content: "The specified pokemon does not exist in this generation.",
 			}, nil
-		} else {
+		} else if err != nil {
 			return &discordgo.InteractionResponseData{
-				Content: "No Pokemon found with that name.",
+				Content: fmt.Sprintf("No Pokemon found with national dex number %d.", opt.Number.Number),
 			}, nil
 		}
+	case opt.Pokemon != nil:
+		var err error
+		pokemon, err = mdl.PokemonByName(ctx, opt.Pokemon.Name.Value)
+		if err != nil {
+			return pokemonNotFound(ctx, mdl, opt.Pokemon.Name.Value, err)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"dex\": %w", ErrCommandFormat)
 	}
 
 	titleStrings := make([]string, 0, 3)
 
-	name, err := pokemon.LocalizedName(ctx)
+	name, err := pokemon.LocalizedFormName(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
 	}
@@ -56,18 +136,12 @@ func (resp dexResponder) Handle(
 		return nil, fmt.Errorf("could not get type combo for pokemon: %w", err)
 	}
 
-	t1, err := resp.emojis.Emoji(combo.Type1.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error while constructing first type emoji string: %w", err)
-	}
-	titleStrings = append(titleStrings, t1)
-
-	if combo.Type2 != nil {
-		t2, err := resp.emojis.Emoji(combo.Type2.Name)
+	for _, typ := range combo.Types() {
+		emoji, err := resp.emojis.Emoji(typ.Name)
 		if err != nil {
-			return nil, fmt.Errorf("error while constructing first type emoji string: %w", err)
+			return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
 		}
-		titleStrings = append(titleStrings, t2)
+		titleStrings = append(titleStrings, emoji)
 	}
 
 	gen, err := mdl.Version.Generation(ctx)
@@ -125,27 +199,32 @@ func (resp dexResponder) Handle(
 		})
 	}
 
-	is, err := mdl.IntrinsicStats(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error while getting all intrinsic stats: %w", err)
-	}
-
-	for _, stat := range is {
-		bs, err := pokemon.BaseStat(ctx, stat)
+	unavailableAbilities := opt.unavailableAbilities()
+	if unavailableAbilities {
+		unavailableField, err := resp.unavailableAbilityField(ctx, pokemon)
 		if err != nil {
-			return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+			return nil, err
 		}
+		if unavailableField != nil {
+			fields = append(fields, unavailableField)
+		}
+	}
 
-		name, err := stat.LocalizedName(ctx)
+	statBars := opt.statBars()
+	statRanks := opt.statRanks()
+	statFields, err := resp.statFields(ctx, mdl, pokemon, statBars, statRanks)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, statFields...)
+
+	defensiveRating := opt.defensiveRating()
+	if defensiveRating {
+		ratingField, err := resp.defensiveRatingField(ctx, pokemon)
 		if err != nil {
-			return nil, fmt.Errorf("error while getting localized name for stat: %w", err)
+			return nil, err
 		}
-
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:   name,
-			Value:  strconv.Itoa(bs),
-			Inline: true,
-		})
+		fields = append(fields, ratingField)
 	}
 
 	sprite, err := pokemonSpriteFile(ctx, pokemon)
@@ -163,6 +242,7 @@ func (resp dexResponder) Handle(
 		discordgo.Button{
 			Label: "Learnset",
 		},
+		interaction,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("could not create follow-up button for learnset: %w", err)
@@ -171,9 +251,7 @@ func (resp dexResponder) Handle(
 	weakButton, err := followUpButton(
 		resp.commands,
 		weakOptions{
-			Pokemon: &struct {
-				Name discordField[string] `option:"pokemon"`
-			}{
+			Pokemon: &weakPokemonOptions{
 				Name: discordField[string]{
 					Value: pokemon.Name,
 				},
@@ -182,36 +260,526 @@ func (resp dexResponder) Handle(
 		discordgo.Button{
 			Label: "Type Chart",
 		},
+		interaction,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("could not create follow-up button for weak: %w", err)
 	}
 
-	return &discordgo.InteractionResponseData{
-		Embeds: []*discordgo.MessageEmbed{
-			{
-				Title:       strings.Join(titleStrings, " "),
-				Description: genName,
-				Thumbnail: &discordgo.MessageEmbedThumbnail{
-					URL: fmt.Sprintf("attachment://%s", sprite.Name),
+	embed := &discordgo.MessageEmbed{
+		Title:       strings.Join(titleStrings, " "),
+		Description: genName,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{
+			URL: fmt.Sprintf("attachment://%s", sprite.Name),
+		},
+		Fields: fields,
+	}
+	var notes []string
+	if !gen.HasSplitSpecial() {
+		notes = append(notes, "This generation used a single Special stat, shown here as Special Attack.")
+	}
+	if !gen.HasHiddenAbilities() {
+		hiddenGen, err := mdl.GenerationByID(ctx, model.HiddenAbilityGeneration)
+		if err != nil {
+			return nil, fmt.Errorf("could not get generation %d: %w", model.HiddenAbilityGeneration, err)
+		}
+		hiddenGenName, err := hiddenGen.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for generation %d: %w", hiddenGen.ID, err)
+		}
+		notes = append(notes, fmt.Sprintf("Hidden abilities are not available until %s.", hiddenGenName))
+	}
+	if len(notes) > 0 {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: strings.Join(notes, "\n"),
+		}
+	}
+
+	buttons := []discordgo.MessageComponent{
+		learnsetButton,
+		weakButton,
+	}
+
+	megas, err := pokemon.MegaForms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get mega forms for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	for _, mega := range megas {
+		if mega.ID == pokemon.ID {
+			continue
+		}
+
+		name, err := mega.LocalizedFormName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized form name for pokemon %q: %w", mega.Name, err)
+		}
+
+		megaButton, err := followUpButton(
+			resp.commands,
+			dexOptions{
+				Pokemon: &dexPokemonOptions{
+					Name: discordField[string]{
+						Value: mega.Name,
+					},
+					StatBars:             &statBars,
+					StatRanks:            &statRanks,
+					DefensiveRating:      &defensiveRating,
+					UnavailableAbilities: &unavailableAbilities,
 				},
-				Fields: fields,
 			},
+			discordgo.Button{
+				Label: name,
+			},
+			interaction,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not create follow-up button for mega form: %w", err)
+		}
+		buttons = append(buttons, megaButton)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			embed,
 		},
 		Files: []*discordgo.File{
 			sprite,
 		},
 		Components: []discordgo.MessageComponent{
 			discordgo.ActionsRow{
-				Components: []discordgo.MessageComponent{
-					learnsetButton,
-					weakButton,
-				},
+				Components: buttons,
 			},
 		},
 	}, nil
 }
 
+// unavailableAbilityField lists the pokemon's current abilities that it could
+// not have in the selected game, or is nil if there are none.
+func (resp dexResponder) unavailableAbilityField(
+	ctx context.Context,
+	pokemon *model.Pokemon,
+) (*discordgo.MessageEmbedField, error) {
+	abilities, err := pokemon.UnavailableAbilities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get unavailable abilities for pokemon %q: %w", pokemon.Name, err)
+	}
+	if len(abilities) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, len(abilities))
+	for i, ability := range abilities {
+		names[i], err = ability.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for ability: %w", err)
+		}
+		if ability.IsHidden {
+			names[i] = fmt.Sprintf("%s (hidden)", names[i])
+		}
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name:  "Unavailable in This Game",
+		Value: strings.Join(names, ", "),
+	}, nil
+}
+
+// defensiveRatingField summarizes the pokemon's bulk after its type matchups.
+// The rating is a rough heuristic, so the field is labelled as one and shows
+// what goes into it.
+func (resp dexResponder) defensiveRatingField(
+	ctx context.Context,
+	pokemon *model.Pokemon,
+) (*discordgo.MessageEmbedField, error) {
+	rating, err := pokemon.DefensiveRating(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get defensive rating for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name: "Defensive Rating (heuristic)",
+		Value: fmt.Sprintf(
+			"**%d**\nTakes %.2fx damage on average across types, with %d HP, %d Def and %d SpD",
+			rating.Score,
+			rating.TypeMultiplier,
+			rating.HP,
+			rating.Defense,
+			rating.SpecialDefense,
+		),
+	}, nil
+}
+
+// statBarWidth is the number of characters in a full stat bar, which stays
+// narrow enough for the stats to fit on mobile without wrapping.
+const statBarWidth = 10
+
+// statBarBlocks are the partial blocks making up the end of a stat bar, in
+// eighths of a character.
+var statBarBlocks = []rune(" ▏▎▍▌▋▊▉")
+
+// statBar draws a bar for the value, scaled so that max fills the whole width.
+func statBar(value int, max int, width int) string {
+	if max <= 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	eighths := value * width * 8 / max
+	if eighths > width*8 {
+		eighths = width * 8
+	}
+
+	bar := strings.Repeat("█", eighths/8)
+	if eighths%8 > 0 {
+		bar += string(statBarBlocks[eighths%8])
+	}
+
+	return bar + strings.Repeat(" ", width-utf8.RuneCountInString(bar))
+}
+
+// statFields lists the base stats of the pokemon, along with their rank in the
+// generation if ranks is set, either as an inline field per stat or, with bars,
+// as a single aligned chart scaled against the highest base stat in the
+// generation.
+func (resp dexResponder) statFields(
+	ctx context.Context,
+	mdl *model.Model,
+	pokemon *model.Pokemon,
+	bars bool,
+	ranks bool,
+) ([]*discordgo.MessageEmbedField, error) {
+	is, err := mdl.IntrinsicStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting all intrinsic stats: %w", err)
+	}
+
+	type statLine struct {
+		name string
+		base int
+		rank *model.StatRank
+	}
+	lines := make([]statLine, len(is))
+	for i, stat := range is {
+		bs, err := pokemon.BaseStat(ctx, stat)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+		}
+
+		name, err := stat.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for stat: %w", err)
+		}
+
+		lines[i] = statLine{name: name, base: bs}
+		if ranks {
+			lines[i].rank, err = pokemon.StatRank(ctx, stat)
+			if err != nil {
+				return nil, fmt.Errorf("error while ranking base stat for pokemon: %w", err)
+			}
+		}
+	}
+
+	if !bars {
+		fields := make([]*discordgo.MessageEmbedField, len(lines))
+		for i, line := range lines {
+			value := strconv.Itoa(line.base)
+			if line.rank != nil {
+				value = fmt.Sprintf("%s `#%d/%d`", value, line.rank.Rank, line.rank.Total)
+			}
+			fields[i] = &discordgo.MessageEmbedField{
+				Name:   line.name,
+				Value:  value,
+				Inline: true,
+			}
+		}
+		return fields, nil
+	}
+
+	max, err := mdl.MaxBaseStat(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nameWidth := 0
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line.name); n > nameWidth {
+			nameWidth = n
+		}
+	}
+
+	rows := make([]string, len(lines))
+	for i, line := range lines {
+		padding := strings.Repeat(" ", nameWidth-utf8.RuneCountInString(line.name))
+		rows[i] = fmt.Sprintf("%s%s %3d %s", line.name, padding, line.base, statBar(line.base, max, statBarWidth))
+		if line.rank != nil {
+			rows[i] = fmt.Sprintf("%s #%d/%d", rows[i], line.rank.Rank, line.rank.Total)
+		}
+	}
+
+	return []*discordgo.MessageEmbedField{
+		{
+			Name:  "Base Stats",
+			Value: fmt.Sprintf("```\n%s\n```", strings.Join(rows, "\n")),
+		},
+	}, nil
+}
+
+func (resp dexResponder) handleMove(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	move, err := mdl.MoveByName(ctx, name)
+	if err != nil {
+		return moveNotFound(ctx, mdl, name, err)
+	}
+
+	moveName, err := move.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get localized name for move %q: %w", move.Name, err)
+	}
+
+	titleStrings := []string{moveName}
+	typ, err := move.Type(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+	}
+	if !typ.IsPseudo() {
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
+		}
+		titleStrings = append(titleStrings, emoji)
+	}
+
+	class, err := move.DamageClass(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+	}
+	classEmoji, err := resp.emojis.Emoji(class.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error while constructing damage class emoji string for move %q: %w", move.Name, err)
+	}
+	titleStrings = append(titleStrings, classEmoji)
+
+	var flavorText string
+	if resp.moveSections.FlavorText {
+		flavorText, err = move.FlavorText(ctx)
+		if err != nil && !errors.Is(err, model.ErrNoFlavorText) {
+			return nil, fmt.Errorf("could not get flavor text for move %q: %w", move.Name, err)
+		}
+	}
+
+	optionalStat := func(stat *int, format string) string {
+		if stat == nil {
+			return "—"
+		}
+		return fmt.Sprintf(format, *stat)
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   "Power",
+			Value:  optionalStat(move.Power, "%d"),
+			Inline: true,
+		},
+		{
+			Name:   "Accuracy",
+			Value:  optionalStat(move.Accuracy, "%d%%"),
+			Inline: true,
+		},
+		{
+			Name:   "PP",
+			Value:  optionalStat(move.PP, "%d"),
+			Inline: true,
+		},
+	}
+
+	sectionFields, err := resp.moveSectionFields(ctx, move)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, sectionFields...)
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       strings.Join(titleStrings, " "),
+				Description: flavorText,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+// moveSectionFields builds the fields for the optional move sections enabled in
+// the configuration.
+func (resp dexResponder) moveSectionFields(ctx context.Context, move *model.Move) ([]*discordgo.MessageEmbedField, error) {
+	var fields []*discordgo.MessageEmbedField
+
+	if resp.moveSections.Meta {
+		meta, err := move.Meta(ctx)
+		if err != nil && !errors.Is(err, model.ErrNoMoveMeta) {
+			return nil, fmt.Errorf("could not get meta for move %q: %w", move.Name, err)
+		}
+
+		if meta != nil {
+			var lines []string
+			if meta.MinHits != nil && meta.MaxHits != nil {
+				if *meta.MinHits == *meta.MaxHits {
+					lines = append(lines, fmt.Sprintf("Hits %d times", *meta.MinHits))
+				} else {
+					lines = append(lines, fmt.Sprintf("Hits %d-%d times", *meta.MinHits, *meta.MaxHits))
+				}
+			}
+			if meta.CritRate > 0 {
+				lines = append(lines, fmt.Sprintf("Critical hit stage +%d", meta.CritRate))
+			}
+			if meta.HasAilment() && meta.AilmentChance > 0 {
+				lines = append(lines, fmt.Sprintf("%d%% chance of %s", meta.AilmentChance, meta.Ailment))
+			}
+			if meta.FlinchChance > 0 {
+				lines = append(lines, fmt.Sprintf("%d%% chance to flinch", meta.FlinchChance))
+			}
+			if meta.StatChance > 0 {
+				lines = append(lines, fmt.Sprintf("%d%% chance of stat change", meta.StatChance))
+			}
+			if meta.Drain > 0 {
+				lines = append(lines, fmt.Sprintf("Drains %d%% of damage dealt", meta.Drain))
+			} else if meta.Drain < 0 {
+				lines = append(lines, fmt.Sprintf("Recoil of %d%% of damage dealt", -meta.Drain))
+			}
+			if meta.Healing > 0 {
+				lines = append(lines, fmt.Sprintf("Heals %d%% of max HP", meta.Healing))
+			}
+
+			if len(lines) > 0 {
+				fields = append(fields, &discordgo.MessageEmbedField{
+					Name:  "Effects",
+					Value: strings.Join(lines, "\n"),
+				})
+			}
+		}
+	}
+
+	if resp.moveSections.Flags {
+		flags, err := move.Flags(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get flags for move %q: %w", move.Name, err)
+		}
+
+		if len(flags) > 0 {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  "Flags",
+				Value: strings.Join(flags, "\n"),
+			})
+		}
+	}
+
+	if resp.moveSections.History {
+		lines, err := resp.moveHistoryLines(ctx, move)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "History",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+
+	if resp.moveSections.Contest {
+		contest, err := move.Contest(ctx)
+		if err != nil && !errors.Is(err, model.ErrNoContestData) {
+			return nil, fmt.Errorf("could not get contest data for move %q: %w", move.Name, err)
+		}
+
+		if contest != nil {
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  "Contest",
+				Value: fmt.Sprintf("%s (Appeal %d, Jam %d)", contest.Type, contest.Appeal, contest.Jam),
+			})
+		}
+	}
+
+	return fields, nil
+}
+
+// moveHistoryLines describes when the move was introduced and how each later
+// version group changed its stats.
+func (resp dexResponder) moveHistoryLines(ctx context.Context, move *model.Move) ([]string, error) {
+	gen, err := move.IntroducedGeneration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get introduced generation for move %q: %w", move.Name, err)
+	}
+	genName, err := gen.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+	}
+	lines := []string{fmt.Sprintf("Introduced in %s", genName)}
+
+	changes, err := move.History(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get history for move %q: %w", move.Name, err)
+	}
+
+	optionalStat := func(stat *int, format string) string {
+		if stat == nil {
+			return "—"
+		}
+		return fmt.Sprintf(format, *stat)
+	}
+	for i := range changes {
+		change := &changes[i]
+
+		var deltas []string
+		if change.Power != nil {
+			deltas = append(deltas, fmt.Sprintf("Power %s→%s", optionalStat(change.Power, "%d"), optionalStat(change.NewPower, "%d")))
+		}
+		if change.Accuracy != nil {
+			deltas = append(deltas, fmt.Sprintf("Accuracy %s→%s", optionalStat(change.Accuracy, "%d%%"), optionalStat(change.NewAccuracy, "%d%%")))
+		}
+		if change.PP != nil {
+			deltas = append(deltas, fmt.Sprintf("PP %s→%s", optionalStat(change.PP, "%d"), optionalStat(change.NewPP, "%d")))
+		}
+		oldType, err := change.Type(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get previous type for move %q: %w", move.Name, err)
+		}
+		newType, err := change.NewType(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get new type for move %q: %w", move.Name, err)
+		}
+		if oldType != nil && newType != nil {
+			oldEmoji, err := resp.emojis.Emoji(oldType.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
+			}
+			newEmoji, err := resp.emojis.Emoji(newType.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
+			}
+			deltas = append(deltas, fmt.Sprintf("Type %s→%s", oldEmoji, newEmoji))
+		}
+		if len(deltas) == 0 {
+			continue
+		}
+
+		vg, err := change.VersionGroup(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get version group for change to move %q: %w", move.Name, err)
+		}
+		vgName, err := versionGroupName(ctx, vg)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, fmt.Sprintf("Changed in %s: %s", vgName, strings.Join(deltas, ", ")))
+	}
+
+	return lines, nil
+}
+
 func (resp dexResponder) Autocomplete(
 	ctx context.Context,
 	mdl *model.Model,
@@ -222,13 +790,28 @@ func (resp dexResponder) Autocomplete(
 	switch {
 	case opt.Pokemon != nil:
 		if opt.Pokemon.Name.Focused {
-			s := pokemonSearcher{
-				model:  mdl,
-				prefix: opt.Pokemon.Name.Value,
-				limit:  resp.autocompleteLimit,
+			s := favoritePokemonSearcher{
+				pokemonSearcher: pokemonSearcher{
+					model:      mdl,
+					prefix:     opt.Pokemon.Name.Value,
+					limit:      resp.autocompleteLimits.Pokemon,
+					order:      resp.pokemonOrder,
+					dexNumbers: resp.dexNumbers,
+				},
+				store:  resp.store,
+				userID: interactionUserID(interaction),
 			}
 			return searchChoices[*model.Pokemon](ctx, s)
 		}
+	case opt.Move != nil:
+		if opt.Move.Name.Focused {
+			s := moveSearcher{
+				model:  mdl,
+				prefix: opt.Move.Name.Value,
+				limit:  resp.autocompleteLimits.Move,
+			}
+			return searchChoices[*model.Move](ctx, s)
+		}
 	default:
 		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
 	}
@@ -237,10 +820,16 @@ func (resp dexResponder) Autocomplete(
 }
 
 func (builder *Builder) dex(ctx context.Context) (Command, error) {
+	minDexNumber := float64(1)
+
 	resp := dexResponder{
-		autocompleteLimit: builder.config.AutocompleteLimit,
-		emojis:            builder.emojis,
-		commands:          builder.commands,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+		commands:           builder.commands,
+		moveSections:       builder.config.MoveSections,
+		store:              builder.store,
 	}
 
 	return command[dexOptions]{
@@ -262,6 +851,82 @@ func (builder *Builder) dex(ctx context.Context) (Command, error) {
 							Required:     true,
 							Autocomplete: true,
 						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "stat_bars",
+							Description: "Draw the base stats as bars",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "stat_ranks",
+							Description: "Rank each base stat against the other Pokemon in the generation",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "defensive_rating",
+							Description: "Rate the Pokemon's bulk after its type matchups (heuristic)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "unavailable_abilities",
+							Description: "Also list current abilities the Pokemon could not have in the selected game",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "move",
+					Description: "Fetch data for a move",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "move",
+							Description:  "Name of the move",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "number",
+					Description: "Fetch data for a Pokemon by national dex number",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "number",
+							Description: "National dex number of the Pokemon",
+							Required:    true,
+							MinValue:    &minDexNumber,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "stat_bars",
+							Description: "Draw the base stats as bars",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "stat_ranks",
+							Description: "Rank each base stat against the other Pokemon in the generation",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "defensive_rating",
+							Description: "Rate the Pokemon's bulk after its type matchups (heuristic)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "unavailable_abilities",
+							Description: "Also list current abilities the Pokemon could not have in the selected game",
+							Required:    false,
+						},
 					},
 				},
 			},
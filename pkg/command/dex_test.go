@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestDexStatFields(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		language model.LocalizationCode
+		names    []string
+	}{
+		{language: "en", names: []string{"HP", "Attack", "Defense", "Special Attack", "Special Defense", "Speed"}},
+		{language: "fr", names: []string{"PV", "Attaque", "Défense", "Attaque Spéciale", "Défense Spéciale", "Vitesse"}},
+		{language: "cs", names: []string{"HP", "Attack", "Defense", "Special Attack", "Special Defense", "Speed"}},
+	}
+	bases := []string{"78", "84", "78", "109", "85", "100"}
+	for _, test := range tests {
+		t.Run(string(test.language), func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetLanguageByLocalizationCode(ctx, test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pokemon, err := mdl.PokemonByName(ctx, "charizard")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, ranks := range []bool{false, true} {
+				fields, err := dexResponder{}.statFields(ctx, mdl, pokemon, false, ranks)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(fields) != len(test.names) {
+					t.Fatalf("statFields returned %d fields, want %d", len(fields), len(test.names))
+				}
+				for i, field := range fields {
+					if field.Name != test.names[i] || strings.Fields(field.Value)[0] != bases[i] {
+						t.Errorf("field %d = %q: %q, want %q with base stat %s", i, field.Name, field.Value, test.names[i], bases[i])
+					}
+					if strings.Contains(field.Value, "#") != ranks {
+						t.Errorf("field %q = %q, want ranks shown to be %t", field.Name, field.Value, ranks)
+					}
+				}
+
+				fields, err = dexResponder{}.statFields(ctx, mdl, pokemon, true, ranks)
+				if err != nil {
+					t.Fatal(err)
+				}
+				rows := strings.Split(strings.Trim(fields[0].Value, "`\n"), "\n")
+				if len(rows) != len(test.names) {
+					t.Fatalf("stat chart has %d rows, want %d", len(rows), len(test.names))
+				}
+				for i, row := range rows {
+					fields := strings.Fields(strings.TrimPrefix(row, test.names[i]))
+					if !strings.HasPrefix(row, test.names[i]) || len(fields) == 0 || fields[0] != bases[i] {
+						t.Errorf("stat chart row %d = %q, want %q with base stat %s", i, row, test.names[i], bases[i])
+					}
+					if strings.Contains(row, "#") != ranks {
+						t.Errorf("stat chart row %d = %q, want ranks shown to be %t", i, row, ranks)
+					}
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkDexHandle times assembling the full /dex card for a pokemon, after a
+// warm-up call so that it measures the card with the model caches filled.
+func BenchmarkDexHandle(b *testing.B) {
+	ctx := context.Background()
+	mdl := modeltest.Bench(b)
+	resp := dexResponder{emojis: testEmojis(
+		"normal", "fighting", "flying", "poison", "ground", "rock", "bug", "ghost", "steel",
+		"fire", "water", "grass", "electric", "psychic", "ice", "dragon", "dark", "fairy",
+	)}
+	resp.commands = commands{
+		"dex":      command[dexOptions]{handler: resp, command: discordgo.ApplicationCommand{Name: "dex"}},
+		"learnset": command[learnsetOptions]{command: discordgo.ApplicationCommand{Name: "learnset"}},
+		"weak":     command[weakOptions]{command: discordgo.ApplicationCommand{Name: "weak"}},
+	}
+	interaction := teamTestInteraction("1")
+	// the fixture has no sprites, and the placeholder is logged on every call
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+	enabled := true
+	opt := &dexOptions{Pokemon: &dexPokemonOptions{
+		Name:                 discordField[string]{Value: "charizard"},
+		StatBars:             &enabled,
+		StatRanks:            &enabled,
+		DefensiveRating:      &enabled,
+		UnavailableAbilities: &enabled,
+	}}
+
+	_, err := resp.Handle(ctx, mdl, nil, interaction, opt)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := resp.Handle(ctx, mdl, nil, interaction, opt)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// limits imposed by discord on embeds; exceeding any of them causes the whole
+// response to be rejected
+const (
+	maxEmbeds             = 10
+	maxEmbedTitle         = 256
+	maxEmbedDescription   = 4096
+	maxEmbedFields        = 25
+	maxEmbedFieldName     = 256
+	maxEmbedFooter        = 2048
+	maxEmbedAuthor        = 256
+	maxEmbedTotalLength   = 6000
+	truncationPlaceholder = "…"
+)
+
+func truncate(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+
+	runes := []rune(s)
+	return string(runes[:limit-utf8.RuneCountInString(truncationPlaceholder)]) + truncationPlaceholder
+}
+
+func fieldLength(field *discordgo.MessageEmbedField) int {
+	return utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+}
+
+func embedLength(embed *discordgo.MessageEmbed) int {
+	length := utf8.RuneCountInString(embed.Title) + utf8.RuneCountInString(embed.Description)
+	if embed.Footer != nil {
+		length += utf8.RuneCountInString(embed.Footer.Text)
+	}
+	if embed.Author != nil {
+		length += utf8.RuneCountInString(embed.Author.Name)
+	}
+	for _, field := range embed.Fields {
+		length += fieldLength(field)
+	}
+
+	return length
+}
+
+// limitEmbeds truncates the embeds of a response so that they fit within
+// discord's limits. Fields are dropped from the end once the combined length of
+// the embeds would exceed the total limit, followed by whole embeds.
+func limitEmbeds(embeds []*discordgo.MessageEmbed) []*discordgo.MessageEmbed {
+	if len(embeds) > maxEmbeds {
+		embeds = embeds[:maxEmbeds]
+	}
+
+	for _, embed := range embeds {
+		embed.Title = truncate(embed.Title, maxEmbedTitle)
+		embed.Description = truncate(embed.Description, maxEmbedDescription)
+		if embed.Footer != nil {
+			embed.Footer.Text = truncate(embed.Footer.Text, maxEmbedFooter)
+		}
+		if embed.Author != nil {
+			embed.Author.Name = truncate(embed.Author.Name, maxEmbedAuthor)
+		}
+
+		if len(embed.Fields) > maxEmbedFields {
+			embed.Fields = embed.Fields[:maxEmbedFields]
+		}
+		for _, field := range embed.Fields {
+			field.Name = truncate(field.Name, maxEmbedFieldName)
+			field.Value = truncate(field.Value, maxFieldValueLength)
+		}
+	}
+
+	total := 0
+	for i, embed := range embeds {
+		length := embedLength(embed)
+		if total+length <= maxEmbedTotalLength {
+			total += length
+			continue
+		}
+
+		for len(embed.Fields) > 0 && total+length > maxEmbedTotalLength {
+			length -= fieldLength(embed.Fields[len(embed.Fields)-1])
+			embed.Fields = embed.Fields[:len(embed.Fields)-1]
+		}
+		if total+length > maxEmbedTotalLength {
+			return embeds[:i]
+		}
+
+		return embeds[:i+1]
+	}
+
+	return embeds
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestLimitEmbedsTruncatesAtLimits(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		get   func(*discordgo.MessageEmbed) *string
+		set   func(*discordgo.MessageEmbed, string)
+	}{
+		{
+			name:  "title",
+			limit: maxEmbedTitle,
+			get:   func(embed *discordgo.MessageEmbed) *string { return &embed.Title },
+			set:   func(embed *discordgo.MessageEmbed, s string) { embed.Title = s },
+		},
+		{
+			name:  "field value",
+			limit: maxFieldValueLength,
+			get:   func(embed *discordgo.MessageEmbed) *string { return &embed.Fields[0].Value },
+			set: func(embed *discordgo.MessageEmbed, s string) {
+				embed.Fields = []*discordgo.MessageEmbedField{{Name: "name", Value: s}}
+			},
+		},
+	}
+	for _, test := range tests {
+		// accented characters check that limits count characters, not bytes
+		for _, length := range []int{test.limit - 1, test.limit, test.limit + 1} {
+			var embed discordgo.MessageEmbed
+			test.set(&embed, strings.Repeat("é", length))
+
+			limitEmbeds([]*discordgo.MessageEmbed{&embed})
+			got := *test.get(&embed)
+			want := length
+			if want > test.limit {
+				want = test.limit
+			}
+			if n := utf8.RuneCountInString(got); n != want {
+				t.Errorf("%s of %d characters was limited to %d, want %d", test.name, length, n, want)
+			}
+			if truncated := strings.HasSuffix(got, truncationPlaceholder); truncated != (length > test.limit) {
+				t.Errorf("%s of %d characters is truncated: %t, want %t", test.name, length, truncated, length > test.limit)
+			}
+		}
+	}
+}
+
+func TestLimitEmbedsFieldCount(t *testing.T) {
+	for _, count := range []int{maxEmbedFields - 1, maxEmbedFields, maxEmbedFields + 1} {
+		fields := make([]*discordgo.MessageEmbedField, count)
+		for i := range fields {
+			fields[i] = &discordgo.MessageEmbedField{Name: "name", Value: "value"}
+		}
+		embed := &discordgo.MessageEmbed{Fields: fields}
+
+		limitEmbeds([]*discordgo.MessageEmbed{embed})
+		want := count
+		if want > maxEmbedFields {
+			want = maxEmbedFields
+		}
+		if len(embed.Fields) != want {
+			t.Errorf("embed with %d fields kept %d, want %d", count, len(embed.Fields), want)
+		}
+	}
+}
+
+func TestLimitEmbedsTotalLength(t *testing.T) {
+	// each field is 1000 characters, so six of them fill the total limit
+	field := func() *discordgo.MessageEmbedField {
+		return &discordgo.MessageEmbedField{Name: "name", Value: strings.Repeat("v", 996)}
+	}
+	fields := func(n int) []*discordgo.MessageEmbedField {
+		fields := make([]*discordgo.MessageEmbedField, n)
+		for i := range fields {
+			fields[i] = field()
+		}
+		return fields
+	}
+
+	tests := []struct {
+		name       string
+		embeds     []*discordgo.MessageEmbed
+		wantEmbeds int
+		wantFields int
+	}{
+		{
+			name:       "at the limit",
+			embeds:     []*discordgo.MessageEmbed{{Fields: fields(6)}},
+			wantEmbeds: 1,
+			wantFields: 6,
+		},
+		{
+			name:       "one character over",
+			embeds:     []*discordgo.MessageEmbed{{Title: "t", Fields: fields(6)}},
+			wantEmbeds: 1,
+			wantFields: 5,
+		},
+		{
+			name:       "second embed over",
+			embeds:     []*discordgo.MessageEmbed{{Fields: fields(6)}, {Title: "t"}},
+			wantEmbeds: 1,
+			wantFields: 6,
+		},
+		{
+			name:       "second embed loses fields",
+			embeds:     []*discordgo.MessageEmbed{{Fields: fields(3)}, {Fields: fields(4)}},
+			wantEmbeds: 2,
+			wantFields: 3,
+		},
+	}
+	for _, test := range tests {
+		embeds := limitEmbeds(test.embeds)
+		if len(embeds) != test.wantEmbeds {
+			t.Fatalf("%s: kept %d embeds, want %d", test.name, len(embeds), test.wantEmbeds)
+		}
+
+		total := 0
+		for _, embed := range embeds {
+			total += embedLength(embed)
+		}
+		if total > maxEmbedTotalLength {
+			t.Errorf("%s: embeds total %d characters, over the limit of %d", test.name, total, maxEmbedTotalLength)
+		}
+		if fields := len(embeds[len(embeds)-1].Fields); fields != test.wantFields {
+			t.Errorf("%s: last embed kept %d fields, want %d", test.name, fields, test.wantFields)
+		}
+	}
+}
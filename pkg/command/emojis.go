@@ -3,24 +3,68 @@ package command
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 )
 
-type Emojis map[string]*discordgo.Emoji
+// Emojis holds the resource emojis by key. Copies share the same set, which is
+// replaced as a whole by Store, so it is safe to read from handlers while the
+// resource guild's emojis are reloaded.
+type Emojis struct {
+	byKey *atomic.Pointer[map[string]*discordgo.Emoji]
+}
+
+func NewEmojis() Emojis {
+	emojis := Emojis{byKey: &atomic.Pointer[map[string]*discordgo.Emoji]{}}
+	emojis.Store(map[string]*discordgo.Emoji{})
+	return emojis
+}
+
+// Store replaces the emojis with byKey, which must not be modified afterwards.
+func (emojis Emojis) Store(byKey map[string]*discordgo.Emoji) {
+	emojis.byKey.Store(&byKey)
+}
 
 var ErrNoEmoji = errors.New("no matching emoji")
 
 func (emojis Emojis) Emoji(name string) (string, error) {
-	emoji1, ok := emojis[name+"1"]
+	byKey := *emojis.byKey.Load()
+	emoji1, ok := byKey[name+"1"]
 	if !ok {
 		return "", fmt.Errorf("could not find first emoji for resource %q: %w", name, ErrNoEmoji)
 	}
 
-	emoji2, ok := emojis[name+"2"]
+	emoji2, ok := byKey[name+"2"]
 	if !ok {
 		return "", fmt.Errorf("could not find second emoji for resource %q: %w", name, ErrNoEmoji)
 	}
 
 	return fmt.Sprintf("<:%v:%v><:%v:%v>", emoji1.Name, emoji1.ID, emoji2.Name, emoji2.ID), nil
 }
+
+// EmojiKey maps the name of an emoji in the resource guild to its key in Emojis,
+// stripping the configured prefix and suffix. Emojis not following the naming
+// scheme are not resource emojis.
+func EmojiKey(name string, naming config.EmojiNaming) (string, bool) {
+	if len(name) == 0 {
+		return "", false
+	}
+	index := name[len(name)-1:]
+	if index != "1" && index != "2" {
+		return "", false
+	}
+
+	resource := name[:len(name)-1]
+	if !strings.HasPrefix(resource, naming.Prefix) || !strings.HasSuffix(resource, naming.Suffix) {
+		return "", false
+	}
+	resource = strings.TrimSuffix(strings.TrimPrefix(resource, naming.Prefix), naming.Suffix)
+	if len(resource) == 0 || len(resource)+len(naming.Prefix)+len(naming.Suffix)+1 != len(name) {
+		return "", false
+	}
+
+	return resource + index, true
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type exportOptions struct {
+	Pokemon *struct {
+		Name discordField[string] `option:"pokemon"`
+	} `option:"pokemon"`
+}
+
+type exportResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+}
+
+func (resp exportResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *exportOptions,
+) (*discordgo.InteractionResponseData, error) {
+	switch {
+	case opt.Pokemon != nil:
+		pokemon, err := mdl.PokemonByName(ctx, opt.Pokemon.Name.Value)
+		if err != nil {
+			return pokemonNotFound(ctx, mdl, opt.Pokemon.Name.Value, err)
+		}
+
+		data, err := pokemon.MarshalData(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect data for pokemon %q: %w", pokemon.Name, err)
+		}
+
+		b, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("could not encode data for pokemon %q: %w", pokemon.Name, err)
+		}
+
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Exported data for %s.", data.LocalizedName),
+			Files: []*discordgo.File{
+				{
+					Name:        fmt.Sprintf("%s.json", pokemon.Name),
+					ContentType: "application/json",
+					Reader:      bytes.NewReader(b),
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"export\": %w", ErrCommandFormat)
+	}
+}
+
+func (resp exportResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *exportOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Pokemon != nil:
+		if opt.Pokemon.Name.Focused {
+			s := pokemonSearcher{
+				model:      mdl,
+				prefix:     opt.Pokemon.Name.Value,
+				limit:      resp.autocompleteLimits.Pokemon,
+				order:      resp.pokemonOrder,
+				dexNumbers: resp.dexNumbers,
+			}
+			return searchChoices[*model.Pokemon](ctx, s)
+		}
+	default:
+		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
+	}
+
+	return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+}
+
+func (builder *Builder) export(ctx context.Context) (Command, error) {
+	resp := exportResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+	}
+
+	return command[exportOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "export",
+			Description: "Export game data for a specified resource as JSON.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "pokemon",
+					Description: "Export data for a Pokemon",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "pokemon",
+							Description:  "Name of the Pokemon",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,290 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
+)
+
+type favoriteOptions struct {
+	Add *struct {
+		Name discordField[string] `option:"pokemon"`
+	} `option:"add"`
+	Remove *struct {
+		Name discordField[string] `option:"pokemon"`
+	} `option:"remove"`
+	List *struct{} `option:"list"`
+}
+
+type favoriteResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	store              *store.UserStore
+}
+
+// favoritePokemon returns the default form of each species a user has
+// favorited, along with how many favorites do not exist in the model's
+// generation.
+func favoritePokemon(
+	ctx context.Context,
+	mdl *model.Model,
+	st *store.UserStore,
+	userID string,
+) ([]*model.Pokemon, int, error) {
+	ids, err := st.FavoritePokemon(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error while getting favorite pokemon: %w", err)
+	}
+
+	pokemon := make([]*model.Pokemon, 0, len(ids))
+	missing := 0
+	for _, id := range ids {
+		p, err := mdl.PokemonByDexNumber(ctx, id)
+		if errors.Is(err, model.ErrWrongGeneration) {
+			missing++
+			continue
+		} else if err != nil {
+			return nil, 0, fmt.Errorf("error while getting favorite pokemon %d: %w", id, err)
+		}
+		pokemon = append(pokemon, p)
+	}
+
+	return pokemon, missing, nil
+}
+
+func (resp favoriteResponder) lookup(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+) (*model.Pokemon, *discordgo.InteractionResponseData) {
+	pokemon, err := mdl.PokemonByName(ctx, name)
+	if errors.Is(err, model.ErrWrongGeneration) {
+		return nil, &discordgo.InteractionResponseData{
+			Content: "The specified pokemon does not exist in this generation.",
+		}
+	} else if err != nil {
+		return nil, &discordgo.InteractionResponseData{
+			Content: "No Pokemon found with that name.",
+		}
+	}
+
+	return pokemon, nil
+}
+
+func (resp favoriteResponder) handleAdd(
+	ctx context.Context,
+	mdl *model.Model,
+	userID string,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, msg := resp.lookup(ctx, mdl, name)
+	if msg != nil {
+		return msg, nil
+	}
+
+	localized, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	added, err := resp.store.AddFavoritePokemon(ctx, userID, pokemon.SpeciesID)
+	if errors.Is(err, store.ErrTooManyFavorites) {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("You can have at most %d favorite Pokemon.", store.MaxFavorites),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not add favorite: %w", err)
+	}
+
+	if !added {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("%s is already one of your favorites.", localized),
+		}, nil
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("Added %s to your favorites.", localized),
+	}, nil
+}
+
+func (resp favoriteResponder) handleRemove(
+	ctx context.Context,
+	mdl *model.Model,
+	userID string,
+	name string,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, msg := resp.lookup(ctx, mdl, name)
+	if msg != nil {
+		return msg, nil
+	}
+
+	localized, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	removed, err := resp.store.RemoveFavoritePokemon(ctx, userID, pokemon.SpeciesID)
+	if err != nil {
+		return nil, fmt.Errorf("could not remove favorite: %w", err)
+	}
+
+	if !removed {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("%s is not one of your favorites.", localized),
+		}, nil
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("Removed %s from your favorites.", localized),
+	}, nil
+}
+
+func (resp favoriteResponder) handleList(
+	ctx context.Context,
+	mdl *model.Model,
+	userID string,
+) (*discordgo.InteractionResponseData, error) {
+	favorites, missing, err := favoritePokemon(ctx, mdl, resp.store, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(favorites) == 0 && missing == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: "You have no favorite Pokemon. Add one with `/favorite add`.",
+		}, nil
+	}
+
+	lines := make([]string, len(favorites))
+	for i, pokemon := range favorites {
+		name, err := pokemon.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+		}
+		lines[i] = fmt.Sprintf("#%d %s", pokemon.SpeciesID, name)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "Favorite Pokemon",
+		Description: strings.Join(lines, "\n"),
+	}
+	if len(lines) == 0 {
+		embed.Description = "_None in this generation_"
+	}
+	if missing > 0 {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("%d more do not exist in this generation.", missing),
+		}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func (resp favoriteResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *favoriteOptions,
+) (*discordgo.InteractionResponseData, error) {
+	userID := interactionUserID(interaction)
+	switch {
+	case opt.Add != nil:
+		return resp.handleAdd(ctx, mdl, userID, opt.Add.Name.Value)
+	case opt.Remove != nil:
+		return resp.handleRemove(ctx, mdl, userID, opt.Remove.Name.Value)
+	case opt.List != nil:
+		return resp.handleList(ctx, mdl, userID)
+	default:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"favorite\": %w", ErrCommandFormat)
+	}
+}
+
+func (resp favoriteResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *favoriteOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	s := favoritePokemonSearcher{
+		pokemonSearcher: pokemonSearcher{
+			model:      mdl,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		},
+		store:  resp.store,
+		userID: interactionUserID(interaction),
+	}
+
+	switch {
+	case opt.Add != nil && opt.Add.Name.Focused:
+		s.prefix = opt.Add.Name.Value
+		return searchChoices[*model.Pokemon](ctx, s.pokemonSearcher)
+	case opt.Remove != nil && opt.Remove.Name.Focused:
+		s.prefix = opt.Remove.Name.Value
+		s.favoritesOnly = true
+		return searchChoices[*model.Pokemon](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) favorite(ctx context.Context) (Command, error) {
+	resp := favoriteResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		store:              builder.store,
+	}
+
+	pokemonOption := func(description string) []*discordgo.ApplicationCommandOption {
+		return []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "pokemon",
+				Description:  description,
+				Required:     true,
+				Autocomplete: true,
+			},
+		}
+	}
+
+	return command[favoriteOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "favorite",
+			Description: "Save your favorite Pokemon, which are suggested first when looking up Pokemon.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a Pokemon to your favorites",
+					Options:     pokemonOption("Name of the Pokemon to add"),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a Pokemon from your favorites",
+					Options:     pokemonOption("Name of the favorite to remove"),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List your favorite Pokemon",
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,203 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type formOptions struct {
+	PokemonName discordField[string]  `option:"pokemon"`
+	FormName    *discordField[string] `option:"form"`
+}
+
+type formResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+	dex                dexResponder
+}
+
+func (resp formResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *formOptions,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, opt.PokemonName.Value, err)
+	}
+
+	forms, err := pokemon.Forms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get forms for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	if opt.FormName != nil {
+		for _, form := range forms {
+			if form.Name == opt.FormName.Value {
+				return resp.dex.Handle(ctx, mdl, sess, interaction, &dexOptions{
+					Pokemon: &dexPokemonOptions{
+						Name: discordField[string]{
+							Value: form.Name,
+						},
+					},
+				})
+			}
+		}
+
+		return &discordgo.InteractionResponseData{
+			Content: "The specified form does not exist for this Pokemon in this generation.",
+		}, nil
+	}
+
+	pokemonName, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	values := make([]string, len(forms))
+	for i, form := range forms {
+		name, err := form.LocalizedFormName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized form name for pokemon %q: %w", form.Name, err)
+		}
+
+		combo, err := form.TypeCombo(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get type combo for pokemon %q: %w", form.Name, err)
+		}
+
+		emojis := make([]string, 0, 3)
+		for _, typ := range combo.Types() {
+			emoji, err := resp.emojis.Emoji(typ.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+			}
+			emojis = append(emojis, emoji)
+		}
+		values[i] = fmt.Sprintf("%s %s", strings.Join(emojis, ""), name)
+	}
+
+	fields, _ := chunkFields("Forms", values, "\n", maxEmbedFields)
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:  pokemonName,
+				Fields: fields,
+			},
+		},
+	}, nil
+}
+
+func (resp formResponder) formChoices(
+	ctx context.Context,
+	mdl *model.Model,
+	opt *formOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	pokemon, err := mdl.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return nil, nil
+	}
+
+	forms, err := pokemon.Forms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get forms for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	prefix := strings.ToLower(opt.FormName.Value)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, resp.autocompleteLimits.Pokemon)
+	for _, form := range forms {
+		if len(choices) == resp.autocompleteLimits.Pokemon {
+			break
+		}
+
+		name, err := form.LocalizedFormName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized form name for pokemon %q: %w", form.Name, err)
+		}
+
+		if strings.Contains(strings.ToLower(name), prefix) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  name,
+				Value: form.Name,
+			})
+		}
+	}
+
+	return choices, nil
+}
+
+func (resp formResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *formOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	case opt.FormName != nil && opt.FormName.Focused:
+		return resp.formChoices(ctx, mdl, opt)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) form(ctx context.Context) (Command, error) {
+	resp := formResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+		dex: dexResponder{
+			autocompleteLimits: builder.config.AutocompleteLimits,
+			pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+			dexNumbers:         builder.config.AutocompleteDexNumbers,
+			emojis:             builder.emojis,
+			commands:           builder.commands,
+			moveSections:       builder.config.MoveSections,
+		},
+	}
+
+	return command[formOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "form",
+			Description: "Browse the forms of a Pokemon.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "form",
+					Description:  "Form to show data for",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
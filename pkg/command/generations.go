@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type generationsOptions struct{}
+
+type generationsResponder struct{}
+
+func (resp generationsResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *generationsOptions,
+) (*discordgo.InteractionResponseData, error) {
+	if mdl.Version == nil {
+		return nil, fmt.Errorf("could not get current generation: %w", model.ErrUnsetVersion)
+	}
+	current, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+
+	gens, err := mdl.AllGenerations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get all generations: %w", err)
+	}
+
+	latest, err := mdl.LatestGeneration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest generation: %w", err)
+	}
+
+	lines := make([]string, len(gens))
+	for i, gen := range gens {
+		name, err := gen.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+		}
+
+		if gen.ID == current.ID {
+			name = fmt.Sprintf("**%s** (current)", name)
+		}
+		if gen.ID == latest.ID {
+			name = fmt.Sprintf("%s (latest)", name)
+		}
+		lines[i] = name
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       "Generations",
+				Description: strings.Join(lines, "\n"),
+			},
+		},
+	}, nil
+}
+
+func (builder *Builder) generations(ctx context.Context) (Command, error) {
+	return command[generationsOptions]{
+		handler: generationsResponder{},
+		command: discordgo.ApplicationCommand{
+			Name:        "generations",
+			Description: "List every Pokemon generation.",
+		},
+	}, nil
+}
@@ -6,13 +6,16 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 type languageOptions struct {
 	LocalizationCode *string `option:"language"`
 }
 
-type languageResponder struct{}
+type languageResponder struct {
+	store *store.UserStore
+}
 
 func (resp languageResponder) Handle(
 	ctx context.Context,
@@ -36,6 +39,11 @@ func (resp languageResponder) Handle(
 			return nil, fmt.Errorf("error while changing language: %w", err)
 		}
 
+		err = saveSettings(ctx, resp.store, mdl, interaction)
+		if err != nil {
+			return nil, err
+		}
+
 		return &discordgo.InteractionResponseData{
 			Content: "Language successfully changed.",
 		}, nil
@@ -50,7 +58,7 @@ func (builder *Builder) language(ctx context.Context) (Command, error) {
 	}
 
 	return command[languageOptions]{
-		handler: languageResponder{},
+		handler: languageResponder{store: builder.store},
 		command: discordgo.ApplicationCommand{
 			Name:        "language",
 			Description: "Get/set the the current Pokedex language.",
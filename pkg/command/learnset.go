@@ -2,10 +2,12 @@ package command
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
 )
 
@@ -13,14 +15,26 @@ type learnsetOptions struct {
 	PokemonName discordField[string] `option:"pokemon"`
 	MaxLevel    *int                 `option:"max_level"`
 	EggMoves    *bool                `option:"egg_moves"`
+	PreEvos     *bool                `option:"pre_evolutions"`
+	BestMoves   *bool                `option:"best_moves"`
+	ByType      *bool                `option:"by_type"`
 }
 
+const (
+	bestMoveCount = 3
+	// maxLearnsetMoves bounds the query for a whole learnset, which is far smaller
+	maxLearnsetMoves = 1000
+)
+
 type learnsetResponder struct {
-	queryLimit        int
-	autocompleteLimit int
-	learnMethodNames  []model.LearnMethodName
-	emojis            Emojis
-	commands          commands
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	learnMethodNames   []model.LearnMethodName
+	emojis             Emojis
+	commands           commands
+	pagination         config.PaginationConfig
 }
 
 func (resp learnsetResponder) Paginate(
@@ -32,15 +46,7 @@ func (resp learnsetResponder) Paginate(
 ) (*discordgo.InteractionResponseData, error) {
 	pokemon, err := mdl.PokemonByName(ctx, p.Options.PokemonName.Value)
 	if err != nil {
-		if errors.Is(err, model.ErrWrongGeneration) {
-			return &discordgo.InteractionResponseData{
-				Content: "The specified Pokemon does not exist in this generation.",
-			}, nil
-		} else {
-			return &discordgo.InteractionResponseData{
-				Content: "No Pokemon found with that name.",
-			}, nil
-		}
+		return pokemonNotFound(ctx, mdl, p.Options.PokemonName.Value, err)
 	}
 
 	pokemonName, err := pokemon.LocalizedName(ctx)
@@ -70,13 +76,42 @@ func (resp learnsetResponder) Paginate(
 		return nil, fmt.Errorf("failed to get learn methods: %w", err)
 	}
 
-	pms, hasNext, err := pokemon.SearchPokemonMoves(ctx, methods, p.Options.MaxLevel, nil, p.Page.Limit, p.Page.Offset)
-	if err != nil {
-		return nil, fmt.Errorf("could not get moves for pokemon %q: %w", pokemon.Name, err)
+	var fields []*discordgo.MessageEmbedField
+	var hasNext bool
+	if p.Options.ByType != nil && *p.Options.ByType {
+		fields, err = resp.typeCoverageFields(ctx, pokemon, methods, p)
+		if err != nil {
+			return nil, err
+		}
+	} else if p.Options.PreEvos != nil && *p.Options.PreEvos {
+		fields, hasNext, err = resp.lineMoveFields(ctx, pokemon, methods, p)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var pms []model.PokemonMove
+		pms, hasNext, err = pokemon.SearchPokemonMoves(ctx, methods, p.Options.MaxLevel, nil, p.Page.Limit, p.Page.Offset)
+		if err != nil {
+			return nil, fmt.Errorf("could not get moves for pokemon %q: %w", pokemon.Name, err)
+		}
+		fields, err = movesToFields(ctx, pms, resp.emojis)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert pokemon moves to discord fields: %w", err)
+		}
 	}
-	fields, err := movesToFields(ctx, pms, resp.emojis)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert pokemon moves to discord fields: %w", err)
+
+	if len(fields) == 0 && p.Page.Offset == 0 {
+		moves := "level-up moves"
+		if p.Options.EggMoves != nil && *p.Options.EggMoves {
+			moves = "level-up or egg moves"
+		}
+		if p.Options.ByType != nil && *p.Options.ByType {
+			moves = fmt.Sprintf("damaging %s", moves)
+		}
+		if p.Options.MaxLevel != nil {
+			moves = fmt.Sprintf("%s up to Lv. %d", moves, *p.Options.MaxLevel)
+		}
+		return noMovesResponse(ctx, mdl, moves, pokemonName)
 	}
 
 	sprite, err := pokemonSpriteFile(ctx, pokemon)
@@ -91,11 +126,26 @@ func (resp learnsetResponder) Paginate(
 			URL: fmt.Sprintf("attachment://%s", sprite.Name),
 		},
 	}
+	descriptions := make([]string, 0, 4)
 	if p.Options.MaxLevel != nil {
-		embed.Description = fmt.Sprintf("Max Lv. %d", *p.Options.MaxLevel)
+		descriptions = append(descriptions, fmt.Sprintf("Max Lv. %d", *p.Options.MaxLevel))
+	}
+	if p.Options.PreEvos != nil && *p.Options.PreEvos {
+		descriptions = append(descriptions, "Including pre-evolutions")
+	}
+	if p.Options.ByType != nil && *p.Options.ByType {
+		descriptions = append(descriptions, "Best power by move type")
 	}
+	if p.Options.BestMoves != nil && *p.Options.BestMoves && p.Page.Offset == 0 {
+		best, err := resp.bestMoves(ctx, mdl, pokemon, methods, p)
+		if err != nil {
+			return nil, err
+		}
+		descriptions = append(descriptions, best)
+	}
+	embed.Description = strings.Join(descriptions, "\n")
 
-	buttons, err := p.moveButtons(hasNext, resp.commands)
+	buttons, err := p.moveButtons(hasNext, resp.commands, resp.pagination, interaction)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate pagination buttons: %w", err)
 	}
@@ -113,6 +163,269 @@ func (resp learnsetResponder) Paginate(
 	}, nil
 }
 
+// lineMoveFields lists the combined learnset of the pokemon and its
+// pre-evolutions, labelling moves that are learned by an earlier stage.
+func (resp learnsetResponder) lineMoveFields(
+	ctx context.Context,
+	pokemon *model.Pokemon,
+	methods []*model.LearnMethod,
+	p paginator[learnsetOptions],
+) ([]*discordgo.MessageEmbedField, bool, error) {
+	lms, hasNext, err := pokemon.SearchLineMoves(ctx, methods, p.Options.MaxLevel, p.Page.Limit, p.Page.Offset)
+	if err != nil {
+		return nil, false, fmt.Errorf("could not get moves for evolution line of pokemon %q: %w", pokemon.Name, err)
+	}
+
+	pms := make([]model.PokemonMove, len(lms))
+	for i, lm := range lms {
+		pms[i] = lm.PokemonMove
+	}
+	fields, err := movesToFields(ctx, pms, resp.emojis)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to convert pokemon moves to discord fields: %w", err)
+	}
+
+	for i, lm := range lms {
+		if lm.StageSpeciesID == pokemon.SpeciesID {
+			continue
+		}
+
+		stageName, err := lm.Stage().LocalizedName(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("could not get localized name for pokemon %q: %w", lm.StageName, err)
+		}
+		fields[i].Name = fmt.Sprintf("%s (as %s)", fields[i].Name, stageName)
+	}
+
+	return fields, hasNext, nil
+}
+
+// learnedMoves lists every move the pokemon learns by the methods, without
+// pagination, including moves of its pre-evolutions when requested.
+func (resp learnsetResponder) learnedMoves(
+	ctx context.Context,
+	pokemon *model.Pokemon,
+	methods []*model.LearnMethod,
+	p paginator[learnsetOptions],
+) ([]*model.Move, error) {
+	var moves []*model.Move
+	if p.Options.PreEvos != nil && *p.Options.PreEvos {
+		lms, _, err := pokemon.SearchLineMoves(ctx, methods, p.Options.MaxLevel, maxLearnsetMoves, 0)
+		if err != nil {
+			return nil, fmt.Errorf("could not get moves for evolution line of pokemon %q: %w", pokemon.Name, err)
+		}
+		for _, lm := range lms {
+			moves = append(moves, lm.Move)
+		}
+	} else {
+		pms, _, err := pokemon.SearchPokemonMoves(ctx, methods, p.Options.MaxLevel, nil, maxLearnsetMoves, 0)
+		if err != nil {
+			return nil, fmt.Errorf("could not get moves for pokemon %q: %w", pokemon.Name, err)
+		}
+		for _, pm := range pms {
+			moves = append(moves, pm.Move)
+		}
+	}
+
+	return moves, nil
+}
+
+// typeCoverageFields groups the damaging moves of the learnset by type, with a
+// field per type naming its most powerful move. Types with the highest power
+// are listed first, so the fields read as the offensive coverage of the
+// learnset. Each type is looked up once, however many moves share it.
+func (resp learnsetResponder) typeCoverageFields(
+	ctx context.Context,
+	pokemon *model.Pokemon,
+	methods []*model.LearnMethod,
+	p paginator[learnsetOptions],
+) ([]*discordgo.MessageEmbedField, error) {
+	moves, err := resp.learnedMoves(ctx, pokemon, methods, p)
+	if err != nil {
+		return nil, err
+	}
+
+	type typeGroup struct {
+		typ   *model.Type
+		best  *model.Move
+		count int
+	}
+	groups := make(map[int]*typeGroup)
+	var order []*typeGroup
+	for _, move := range moves {
+		if move.Power == nil {
+			continue
+		}
+
+		group, ok := groups[move.TypeID]
+		if !ok {
+			typ, err := move.Type(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+			}
+			group = &typeGroup{typ: typ}
+			groups[move.TypeID] = group
+			order = append(order, group)
+		}
+
+		group.count++
+		if group.best == nil || *move.Power > *group.best.Power {
+			group.best = move
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return *order[i].best.Power > *order[j].best.Power
+	})
+
+	fields := make([]*discordgo.MessageEmbedField, 0, len(order))
+	for _, group := range order {
+		if group.typ.IsPseudo() {
+			continue
+		}
+
+		typeName, err := group.typ.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for type %q: %w", group.typ.Name, err)
+		}
+		emoji, err := resp.emojis.Emoji(group.typ.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+		moveName, err := group.best.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get localized name for move %q: %w", group.best.Name, err)
+		}
+
+		value := fmt.Sprintf("**%d** (%s)", *group.best.Power, moveName)
+		if group.count > 1 {
+			value = fmt.Sprintf("%s\n%d moves", value, group.count)
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s %s", emoji, typeName),
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	return fields, nil
+}
+
+type bestMoveClass struct {
+	name  model.StatName
+	label string
+	stat  int
+	moves []scoredMove
+}
+
+type scoredMove struct {
+	move  *model.Move
+	typ   *model.Type
+	power int
+}
+
+// bestMoves summarizes the strongest damaging moves in each damage class, by
+// power with STAB applied. The class matching the pokemon's higher attacking
+// stat is listed first.
+func (resp learnsetResponder) bestMoves(
+	ctx context.Context,
+	mdl *model.Model,
+	pokemon *model.Pokemon,
+	methods []*model.LearnMethod,
+	p paginator[learnsetOptions],
+) (string, error) {
+	moves, err := resp.learnedMoves(ctx, pokemon, methods, p)
+	if err != nil {
+		return "", err
+	}
+
+	combo, err := pokemon.TypeCombo(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get type combo for pokemon: %w", err)
+	}
+
+	classes := map[string]*bestMoveClass{
+		"physical": {name: model.StatNameAttack, label: "Physical"},
+		"special":  {name: model.StatNameSpecialAttack, label: "Special"},
+	}
+	for _, class := range classes {
+		stat, err := mdl.StatByName(ctx, class.name)
+		if err != nil {
+			return "", fmt.Errorf("could not get stat %q: %w", class.name, err)
+		}
+		class.stat, err = pokemon.BaseStat(ctx, *stat)
+		if err != nil {
+			return "", fmt.Errorf("could not get base stat %q for pokemon %q: %w", class.name, pokemon.Name, err)
+		}
+	}
+
+	for _, move := range moves {
+		if move.Power == nil {
+			continue
+		}
+
+		dc, err := move.AttackingDamageClass(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+		}
+		class, ok := classes[dc.Name]
+		if !ok {
+			continue
+		}
+
+		typ, err := move.Type(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+		}
+
+		class.moves = append(class.moves, scoredMove{
+			move:  move,
+			typ:   typ,
+			power: model.STABModifier(combo, typ).Apply(*move.Power),
+		})
+	}
+
+	order := []*bestMoveClass{classes["physical"], classes["special"]}
+	if order[1].stat > order[0].stat {
+		order[0], order[1] = order[1], order[0]
+	}
+
+	lines := make([]string, 0, len(order))
+	for _, class := range order {
+		sort.SliceStable(class.moves, func(i, j int) bool {
+			return class.moves[i].power > class.moves[j].power
+		})
+		if len(class.moves) > bestMoveCount {
+			class.moves = class.moves[:bestMoveCount]
+		}
+
+		values := make([]string, len(class.moves))
+		for i, sm := range class.moves {
+			name, err := sm.move.LocalizedName(ctx)
+			if err != nil {
+				return "", fmt.Errorf("failed to get localized name for move %q: %w", sm.move.Name, err)
+			}
+
+			values[i] = fmt.Sprintf("%s (%d)", name, sm.power)
+			if !sm.typ.IsPseudo() {
+				emoji, err := resp.emojis.Emoji(sm.typ.Name)
+				if err != nil {
+					return "", fmt.Errorf("error while constructing type emoji string for move %q: %w", sm.move.Name, err)
+				}
+				values[i] = fmt.Sprintf("%s %s", emoji, values[i])
+			}
+		}
+		if len(values) == 0 {
+			values = []string{"_None_"}
+		}
+
+		lines = append(lines, fmt.Sprintf("**Best %s** (base %d): %s", class.label, class.stat, strings.Join(values, ", ")))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
 func (resp learnsetResponder) Initial() Page {
 	return Page{
 		Offset: 0,
@@ -130,9 +443,11 @@ func (resp learnsetResponder) Autocomplete(
 	switch {
 	case opt.PokemonName.Focused:
 		s := pokemonSearcher{
-			model:  mdl,
-			prefix: opt.PokemonName.Value,
-			limit:  resp.autocompleteLimit,
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
 		}
 		return searchChoices[*model.Pokemon](ctx, s)
 	default:
@@ -145,13 +460,16 @@ func (builder *Builder) learnset(ctx context.Context) (Command, error) {
 	maxLevel := float64(builder.metadata.MaxLevel)
 
 	resp := learnsetResponder{
-		queryLimit:        builder.config.MoveLimit,
-		autocompleteLimit: builder.config.AutocompleteLimit,
+		queryLimit:         builder.config.LearnsetLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
 		learnMethodNames: []model.LearnMethodName{
 			model.LevelUp,
 		},
-		emojis:   builder.emojis,
-		commands: builder.commands,
+		emojis:     builder.emojis,
+		commands:   builder.commands,
+		pagination: builder.config.Pagination,
 	}
 
 	return command[learnsetOptions]{
@@ -182,6 +500,24 @@ func (builder *Builder) learnset(ctx context.Context) (Command, error) {
 					Description: "Include egg moves",
 					Required:    false,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "pre_evolutions",
+					Description: "Include moves learned by earlier stages of the evolution line",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "best_moves",
+					Description: "Show the strongest physical and special moves first",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "by_type",
+					Description: "Group damaging moves by type, showing the best power for each",
+					Required:    false,
+				},
 			},
 		},
 	}, nil
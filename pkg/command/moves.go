@@ -2,10 +2,10 @@ package command
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
 )
 
@@ -15,12 +15,15 @@ type movesOptions struct {
 }
 
 type movesResponder struct {
-	queryLimit        int
-	autocompleteLimit int
-	moveCount         int
-	learnMethodNames  []model.LearnMethodName
-	emojis            Emojis
-	commands          commands
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	moveCount          int
+	learnMethodNames   []model.LearnMethodName
+	emojis             Emojis
+	commands           commands
+	pagination         config.PaginationConfig
 }
 
 func (resp movesResponder) Paginate(
@@ -32,15 +35,7 @@ func (resp movesResponder) Paginate(
 ) (*discordgo.InteractionResponseData, error) {
 	pokemon, err := mdl.PokemonByName(ctx, p.Options.PokemonName.Value)
 	if err != nil {
-		if errors.Is(err, model.ErrWrongGeneration) {
-			return &discordgo.InteractionResponseData{
-				Content: "The specified Pokemon does not exist in this generation.",
-			}, nil
-		} else {
-			return &discordgo.InteractionResponseData{
-				Content: "No Pokemon found with that name.",
-			}, nil
-		}
+		return pokemonNotFound(ctx, mdl, p.Options.PokemonName.Value, err)
 	}
 
 	pokemonName, err := pokemon.LocalizedName(ctx)
@@ -60,6 +55,26 @@ func (resp movesResponder) Paginate(
 		return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
 	}
 
+	// a pokemon can exist in the generation without being usable in the game,
+	// in which case there is no learnset to pick a moveset from
+	available, err := mdl.Version.HasLearnset(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not check availability of pokemon %q: %w", pokemon.Name, err)
+	}
+	if !available {
+		version, err := mdl.Version.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not localize current version name: %w", err)
+		}
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf(
+				"%s cannot be used in Pokemon %s, so it has no moveset there.",
+				pokemonName,
+				version,
+			),
+		}, nil
+	}
+
 	methods, err := mdl.LearnMethodsByName(ctx, resp.learnMethodNames)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get learn methods: %w", err)
@@ -75,6 +90,11 @@ func (resp movesResponder) Paginate(
 		return nil, fmt.Errorf("failed to convert pokemon moves to discord fields: %w", err)
 	}
 
+	if len(fields) == 0 && p.Page.Offset == 0 {
+		moves := fmt.Sprintf("level-up moves up to Lv. %d", p.Options.Level)
+		return noMovesResponse(ctx, mdl, moves, pokemonName)
+	}
+
 	sprite, err := pokemonSpriteFile(ctx, pokemon)
 	if err != nil {
 		return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
@@ -89,7 +109,7 @@ func (resp movesResponder) Paginate(
 		},
 	}
 
-	buttons, err := p.moveButtons(hasNext, resp.commands)
+	buttons, err := p.moveButtons(hasNext, resp.commands, resp.pagination, interaction)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate pagination buttons: %w", err)
 	}
@@ -98,6 +118,26 @@ func (resp movesResponder) Paginate(
 		components = []discordgo.MessageComponent{buttons}
 	}
 
+	showdownButton, err := followUpButton(
+		resp.commands,
+		showdownOptions{
+			PokemonName: p.Options.PokemonName,
+			Level:       p.Options.Level,
+		},
+		discordgo.Button{
+			Label: "Showdown Set",
+		},
+		interaction,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create follow-up button for showdown: %w", err)
+	}
+	components = append(components, discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			showdownButton,
+		},
+	})
+
 	return &discordgo.InteractionResponseData{
 		Embeds:     []*discordgo.MessageEmbed{embed},
 		Components: components,
@@ -124,9 +164,11 @@ func (resp movesResponder) Autocomplete(
 	switch {
 	case opt.PokemonName.Focused:
 		s := pokemonSearcher{
-			model:  mdl,
-			prefix: opt.PokemonName.Value,
-			limit:  resp.autocompleteLimit,
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
 		}
 		return searchChoices[*model.Pokemon](ctx, s)
 	default:
@@ -139,14 +181,17 @@ func (builder *Builder) moves(ctx context.Context) (Command, error) {
 	maxLevel := float64(builder.metadata.MaxLevel)
 
 	resp := movesResponder{
-		queryLimit:        builder.config.MoveLimit,
-		autocompleteLimit: builder.config.AutocompleteLimit,
-		moveCount:         builder.metadata.MoveCount,
+		queryLimit:         builder.config.MovesLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		moveCount:          builder.metadata.MoveCount,
 		learnMethodNames: []model.LearnMethodName{
 			model.LevelUp,
 		},
-		emojis:   builder.emojis,
-		commands: builder.commands,
+		emojis:     builder.emojis,
+		commands:   builder.commands,
+		pagination: builder.config.Pagination,
 	}
 
 	return command[movesOptions]{
@@ -0,0 +1,227 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type moveSearchOptions struct {
+	Type        *discordField[string] `option:"type"`
+	DamageClass *string               `option:"damage_class"`
+	MinPower    *int                  `option:"min_power"`
+	MinAccuracy *int                  `option:"min_accuracy"`
+	Priority    *int                  `option:"priority"`
+}
+
+type moveSearchResponder struct {
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	emojis             Emojis
+	commands           commands
+	pagination         config.PaginationConfig
+}
+
+func (resp moveSearchResponder) Paginate(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	p paginator[moveSearchOptions],
+) (*discordgo.InteractionResponseData, error) {
+	filters := model.MoveFilters{
+		MinPower:    p.Options.MinPower,
+		MinAccuracy: p.Options.MinAccuracy,
+		Priority:    p.Options.Priority,
+	}
+
+	descriptions := make([]string, 0, 5)
+	if p.Options.Type != nil {
+		typ, err := mdl.TypeByName(ctx, p.Options.Type.Value)
+		if err != nil {
+			return typeNotFound(ctx, mdl, p.Options.Type.Value, err)
+		}
+		filters.Type = typ
+
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("Type: %s", emoji))
+	}
+	if p.Options.DamageClass != nil {
+		class, err := mdl.DamageClassByName(ctx, *p.Options.DamageClass)
+		if err != nil {
+			return nil, fmt.Errorf("could not get damage class %q: %w", *p.Options.DamageClass, err)
+		}
+		filters.DamageClass = class
+
+		emoji, err := resp.emojis.Emoji(class.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing damage class emoji string: %w", err)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("Class: %s", emoji))
+	}
+	if p.Options.MinPower != nil {
+		descriptions = append(descriptions, fmt.Sprintf("Power: %d or more", *p.Options.MinPower))
+	}
+	if p.Options.MinAccuracy != nil {
+		descriptions = append(descriptions, fmt.Sprintf("Accuracy: %d%% or more", *p.Options.MinAccuracy))
+	}
+	if p.Options.Priority != nil {
+		descriptions = append(descriptions, fmt.Sprintf("Priority: %+d", *p.Options.Priority))
+	}
+
+	if mdl.Version == nil {
+		return nil, fmt.Errorf("could not get localized name for version: %w", model.ErrUnsetVersion)
+	}
+	gen, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+	genName, err := gen.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+	}
+
+	moves, hasNext, err := mdl.SearchMovesFiltered(ctx, filters, p.Page.Limit, p.Page.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("could not search moves: %w", err)
+	}
+	if len(moves) == 0 && p.Page.Offset == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: "No moves match those filters.",
+		}, nil
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, len(moves))
+	for i, move := range moves {
+		name, value, err := moveSummary(ctx, move, move, resp.emojis)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert move to discord field: %w", err)
+		}
+
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:  name,
+			Value: value,
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("Move Search, %s", genName),
+		Description: strings.Join(descriptions, "\n"),
+		Fields:      fields,
+	}
+
+	buttons, err := p.moveButtons(hasNext, resp.commands, resp.pagination, interaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pagination buttons: %w", err)
+	}
+	var components []discordgo.MessageComponent
+	if buttons != nil {
+		components = []discordgo.MessageComponent{buttons}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}, nil
+}
+
+func (resp moveSearchResponder) Initial() Page {
+	return Page{
+		Offset: 0,
+		Limit:  resp.queryLimit,
+	}
+}
+
+func (resp moveSearchResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *moveSearchOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Type != nil && opt.Type.Focused:
+		s := typeSearcher{
+			model:  mdl,
+			prefix: opt.Type.Value,
+			limit:  resp.autocompleteLimits.Type,
+		}
+		return searchChoices[*model.Type](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) moveSearch(ctx context.Context) (Command, error) {
+	minPower := float64(0)
+	minAccuracy := float64(0)
+	minPriority := float64(-7)
+
+	classChoices, err := damageClassChoices(ctx, builder.model)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := moveSearchResponder{
+		queryLimit:         builder.config.MoveLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		emojis:             builder.emojis,
+		commands:           builder.commands,
+		pagination:         builder.config.Pagination,
+	}
+
+	return command[moveSearchOptions]{
+		pager:         resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "movesearch",
+			Description: "Search for moves by type, damage class, power, accuracy and priority.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "type",
+					Description:  "Type of the move",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "damage_class",
+					Description: "Damage class of the move",
+					Required:    false,
+					Choices:     classChoices,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "min_power",
+					Description: "Minimum power of the move",
+					Required:    false,
+					MinValue:    &minPower,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "min_accuracy",
+					Description: "Minimum accuracy of the move, which moves that never miss always meet",
+					Required:    false,
+					MinValue:    &minAccuracy,
+					MaxValue:    100,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "priority",
+					Description: "Priority of the move",
+					Required:    false,
+					MinValue:    &minPriority,
+					MaxValue:    5,
+				},
+			},
+		},
+	}, nil
+}
@@ -2,29 +2,54 @@ package command
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 type searcher[T model.Localizer] interface {
 	Search(context.Context) ([]T, error)
 	Value(T) any
+	// Resource names the kind of resource searched, for error messages.
+	Resource() string
+}
+
+// suffixer is implemented by searchers that add details after the name of each
+// choice, such as to tell apart resources with similar names.
+type suffixer[T model.Localizer] interface {
+	Suffix(T) string
 }
 
 type pokemonSearcher struct {
-	model  *model.Model
-	prefix string
-	limit  int
+	model      *model.Model
+	prefix     string
+	limit      int
+	order      model.PokemonOrder
+	dexNumbers bool
 }
 
 func (s pokemonSearcher) Search(ctx context.Context) ([]*model.Pokemon, error) {
-	return s.model.SearchPokemon(ctx, s.prefix, s.limit)
+	return s.model.SearchPokemonOrdered(ctx, s.prefix, s.limit, s.order)
 }
 
 func (pokemonSearcher) Value(pokemon *model.Pokemon) any {
 	return pokemon.Name
 }
 
+func (pokemonSearcher) Resource() string {
+	return "pokemon"
+}
+
+func (s pokemonSearcher) Suffix(pokemon *model.Pokemon) string {
+	if !s.dexNumbers {
+		return ""
+	}
+
+	return fmt.Sprintf(" (#%03d)", pokemon.SpeciesID)
+}
+
 type versionSearcher struct {
 	model  *model.Model
 	prefix string
@@ -39,6 +64,10 @@ func (versionSearcher) Value(ver *model.Version) any {
 	return ver.Name
 }
 
+func (versionSearcher) Resource() string {
+	return "versions"
+}
+
 type languageSearcher struct {
 	model *model.Model
 }
@@ -51,6 +80,10 @@ func (languageSearcher) Value(lang *model.Language) any {
 	return lang.ISO639
 }
 
+func (languageSearcher) Resource() string {
+	return "languages"
+}
+
 type typeSearcher struct {
 	model  *model.Model
 	prefix string
@@ -65,6 +98,10 @@ func (typeSearcher) Value(typ *model.Type) any {
 	return typ.Name
 }
 
+func (typeSearcher) Resource() string {
+	return "types"
+}
+
 type moveSearcher struct {
 	model  *model.Model
 	prefix string
@@ -78,3 +115,83 @@ func (s moveSearcher) Search(ctx context.Context) ([]*model.Move, error) {
 func (moveSearcher) Value(move *model.Move) any {
 	return move.Name
 }
+
+func (moveSearcher) Resource() string {
+	return "moves"
+}
+
+type abilitySearcher struct {
+	model  *model.Model
+	prefix string
+	limit  int
+}
+
+func (s abilitySearcher) Search(ctx context.Context) ([]*model.Ability, error) {
+	return s.model.SearchAbilities(ctx, s.prefix, s.limit)
+}
+
+func (abilitySearcher) Value(ability *model.Ability) any {
+	return ability.Name
+}
+
+func (abilitySearcher) Resource() string {
+	return "abilities"
+}
+
+// favoritePokemonSearcher lists a user's favorite pokemon that match the prefix
+// ahead of any other matches. Without a store it behaves like pokemonSearcher.
+type favoritePokemonSearcher struct {
+	pokemonSearcher
+
+	store  *store.UserStore
+	userID string
+	// favoritesOnly leaves out pokemon that are not favorites.
+	favoritesOnly bool
+}
+
+func (s favoritePokemonSearcher) Search(ctx context.Context) ([]*model.Pokemon, error) {
+	if s.store == nil {
+		return s.pokemonSearcher.Search(ctx)
+	}
+
+	favorites, _, err := favoritePokemon(ctx, s.model, s.store, s.userID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.ToLower(model.RemoveAccents(s.prefix))
+	results := make([]*model.Pokemon, 0, s.limit)
+	seen := make(map[int]bool, len(favorites))
+	for _, pokemon := range favorites {
+		if len(results) >= s.limit {
+			return results, nil
+		}
+
+		name, err := pokemon.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for favorite: %w", err)
+		}
+		if strings.HasPrefix(strings.ToLower(model.RemoveAccents(name)), prefix) {
+			results = append(results, pokemon)
+			seen[pokemon.SpeciesID] = true
+		}
+	}
+	if s.favoritesOnly {
+		return results, nil
+	}
+
+	matches, err := s.pokemonSearcher.Search(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pokemon := range matches {
+		if len(results) >= s.limit {
+			break
+		}
+		if !seen[pokemon.SpeciesID] {
+			results = append(results, pokemon)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,156 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
+)
+
+type setupOptions struct {
+	LocalizationCode *string               `option:"language"`
+	Version          *discordField[string] `option:"version"`
+}
+
+type setupResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	store              *store.UserStore
+}
+
+func (resp setupResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *setupOptions,
+) (*discordgo.InteractionResponseData, error) {
+	if opt.LocalizationCode != nil || opt.Version != nil {
+		// validate both settings on a fork so a bad version leaves the language
+		// unchanged too
+		fork := mdl.Fork()
+		fork.Language = mdl.Language
+		fork.Version = mdl.Version
+		if opt.LocalizationCode != nil {
+			err := fork.SetLanguageByLocalizationCode(ctx, model.LocalizationCode(*opt.LocalizationCode))
+			if err != nil {
+				return nil, fmt.Errorf("error while changing language: %w", err)
+			}
+		}
+		if opt.Version != nil {
+			err := fork.SetVersionByName(ctx, opt.Version.Value)
+			if err != nil {
+				return &discordgo.InteractionResponseData{
+					Content: "No game version found with that name.",
+				}, nil
+			}
+		}
+
+		err := mdl.SetLanguageByLocalizationCode(ctx, fork.Language.ISO639)
+		if err != nil {
+			return nil, fmt.Errorf("error while changing language: %w", err)
+		}
+		err = mdl.SetVersionByName(ctx, fork.Version.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while changing version: %w", err)
+		}
+
+		err = saveSettings(ctx, resp.store, mdl, interaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	language, err := mdl.Language.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current language name: %w", err)
+	}
+
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: "Pokedex settings",
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Language",
+				Value:  language,
+				Inline: true,
+			},
+			{
+				Name:   "Version",
+				Value:  fmt.Sprintf("Pokemon %s", version),
+				Inline: true,
+			},
+		},
+	}
+	if opt.LocalizationCode == nil && opt.Version == nil {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "Pass a language or version to change them.",
+		}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+func (resp setupResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *setupOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Version != nil && opt.Version.Focused:
+		s := versionSearcher{
+			model:  mdl,
+			prefix: opt.Version.Value,
+			limit:  resp.autocompleteLimits.Version,
+		}
+		return searchChoices[*model.Version](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) setup(ctx context.Context) (Command, error) {
+	s := languageSearcher{model: builder.model}
+	langChoices, err := searchChoices[*model.Language](ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("could not get available language choices: %w", err)
+	}
+
+	resp := setupResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		store:              builder.store,
+	}
+
+	return command[setupOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "setup",
+			Description: "Get/set the Pokedex language and game version together.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "language",
+					Description: "Language to set Pokedex to",
+					Choices:     langChoices,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "version",
+					Description:  "Game version to pull data from",
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
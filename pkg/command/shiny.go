@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type shinyOptions struct {
+	Version *discordField[string] `option:"version"`
+}
+
+type shinyResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+}
+
+func shinyOdds(sm model.ShinyMethod) string {
+	if sm.Chance == 1 {
+		return fmt.Sprintf("1/%d", sm.Out)
+	}
+
+	return fmt.Sprintf("%d/%d (~1/%d)", sm.Chance, sm.Out, int(math.Round(float64(sm.Out)/float64(sm.Chance))))
+}
+
+func (resp shinyResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *shinyOptions,
+) (*discordgo.InteractionResponseData, error) {
+	ver := mdl.Version
+	if opt.Version != nil {
+		// look the version up on a fork so the user's selected version is left
+		// untouched
+		fork := mdl.Fork()
+		fork.Language = mdl.Language
+		err := fork.SetVersionByName(ctx, opt.Version.Value)
+		if err != nil {
+			return &discordgo.InteractionResponseData{
+				Content: "No game version found with that name.",
+			}, nil
+		}
+		ver = fork.Version
+	}
+
+	methods, err := ver.ShinyMethods(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get shiny methods for version: %w", err)
+	}
+
+	name, err := ver.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize version name: %w", err)
+	}
+
+	if len(methods) == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Shiny Pokemon do not exist in Pokemon %s.", name),
+		}, nil
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, len(methods))
+	for i, sm := range methods {
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:   sm.Name,
+			Value:  shinyOdds(sm),
+			Inline: true,
+		}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       "Shiny Odds",
+				Description: fmt.Sprintf("Pokemon %s", name),
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+func (resp shinyResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *shinyOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Version != nil && opt.Version.Focused:
+		s := versionSearcher{
+			model:  mdl,
+			prefix: opt.Version.Value,
+			limit:  resp.autocompleteLimits.Version,
+		}
+		return searchChoices[*model.Version](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) shiny(ctx context.Context) (Command, error) {
+	resp := shinyResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+	}
+
+	return command[shinyOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "shiny",
+			Description: "Get shiny odds for each hunting method in a game.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "version",
+					Description:  "Game version to get odds for (defaults to the current version)",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
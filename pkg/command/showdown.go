@@ -0,0 +1,199 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/showdown"
+)
+
+type showdownOptions struct {
+	PokemonName discordField[string] `option:"pokemon"`
+	Level       int                  `option:"level"`
+}
+
+type showdownResponder struct {
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	moveCount          int
+	learnMethodNames   []model.LearnMethodName
+}
+
+func (resp showdownResponder) spread(ctx context.Context, mdl *model.Model, pokemon *model.Pokemon) (showdown.Spread, string, error) {
+	atk, err := mdl.StatByName(ctx, model.StatNameAttack)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get attack stat: %w", err)
+	}
+	atkBase, err := pokemon.BaseStat(ctx, *atk)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get base attack for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	spa, err := mdl.StatByName(ctx, model.StatNameSpecialAttack)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get special attack stat: %w", err)
+	}
+	spaBase, err := pokemon.BaseStat(ctx, *spa)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get base special attack for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	if atkBase >= spaBase {
+		return showdown.Spread{
+			showdown.StatAttack:         252,
+			showdown.StatSpecialDefense: 4,
+			showdown.StatSpeed:          252,
+		}, "Jolly", nil
+	}
+
+	return showdown.Spread{
+		showdown.StatSpecialAttack:  252,
+		showdown.StatSpecialDefense: 4,
+		showdown.StatSpeed:          252,
+	}, "Timid", nil
+}
+
+func (resp showdownResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *showdownOptions,
+) (*discordgo.InteractionResponseData, error) {
+	// Showdown only understands English names, so the set is always exported
+	// in English
+	en := mdl.Fork()
+	err := en.SetLanguageByLocalizationCode(ctx, model.LocalizationCodeEnglish)
+	if err != nil {
+		return nil, fmt.Errorf("could not set language for export: %w", err)
+	}
+	en.Version = mdl.Version
+
+	pokemon, err := en.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, opt.PokemonName.Value, err)
+	}
+
+	species, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	set := showdown.Set{
+		Species: species,
+		Level:   opt.Level,
+	}
+
+	abilities, err := pokemon.Abilities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting abilities for pokemon: %w", err)
+	}
+	for _, ability := range abilities {
+		if !ability.IsHidden {
+			set.Ability, err = ability.LocalizedName(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting localized name for ability: %w", err)
+			}
+			break
+		}
+	}
+
+	set.EVs, set.Nature, err = resp.spread(ctx, en, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not choose spread for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	methods, err := en.LearnMethodsByName(ctx, resp.learnMethodNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learn methods: %w", err)
+	}
+
+	pms, _, err := pokemon.SearchPokemonMoves(ctx, methods, &opt.Level, &resp.moveCount, resp.queryLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not get moves for pokemon %q: %w", pokemon.Name, err)
+	}
+	if len(pms) > resp.moveCount {
+		pms = pms[len(pms)-resp.moveCount:]
+	}
+
+	set.Moves = make([]string, len(pms))
+	for i, move := range pms {
+		set.Moves[i], err = move.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get localized name for move %q: %w", move.Name, err)
+		}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("```\n%s```", set),
+	}, nil
+}
+
+func (resp showdownResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *showdownOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) showdown(ctx context.Context) (Command, error) {
+	minLevel := float64(builder.metadata.MinLevel)
+	maxLevel := float64(builder.metadata.MaxLevel)
+
+	resp := showdownResponder{
+		queryLimit:         builder.config.MoveLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		moveCount:          builder.metadata.MoveCount,
+		learnMethodNames: []model.LearnMethodName{
+			model.LevelUp,
+		},
+	}
+
+	return command[showdownOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "showdown",
+			Description: "Pokemon Showdown set for the most likely moveset at a given level.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "level",
+					Description: "Level of the Pokemon",
+					Required:    true,
+					MinValue:    &minLevel,
+					MaxValue:    maxLevel,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestShowdownExportsEnglishNames(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	err := mdl.SetLanguageByLocalizationCode(ctx, "fr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := showdownResponder{
+		queryLimit:       100,
+		moveCount:        4,
+		learnMethodNames: []model.LearnMethodName{model.LevelUp},
+	}
+	data, err := resp.Handle(ctx, mdl, nil, nil, &showdownOptions{
+		PokemonName: discordField[string]{Value: "charizard"},
+		Level:       100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"Charizard", "Ability: Blaze", "- Flamethrower"} {
+		if !strings.Contains(data.Content, want) {
+			t.Errorf("export %q does not contain %q", data.Content, want)
+		}
+	}
+	for _, unwanted := range []string{"Dracaufeu", "Brasier", "Lance-Flammes"} {
+		if strings.Contains(data.Content, unwanted) {
+			t.Errorf("export %q contains localized name %q", data.Content, unwanted)
+		}
+	}
+}
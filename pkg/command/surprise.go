@@ -0,0 +1,179 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type surpriseOptions struct{}
+
+// surpriseResponder showcases a random pokemon, combining its dex card with
+// summaries of its same-type coverage and strongest level-up moves. Each
+// section has a button that opens the full command it summarizes.
+type surpriseResponder struct {
+	commands commands
+
+	dex      dexResponder
+	analysis analysisResponder
+	learnset learnsetResponder
+}
+
+func (resp surpriseResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *surpriseOptions,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.RandomPokemon(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := discordField[string]{Value: pokemon.Name}
+
+	data, err := resp.dex.Handle(ctx, mdl, sess, interaction, &dexOptions{
+		Pokemon: &dexPokemonOptions{Name: name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create dex card: %w", err)
+	}
+	if len(data.Embeds) == 0 {
+		return data, nil
+	}
+	embeds := []*discordgo.MessageEmbed{data.Embeds[0]}
+
+	coverage, err := resp.analysis.stabCoverage(ctx, mdl, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not create coverage summary: %w", err)
+	}
+	coverage.Title = "STAB Coverage"
+	embeds = append(embeds, coverage)
+
+	hasLearnset, err := mdl.Version.HasLearnset(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not check for learnset of pokemon %q: %w", pokemon.Name, err)
+	}
+	if hasLearnset {
+		methods, err := mdl.LearnMethodsByName(ctx, []model.LearnMethodName{model.LevelUp})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get learn methods: %w", err)
+		}
+		best, err := resp.learnset.bestMoves(ctx, mdl, pokemon, methods, paginator[learnsetOptions]{})
+		if err != nil {
+			return nil, err
+		}
+		embeds = append(embeds, &discordgo.MessageEmbed{
+			Title:       "Strongest Level-Up Moves",
+			Description: best,
+		})
+	}
+
+	buttons, err := resp.buttons(name, hasLearnset, interaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: embeds,
+		Files:  data.Files,
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: buttons,
+			},
+		},
+	}, nil
+}
+
+// buttons expand each section into its full command, and pick another pokemon.
+func (resp surpriseResponder) buttons(
+	name discordField[string],
+	hasLearnset bool,
+	interaction *discordgo.InteractionCreate,
+) ([]discordgo.MessageComponent, error) {
+	analysisButton, err := followUpButton(
+		resp.commands,
+		analysisOptions{PokemonName: name},
+		discordgo.Button{
+			Label: "Analysis",
+		},
+		interaction,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create follow-up button for analysis: %w", err)
+	}
+
+	offensive := string(analysisTabOffensive)
+	coverageButton, err := followUpButton(
+		resp.commands,
+		analysisOptions{PokemonName: name, Tab: &offensive},
+		discordgo.Button{
+			Label: "Coverage",
+		},
+		interaction,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create follow-up button for coverage: %w", err)
+	}
+
+	buttons := []discordgo.MessageComponent{analysisButton, coverageButton}
+
+	if hasLearnset {
+		bestMoves := true
+		learnsetButton, err := followUpButton(
+			resp.commands,
+			learnsetOptions{PokemonName: name, BestMoves: &bestMoves},
+			discordgo.Button{
+				Label: "Learnset",
+			},
+			interaction,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not create follow-up button for learnset: %w", err)
+		}
+		buttons = append(buttons, learnsetButton)
+	}
+
+	againButton, err := followUpButton(
+		resp.commands,
+		surpriseOptions{},
+		discordgo.Button{
+			Label: "Surprise Me Again",
+			Style: discordgo.SuccessButton,
+		},
+		interaction,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create follow-up button for surprise: %w", err)
+	}
+
+	return append(buttons, againButton), nil
+}
+
+func (builder *Builder) surprise(ctx context.Context) (Command, error) {
+	resp := surpriseResponder{
+		commands: builder.commands,
+		dex: dexResponder{
+			emojis:       builder.emojis,
+			commands:     builder.commands,
+			moveSections: builder.config.MoveSections,
+			store:        builder.store,
+		},
+		analysis: analysisResponder{
+			emojis: builder.emojis,
+		},
+		learnset: learnsetResponder{
+			emojis: builder.emojis,
+		},
+	}
+
+	return command[surpriseOptions]{
+		handler: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "surprise",
+			Description: "A random Pokemon with its dex entry, same-type coverage and strongest level-up moves.",
+		},
+	}, nil
+}
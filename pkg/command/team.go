@@ -0,0 +1,632 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/showdown"
+	"github.com/notjagan/pokedex/pkg/store"
+)
+
+type teamOptions struct {
+	Coverage *struct {
+		Pokemon1 discordField[string]  `option:"pokemon_1"`
+		Pokemon2 *discordField[string] `option:"pokemon_2"`
+		Pokemon3 *discordField[string] `option:"pokemon_3"`
+		Pokemon4 *discordField[string] `option:"pokemon_4"`
+		Pokemon5 *discordField[string] `option:"pokemon_5"`
+		Pokemon6 *discordField[string] `option:"pokemon_6"`
+	} `option:"coverage"`
+	Save *struct {
+		Name     string                `option:"name"`
+		Sets     *string               `option:"sets"`
+		Pokemon1 *discordField[string] `option:"pokemon_1"`
+		Pokemon2 *discordField[string] `option:"pokemon_2"`
+		Pokemon3 *discordField[string] `option:"pokemon_3"`
+		Pokemon4 *discordField[string] `option:"pokemon_4"`
+		Pokemon5 *discordField[string] `option:"pokemon_5"`
+		Pokemon6 *discordField[string] `option:"pokemon_6"`
+	} `option:"save"`
+	Load *struct {
+		Name discordField[string] `option:"name"`
+	} `option:"load"`
+	Delete *struct {
+		Name discordField[string] `option:"name"`
+	} `option:"delete"`
+}
+
+func presentFields(fields ...*discordField[string]) []*discordField[string] {
+	present := make([]*discordField[string], 0, len(fields))
+	for _, field := range fields {
+		if field != nil {
+			present = append(present, field)
+		}
+	}
+
+	return present
+}
+
+func (opt *teamOptions) coveragePokemon() []*discordField[string] {
+	if opt.Coverage == nil {
+		return nil
+	}
+
+	return presentFields(
+		&opt.Coverage.Pokemon1,
+		opt.Coverage.Pokemon2,
+		opt.Coverage.Pokemon3,
+		opt.Coverage.Pokemon4,
+		opt.Coverage.Pokemon5,
+		opt.Coverage.Pokemon6,
+	)
+}
+
+func (opt *teamOptions) savePokemon() []*discordField[string] {
+	if opt.Save == nil {
+		return nil
+	}
+
+	return presentFields(
+		opt.Save.Pokemon1,
+		opt.Save.Pokemon2,
+		opt.Save.Pokemon3,
+		opt.Save.Pokemon4,
+		opt.Save.Pokemon5,
+		opt.Save.Pokemon6,
+	)
+}
+
+const (
+	maxGapFields = 3
+	maxTeamSize  = 6
+	// maxTeamNameLength keeps team names short enough to show as autocomplete
+	// choices.
+	maxTeamNameLength = 32
+	// maxSetsFileSize is far larger than any six showdown sets.
+	maxSetsFileSize = 16 << 10
+	// maxPastedSetsLength is the longest text discord accepts in a modal.
+	maxPastedSetsLength = 4000
+)
+
+type teamResponder struct {
+	commands commands
+
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+	// store is nil when no user database is configured, in which case teams
+	// cannot be saved
+	store *store.UserStore
+}
+
+func (resp teamResponder) comboEmoji(combo *model.TypeCombo) (string, error) {
+	var emojis string
+	for _, typ := range combo.Types() {
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return "", fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+		emojis += emoji
+	}
+
+	return emojis, nil
+}
+
+// member looks up a pokemon on a team, returning a response explaining why if it
+// cannot be found.
+func (resp teamResponder) member(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+) (*model.Pokemon, *discordgo.InteractionResponseData) {
+	pokemon, err := mdl.PokemonByName(ctx, name)
+	if errors.Is(err, model.ErrWrongGeneration) {
+		return nil, &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("The Pokemon %q does not exist in this generation.", name),
+		}
+	} else if err != nil {
+		return nil, &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("No Pokemon found with the name %q.", name),
+		}
+	}
+
+	return pokemon, nil
+}
+
+func (resp teamResponder) coverageEmbed(
+	ctx context.Context,
+	mdl *model.Model,
+	team []*model.Pokemon,
+) (*discordgo.MessageEmbed, error) {
+	members := make([]string, 0, len(team))
+	seen := make(map[int]bool)
+	var types []*model.Type
+	for _, pokemon := range team {
+		name, err := pokemon.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+		}
+
+		combo, err := pokemon.TypeCombo(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get type combo for pokemon: %w", err)
+		}
+
+		emoji, err := resp.comboEmoji(combo)
+		if err != nil {
+			return nil, fmt.Errorf("could not get emoji for pokemon %q: %w", pokemon.Name, err)
+		}
+		members = append(members, fmt.Sprintf("%s %s", name, emoji))
+
+		for _, typ := range combo.Types() {
+			if !seen[typ.ID] {
+				seen[typ.ID] = true
+				types = append(types, typ)
+			}
+		}
+	}
+
+	gaps, err := mdl.CoverageGaps(ctx, types)
+	if err != nil {
+		return nil, fmt.Errorf("error while computing coverage gaps: %w", err)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "Team STAB coverage",
+		Description: strings.Join(members, "\n"),
+	}
+
+	if len(gaps) == 0 {
+		embed.Fields = []*discordgo.MessageEmbedField{
+			{
+				Name:  "Coverage gaps",
+				Value: "_None_",
+			},
+		}
+	} else {
+		values := make([]string, len(gaps))
+		for i, combo := range gaps {
+			values[i], err = resp.comboEmoji(combo)
+			if err != nil {
+				return nil, fmt.Errorf("could not get emoji for coverage gap: %w", err)
+			}
+		}
+
+		name := fmt.Sprintf("Not hit super effectively (%d)", len(gaps))
+		fields, shown := chunkFields(name, values, " ", maxGapFields)
+		embed.Fields = fields
+		if shown < len(values) {
+			embed.Footer = &discordgo.MessageEmbedFooter{
+				Text: fmt.Sprintf("...and %d more combinations.", len(values)-shown),
+			}
+		}
+	}
+
+	return embed, nil
+}
+
+func (resp teamResponder) handleCoverage(
+	ctx context.Context,
+	mdl *model.Model,
+	opt *teamOptions,
+) (*discordgo.InteractionResponseData, error) {
+	var team []*model.Pokemon
+	for _, field := range opt.coveragePokemon() {
+		pokemon, msg := resp.member(ctx, mdl, field.Value)
+		if msg != nil {
+			return msg, nil
+		}
+		team = append(team, pokemon)
+	}
+
+	embed, err := resp.coverageEmbed(ctx, mdl, team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{embed},
+	}, nil
+}
+
+// fetchSets downloads and parses an attached showdown export.
+func fetchSets(
+	ctx context.Context,
+	client *http.Client,
+	attachment *discordgo.MessageAttachment,
+) ([]showdown.Set, *discordgo.InteractionResponseData, error) {
+	if attachment.Size > maxSetsFileSize {
+		return nil, &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("The attached sets must be at most %d KB.", maxSetsFileSize>>10),
+		}, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create request for attachment: %w", err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not download attachment: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status %q while downloading attachment", res.Status)
+	}
+
+	sets, err := showdown.Parse(io.LimitReader(res.Body, maxSetsFileSize))
+	if errors.Is(err, showdown.ErrParse) {
+		return nil, &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Could not read the attached sets: %v.", err),
+		}, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("could not read attachment: %w", err)
+	}
+
+	return sets, nil, nil
+}
+
+func (resp teamResponder) handleSave(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+) (*discordgo.InteractionResponseData, error) {
+	fields := opt.savePokemon()
+	var sets []showdown.Set
+	switch {
+	case opt.Save.Sets != nil && len(fields) > 0:
+		return &discordgo.InteractionResponseData{
+			Content: "Either attach showdown sets or list Pokemon, not both.",
+		}, nil
+	case opt.Save.Sets != nil:
+		data := interaction.ApplicationCommandData()
+		if data.Resolved == nil || data.Resolved.Attachments[*opt.Save.Sets] == nil {
+			return nil, fmt.Errorf("attachment %q not resolved: %w", *opt.Save.Sets, ErrCommandFormat)
+		}
+
+		var msg *discordgo.InteractionResponseData
+		var err error
+		sets, msg, err = fetchSets(ctx, sess.Client, data.Resolved.Attachments[*opt.Save.Sets])
+		if msg != nil || err != nil {
+			return msg, err
+		}
+	case len(fields) > 0:
+		for _, field := range fields {
+			sets = append(sets, showdown.Set{Species: field.Value})
+		}
+	default:
+		return pasteModal(resp.commands, *opt, "Paste Showdown Sets", discordgo.TextInput{
+			Label:       "Showdown export",
+			Placeholder: "Pikachu @ Light Ball\nAbility: Static\n- Thunderbolt",
+			Required:    true,
+			MaxLength:   maxPastedSetsLength,
+		}, interaction)
+	}
+
+	return resp.saveSets(ctx, mdl, interaction, opt.Save.Name, sets)
+}
+
+// Paste saves the sets pasted into the modal opened by a save without any sets
+// or Pokemon.
+func (resp teamResponder) Paste(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+	text string,
+) (*discordgo.InteractionResponseData, error) {
+	if resp.store == nil || opt.Save == nil {
+		return nil, fmt.Errorf("unrecognized subcommand for pasted sets: %w", ErrCommandFormat)
+	}
+
+	sets, err := showdown.Parse(strings.NewReader(text))
+	if errors.Is(err, showdown.ErrParse) {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Could not read the pasted sets: %v.", err),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read pasted sets: %w", err)
+	}
+
+	return resp.saveSets(ctx, mdl, interaction, opt.Save.Name, sets)
+}
+
+func (resp teamResponder) saveSets(
+	ctx context.Context,
+	mdl *model.Model,
+	interaction *discordgo.InteractionCreate,
+	name string,
+	sets []showdown.Set,
+) (*discordgo.InteractionResponseData, error) {
+	if len(sets) > maxTeamSize {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("Teams can have at most %d Pokemon, but %d were given.", maxTeamSize, len(sets)),
+		}, nil
+	}
+
+	exports := make([]string, len(sets))
+	for i, set := range sets {
+		pokemon, msg := resp.member(ctx, mdl, set.Species)
+		if msg != nil {
+			return msg, nil
+		}
+		// store the canonical name so the team loads regardless of how the
+		// species was spelled
+		sets[i].Species = pokemon.Name
+		exports[i] = sets[i].String()
+	}
+
+	replaced, err := resp.store.SaveTeam(ctx, interactionUserID(interaction), store.Team{
+		Name:     name,
+		Showdown: strings.Join(exports, "\n"),
+	})
+	if errors.Is(err, store.ErrTooManyTeams) {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("You can save at most %d teams. Delete one with `/team delete` first.", store.MaxTeams),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not save team: %w", err)
+	}
+
+	verb := "Saved"
+	if replaced {
+		verb = "Replaced"
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("%s team %q with %d Pokemon.", verb, name, len(sets)),
+	}, nil
+}
+
+func (resp teamResponder) handleLoad(
+	ctx context.Context,
+	mdl *model.Model,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+) (*discordgo.InteractionResponseData, error) {
+	team, err := resp.store.Team(ctx, interactionUserID(interaction), opt.Load.Name.Value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("You have no team named %q.", opt.Load.Name.Value),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not load team: %w", err)
+	}
+
+	sets, err := showdown.Parse(strings.NewReader(team.Showdown))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse saved team %q: %w", team.Name, err)
+	}
+
+	members := make([]*model.Pokemon, len(sets))
+	for i, set := range sets {
+		var msg *discordgo.InteractionResponseData
+		members[i], msg = resp.member(ctx, mdl, set.Species)
+		if msg != nil {
+			return msg, nil
+		}
+	}
+
+	coverage, err := resp.coverageEmbed(ctx, mdl, members)
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       team.Name,
+				Description: fmt.Sprintf("```\n%s```", team.Showdown),
+			},
+			coverage,
+		},
+	}, nil
+}
+
+func (resp teamResponder) handleDelete(
+	ctx context.Context,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+) (*discordgo.InteractionResponseData, error) {
+	deleted, err := resp.store.DeleteTeam(ctx, interactionUserID(interaction), opt.Delete.Name.Value)
+	if err != nil {
+		return nil, fmt.Errorf("could not delete team: %w", err)
+	}
+
+	if !deleted {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("You have no team named %q.", opt.Delete.Name.Value),
+		}, nil
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("Deleted team %q.", opt.Delete.Name.Value),
+	}, nil
+}
+
+func (resp teamResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+) (*discordgo.InteractionResponseData, error) {
+	switch {
+	case opt.Coverage != nil:
+		return resp.handleCoverage(ctx, mdl, opt)
+	case resp.store == nil:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"team\": %w", ErrCommandFormat)
+	case opt.Save != nil:
+		return resp.handleSave(ctx, mdl, sess, interaction, opt)
+	case opt.Load != nil:
+		return resp.handleLoad(ctx, mdl, interaction, opt)
+	case opt.Delete != nil:
+		return resp.handleDelete(ctx, interaction, opt)
+	default:
+		return nil, fmt.Errorf("unrecognized subcommand for command \"team\": %w", ErrCommandFormat)
+	}
+}
+
+func (resp teamResponder) teamChoices(
+	ctx context.Context,
+	interaction *discordgo.InteractionCreate,
+	prefix string,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	names, err := resp.store.SearchTeams(ctx, interactionUserID(interaction), prefix, resp.autocompleteLimits.Team)
+	if err != nil {
+		return nil, fmt.Errorf("error while searching for matching teams: %w", err)
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(names))
+	for i, name := range names {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: name,
+		}
+	}
+
+	return choices, nil
+}
+
+func (resp teamResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *teamOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	var fields []*discordField[string]
+	switch {
+	case opt.Coverage != nil:
+		fields = opt.coveragePokemon()
+	case resp.store == nil:
+		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
+	case opt.Save != nil:
+		fields = opt.savePokemon()
+	case opt.Load != nil && opt.Load.Name.Focused:
+		return resp.teamChoices(ctx, interaction, opt.Load.Name.Value)
+	case opt.Delete != nil && opt.Delete.Name.Focused:
+		return resp.teamChoices(ctx, interaction, opt.Delete.Name.Value)
+	default:
+		return nil, fmt.Errorf("no recognized subcommand in focus: %w", ErrCommandFormat)
+	}
+
+	for _, field := range fields {
+		if field.Focused {
+			s := favoritePokemonSearcher{
+				pokemonSearcher: pokemonSearcher{
+					model:      mdl,
+					prefix:     field.Value,
+					limit:      resp.autocompleteLimits.Pokemon,
+					order:      resp.pokemonOrder,
+					dexNumbers: resp.dexNumbers,
+				},
+				store:  resp.store,
+				userID: interactionUserID(interaction),
+			}
+			return searchChoices[*model.Pokemon](ctx, s)
+		}
+	}
+
+	return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+}
+
+func (builder *Builder) team(ctx context.Context) (Command, error) {
+	resp := teamResponder{
+		commands:           builder.commands,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+		store:              builder.store,
+	}
+
+	ordinals := []string{"first", "second", "third", "fourth", "fifth", "sixth"}
+	pokemonOptions := func(required bool) []*discordgo.ApplicationCommandOption {
+		options := make([]*discordgo.ApplicationCommandOption, len(ordinals))
+		for i, ordinal := range ordinals {
+			options[i] = &discordgo.ApplicationCommandOption{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         fmt.Sprintf("pokemon_%d", i+1),
+				Description:  fmt.Sprintf("Name of the %s Pokemon", ordinal),
+				Required:     required && i == 0,
+				Autocomplete: true,
+			}
+		}
+
+		return options
+	}
+
+	options := []*discordgo.ApplicationCommandOption{
+		{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        "coverage",
+			Description: "Find type combinations a team's STAB moves do not hit super effectively",
+			Options:     pokemonOptions(true),
+		},
+	}
+	if builder.store != nil {
+		nameOption := func(autocomplete bool) *discordgo.ApplicationCommandOption {
+			return &discordgo.ApplicationCommandOption{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "name",
+				Description:  "Name of the team",
+				Required:     true,
+				MaxLength:    maxTeamNameLength,
+				Autocomplete: autocomplete,
+			}
+		}
+
+		saveOptions := []*discordgo.ApplicationCommandOption{
+			nameOption(false),
+			{
+				Type:        discordgo.ApplicationCommandOptionAttachment,
+				Name:        "sets",
+				Description: "Showdown export of the team, instead of listing its Pokemon or pasting the export",
+			},
+		}
+		options = append(options,
+			&discordgo.ApplicationCommandOption{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "save",
+				Description: "Save a team, replacing any of your teams with the same name",
+				Options:     append(saveOptions, pokemonOptions(false)...),
+			},
+			&discordgo.ApplicationCommandOption{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "load",
+				Description: "Show one of your saved teams and its STAB coverage",
+				Options:     []*discordgo.ApplicationCommandOption{nameOption(true)},
+			},
+			&discordgo.ApplicationCommandOption{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "delete",
+				Description: "Delete one of your saved teams",
+				Options:     []*discordgo.ApplicationCommandOption{nameOption(true)},
+			},
+		)
+	}
+
+	return command[teamOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		paster:        resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "team",
+			Description: "Analyze a team of Pokemon.",
+			Options:     options,
+		},
+	}, nil
+}
@@ -0,0 +1,193 @@
+package command
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+	"github.com/notjagan/pokedex/pkg/store"
+)
+
+func teamTestResponder(t *testing.T) teamResponder {
+	t.Helper()
+
+	userStore, err := store.Open(context.Background(), filepath.Join(t.TempDir(), "users.sqlite3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { userStore.Close() })
+
+	resp := teamResponder{store: userStore}
+	resp.commands = commands{"team": command[teamOptions]{
+		handler: resp,
+		paster:  resp,
+		command: discordgo.ApplicationCommand{Name: "team"},
+	}}
+
+	return resp
+}
+
+func teamTestInteraction(userID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		User: &discordgo.User{ID: userID},
+	}}
+}
+
+func teamSaveOptions(name string) *teamOptions {
+	var opt teamOptions
+	opt.Save = &struct {
+		Name     string                `option:"name"`
+		Sets     *string               `option:"sets"`
+		Pokemon1 *discordField[string] `option:"pokemon_1"`
+		Pokemon2 *discordField[string] `option:"pokemon_2"`
+		Pokemon3 *discordField[string] `option:"pokemon_3"`
+		Pokemon4 *discordField[string] `option:"pokemon_4"`
+		Pokemon5 *discordField[string] `option:"pokemon_5"`
+		Pokemon6 *discordField[string] `option:"pokemon_6"`
+	}{Name: name}
+
+	return &opt
+}
+
+func TestTeamSaveOpensPasteModal(t *testing.T) {
+	ctx := context.Background()
+	resp := teamTestResponder(t)
+	interaction := teamTestInteraction("123456789012345678")
+
+	opt := teamSaveOptions(strings.Repeat("x", maxTeamNameLength))
+
+	data, err := resp.Handle(ctx, modeltest.New(t), nil, interaction, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.CustomID == "" {
+		t.Fatalf("save without sets responded with %q instead of a modal", data.Content)
+	}
+	// discord rejects longer custom IDs
+	if len(data.CustomID) > 100 {
+		t.Errorf("modal custom ID has length %d, want at most 100", len(data.CustomID))
+	}
+
+	reader := strings.NewReader(data.CustomID)
+	name, err := ButtonFollowUp(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *name != "team" {
+		t.Errorf("modal command = %q, want %q", *name, "team")
+	}
+	owner, err := unmarshal[string](reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *owner != interactionUserID(interaction) {
+		t.Errorf("modal owner = %q, want %q", *owner, interactionUserID(interaction))
+	}
+	action, err := reader.ReadByte()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action != (paste[teamOptions]{}).Name() {
+		t.Errorf("modal action = %q, want %q", action, (paste[teamOptions]{}).Name())
+	}
+	state, err := buttonState[paste[teamOptions]](reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Options.Save == nil || state.Options.Save.Name != opt.Save.Name {
+		t.Errorf("modal options do not round trip the team name %q", opt.Save.Name)
+	}
+}
+
+func TestPastedText(t *testing.T) {
+	data := discordgo.ModalSubmitInteractionData{
+		Components: []discordgo.MessageComponent{
+			&discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				&discordgo.TextInput{CustomID: "other", Value: "ignored"},
+			}},
+			&discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				&discordgo.TextInput{CustomID: pasteInputID, Value: "Charizard\n- Ember"},
+			}},
+		},
+	}
+
+	text, ok := pastedText(data)
+	if !ok || text != "Charizard\n- Ember" {
+		t.Errorf("pastedText = %q, %t, want %q, true", text, ok, "Charizard\n- Ember")
+	}
+
+	_, ok = pastedText(discordgo.ModalSubmitInteractionData{})
+	if ok {
+		t.Error("pastedText found text in an empty modal")
+	}
+}
+
+func TestTeamPaste(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	tests := []struct {
+		name  string
+		text  string
+		want  string
+		saved string
+	}{
+		{
+			name:  "sets",
+			text:  "  charizard @ Charcoal\nAbility: Blaze\n- Flamethrower\n\nGengar\n- Shadow Ball\n",
+			want:  `Saved team "mine" with 2 Pokemon.`,
+			saved: "charizard @ Charcoal\nAbility: Blaze\n- Flamethrower\n\ngengar\n- Shadow Ball\n",
+		},
+		{
+			name: "malformed",
+			text: "Charizard\nEVs: 252 Luck",
+			want: "Could not read the pasted sets",
+		},
+		{
+			name: "empty",
+			text: "\n\n",
+			want: "Could not read the pasted sets",
+		},
+		{
+			name: "unknown pokemon",
+			text: "Missingno",
+			want: `No Pokemon found with the name "Missingno".`,
+		},
+		{
+			name: "too many pokemon",
+			text: strings.Repeat("Charmander\n\n", maxTeamSize+1),
+			want: "Teams can have at most",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := teamTestResponder(t)
+			interaction := teamTestInteraction("1")
+
+			data, err := resp.Paste(ctx, mdl, nil, interaction, teamSaveOptions("mine"), test.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(data.Content, test.want) {
+				t.Errorf("Paste responded with %q, want prefix %q", data.Content, test.want)
+			}
+
+			team, err := resp.store.Team(ctx, "1", "mine")
+			if test.saved == "" {
+				if err == nil {
+					t.Errorf("Paste saved team %q from invalid sets", team.Showdown)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if team.Showdown != test.saved {
+				t.Errorf("saved team %q, want %q", team.Showdown, test.saved)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type tutorsOptions struct {
+	PokemonName discordField[string] `option:"pokemon"`
+}
+
+type tutorsResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
+}
+
+func (resp tutorsResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *tutorsOptions,
+) (*discordgo.InteractionResponseData, error) {
+	pokemon, err := mdl.PokemonByName(ctx, opt.PokemonName.Value)
+	if err != nil {
+		return pokemonNotFound(ctx, mdl, opt.PokemonName.Value, err)
+	}
+
+	pokemonName, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	verName, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	pms, err := pokemon.TutorMoves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get tutor moves for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	if len(pms) == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("%s cannot learn any moves from tutors in Pokemon %s.", pokemonName, verName),
+		}, nil
+	}
+
+	values := make([]string, len(pms))
+	for i, pm := range pms {
+		name, err := pm.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get localized name for move %q: %w", pm.Name, err)
+		}
+
+		typ, err := pm.Type(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting type for move %q: %w", pm.Name, err)
+		}
+
+		if typ.IsPseudo() {
+			values[i] = name
+			continue
+		}
+
+		emoji, err := resp.emojis.Emoji(typ.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", pm.Name, err)
+		}
+		values[i] = fmt.Sprintf("%s %s", emoji, name)
+	}
+
+	fields, _ := chunkFields("Tutor Moves", values, "\n", maxEmbedFields)
+
+	sprite, err := pokemonSpriteFile(ctx, pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       pokemonName,
+				Description: fmt.Sprintf("Pokemon %s", verName),
+				Fields:      fields,
+				Thumbnail: &discordgo.MessageEmbedThumbnail{
+					URL: fmt.Sprintf("attachment://%s", sprite.Name),
+				},
+			},
+		},
+		Files: []*discordgo.File{
+			sprite,
+		},
+	}, nil
+}
+
+func (resp tutorsResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *tutorsOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.PokemonName.Focused:
+		s := pokemonSearcher{
+			model:      mdl,
+			prefix:     opt.PokemonName.Value,
+			limit:      resp.autocompleteLimits.Pokemon,
+			order:      resp.pokemonOrder,
+			dexNumbers: resp.dexNumbers,
+		}
+		return searchChoices[*model.Pokemon](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) tutors(ctx context.Context) (Command, error) {
+	resp := tutorsResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
+	}
+
+	return command[tutorsOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "tutors",
+			Description: "Tutor moves for a given Pokemon in the current game.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "pokemon",
+					Description:  "Name of the Pokemon",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,260 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type typeOptions struct {
+	Name discordField[string] `option:"type"`
+}
+
+type typeResponder struct {
+	autocompleteLimits config.AutocompleteLimits
+	emojis             Emojis
+}
+
+func (resp typeResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *typeOptions,
+) (*discordgo.InteractionResponseData, error) {
+	typ, err := mdl.TypeByName(ctx, opt.Name.Value)
+	if err != nil {
+		return typeNotFound(ctx, mdl, opt.Name.Value, err)
+	}
+
+	name, err := typ.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for type %q: %w", typ.Name, err)
+	}
+	emoji, err := resp.emojis.Emoji(typ.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+	}
+
+	intro, err := typ.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for type %q: %w", typ.Name, err)
+	}
+	introName, err := intro.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", intro.ID, err)
+	}
+
+	if mdl.Version == nil {
+		return nil, fmt.Errorf("could not get generation for type matchups: %w", model.ErrUnsetVersion)
+	}
+	gen, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+	if typ.GenerationID > gen.ID {
+		genName, err := gen.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+		}
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("%s was introduced in %s, so it does not exist in %s yet.", name, introName, genName),
+		}, nil
+	}
+
+	attacking, err := typ.AttackingEfficacies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting attacking efficacies for type %q: %w", typ.Name, err)
+	}
+	combo := mdl.NewTypeCombo()
+	combo.Type1 = typ
+	defending, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting defending efficacies for type %q: %w", typ.Name, err)
+	}
+
+	fields, err := efficaciesToFields(ctx, attacking, false, efficacyFilterAll, efficacyNames{
+		strong: "Offense: Super Effective (2x)",
+		weak:   "Offense: Resisted (0.5x)",
+		immune: "Offense: No Effect",
+	}, resp.emojis)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode attacking type efficacies: %w", err)
+	}
+	defFields, err := efficaciesToFields(ctx, defending, false, efficacyFilterAll, efficacyNames{
+		strong: "Defense: Weak To (2x)",
+		weak:   "Defense: Resists (0.5x)",
+		immune: "Defense: Immune To",
+	}, resp.emojis)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode defending type efficacies: %w", err)
+	}
+	fields = append(fields, defFields...)
+
+	changeFields, err := resp.changeFields(ctx, mdl, gen, typ, name)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, changeFields...)
+
+	description, err := withExcludedTypesNote(ctx, mdl, fmt.Sprintf("Introduced in %s", introName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       fmt.Sprintf("%s %s", emoji, name),
+				Description: description,
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+// changeFields lists the matchups of the type that differ from another
+// generation: the latest one, or the one before it when the model version is
+// already in the latest generation.
+func (resp typeResponder) changeFields(
+	ctx context.Context,
+	mdl *model.Model,
+	gen *model.Generation,
+	typ *model.Type,
+	name string,
+) ([]*discordgo.MessageEmbedField, error) {
+	other, err := mdl.LatestGeneration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting latest generation: %w", err)
+	}
+	if other.ID == gen.ID {
+		if gen.ID == 1 {
+			return nil, nil
+		}
+
+		other, err = mdl.GenerationByID(ctx, gen.ID-1)
+		if err != nil {
+			return nil, fmt.Errorf("could not get previous generation: %w", err)
+		}
+	}
+
+	otherName, err := other.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for generation %d: %w", other.ID, err)
+	}
+
+	if typ.GenerationID > other.ID {
+		return []*discordgo.MessageEmbedField{
+			{
+				Name:  fmt.Sprintf("Changes from %s", otherName),
+				Value: fmt.Sprintf("_%s did not exist in %s._", name, otherName),
+			},
+		}, nil
+	}
+
+	changes, err := typ.MatchupChanges(ctx, other)
+	if err != nil {
+		return nil, fmt.Errorf("could not get matchup changes for type %q: %w", typ.Name, err)
+	}
+	if len(changes) == 0 {
+		return []*discordgo.MessageEmbedField{
+			{
+				Name:  fmt.Sprintf("Changes from %s", otherName),
+				Value: "_None_",
+			},
+		}, nil
+	}
+
+	var offense, defense []string
+	for _, mc := range changes {
+		opposing, err := mc.OpposingType(ctx)
+		if err != nil {
+			return nil, err
+		}
+		emoji, err := resp.emojis.Emoji(opposing.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing type emoji string: %w", err)
+		}
+
+		line := fmt.Sprintf(
+			"vs %s: %s (%s in %s)",
+			emoji,
+			efficacyMultiplier(mc.EfficacyLevel()),
+			efficacyMultiplier(mc.OtherEfficacyLevel()),
+			otherName,
+		)
+		if mc.Attacking {
+			offense = append(offense, line)
+		} else {
+			defense = append(defense, line)
+		}
+	}
+
+	var fields []*discordgo.MessageEmbedField
+	for _, group := range []struct {
+		label string
+		lines []string
+	}{
+		{"Offense", offense},
+		{"Defense", defense},
+	} {
+		if len(group.lines) == 0 {
+			continue
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s: Changes from %s", group.label, otherName),
+			Value: strings.Join(group.lines, "\n"),
+		})
+	}
+
+	return fields, nil
+}
+
+func (resp typeResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *typeOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.Name.Focused:
+		s := typeSearcher{
+			model:  mdl,
+			prefix: opt.Name.Value,
+			limit:  resp.autocompleteLimits.Type,
+		}
+		return searchChoices[*model.Type](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) typeInfo(ctx context.Context) (Command, error) {
+	resp := typeResponder{
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		emojis:             builder.emojis,
+	}
+
+	return command[typeOptions]{
+		handler:       resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "type",
+			Description: "Matchups of a type, and how they differ from other generations.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "type",
+					Description:  "Name of the type",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
@@ -4,81 +4,144 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"log"
 	"os"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/sprite"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 var ErrCommandFormat = errors.New("invalid command format")
 
 var ErrMissingResourceGuild = errors.New("resource guild not found")
 
+var learnMethodLabels = map[model.LearnMethodName]string{
+	model.LevelUp: "Level up",
+	model.Egg:     "Egg move",
+	model.Tutor:   "Move tutor",
+	model.Machine: "TM/HM",
+}
+
+// learnMethodLabel names a learn method, falling back to the method's
+// identifier for the rarer special methods.
+func learnMethodLabel(method *model.LearnMethod) string {
+	if label, ok := learnMethodLabels[model.LearnMethodName(method.Name)]; ok {
+		return label
+	}
+
+	name := strings.ReplaceAll(method.Name, "-", " ")
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
 func movesToFields(ctx context.Context, pms []model.PokemonMove, emojis Emojis) ([]*discordgo.MessageEmbedField, error) {
 	fields := make([]*discordgo.MessageEmbedField, len(pms))
-	for i, move := range pms {
-		values := make([]string, 0, 5)
-
-		name, err := move.LocalizedName(ctx)
+	// a move can be listed once per learn method, so its name is only looked
+	// up the first time it appears
+	localizers := make(map[int]model.Localizer)
+	for i := range pms {
+		pm := &pms[i]
+		move, err := pm.ResolvedMove(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get localized name for move %q: %w", move.Name, err)
+			return nil, fmt.Errorf("could not get move %d: %w", pm.MoveID, err)
 		}
-
-		typ, err := move.Type(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+		localizer, ok := localizers[move.ID]
+		if !ok {
+			localizer = model.CacheLocalizer(move)
+			localizers[move.ID] = localizer
 		}
-		if !typ.IsUnknown() {
-			typeString, err := emojis.Emoji(typ.Name)
-			if err != nil {
-				return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
-			}
-			values = append(values, typeString)
+		name, value, err := moveSummary(ctx, move, localizer, emojis)
+		if err != nil {
+			return nil, err
 		}
 
-		class, err := move.DamageClass(ctx)
+		method, err := pm.LearnMethod(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+			return nil, fmt.Errorf("could not get learn method for move %q: %w", move.Name, err)
 		}
-		classString, err := emojis.Emoji(class.Name)
-		if err != nil {
-			return nil, fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
+		// only level-up moves have a meaningful level; egg, tutor and machine
+		// moves are listed with level 0
+		label := fmt.Sprintf("Lv. %-2d", pm.Level)
+		if method.Name != string(model.LevelUp) {
+			label = learnMethodLabel(method)
 		}
-		values = append(values, classString)
 
-		if move.Power != nil {
-			values = append(values, fmt.Sprintf("%d `POWER`", *move.Power))
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("%s ▸ %s", label, name),
+			Value: value,
 		}
+	}
 
-		if move.Accuracy != nil {
-			values = append(values, fmt.Sprintf("%d%%", *move.Accuracy))
-		}
+	return fields, nil
+}
 
-		if move.PP != nil {
-			values = append(values, fmt.Sprintf("%d `PP`", *move.PP))
-		}
+// moveSummary returns the name of a move from its localizer and a line with
+// its type, damage class, power, accuracy and PP.
+func moveSummary(ctx context.Context, move *model.Move, localizer model.Localizer, emojis Emojis) (string, string, error) {
+	values := make([]string, 0, 5)
 
-		fields[i] = &discordgo.MessageEmbedField{
-			Name:  fmt.Sprintf("Lv. %-2d ▸ %s", move.Level, name),
-			Value: strings.Join(values, " ▸ "),
+	name, err := localizer.LocalizedName(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get localized name for move %q: %w", move.Name, err)
+	}
+
+	typ, err := move.Type(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+	}
+	if !typ.IsPseudo() {
+		typeString, err := emojis.Emoji(typ.Name)
+		if err != nil {
+			return "", "", fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
 		}
+		values = append(values, typeString)
 	}
 
-	return fields, nil
+	class, err := move.DamageClass(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+	}
+	classString, err := emojis.Emoji(class.Name)
+	if err != nil {
+		return "", "", fmt.Errorf("error while constructing type emoji string for move %q: %w", move.Name, err)
+	}
+	values = append(values, classString)
+
+	if move.Power != nil {
+		values = append(values, fmt.Sprintf("%d `POWER`", *move.Power))
+	}
+
+	if move.Accuracy != nil {
+		values = append(values, fmt.Sprintf("%d%%", *move.Accuracy))
+	}
+
+	if move.PP != nil {
+		values = append(values, fmt.Sprintf("%d `PP`", *move.PP))
+	}
+
+	return name, strings.Join(values, " ▸ "), nil
 }
 
 func searchChoices[T model.Localizer](ctx context.Context, s searcher[T]) ([]*discordgo.ApplicationCommandOptionChoice, error) {
 	results, err := s.Search(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error while searching for matching pokemon: %w", err)
+		return nil, fmt.Errorf("error while searching for matching %s: %w", s.Resource(), err)
 	}
 
 	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(results))
 	for i, res := range results {
 		name, err := res.LocalizedName(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("error while getting localized name for resource: %w", err)
+			return nil, fmt.Errorf("error while getting localized name for %s: %w", s.Resource(), err)
+		}
+
+		if sfx, ok := s.(suffixer[T]); ok {
+			name += sfx.Suffix(res)
 		}
 
 		choices[i] = &discordgo.ApplicationCommandOptionChoice{
@@ -90,7 +153,96 @@ func searchChoices[T model.Localizer](ctx context.Context, s searcher[T]) ([]*di
 	return choices, nil
 }
 
-func (p paginator[T]) moveButtons(hasNext bool, cmds commands) (*discordgo.ActionsRow, error) {
+func paginationButton(cfg config.PaginationButton, id string, disabled bool) discordgo.Button {
+	button := discordgo.Button{
+		Style:    discordgo.PrimaryButton,
+		Label:    cfg.Label,
+		CustomID: id,
+		Disabled: disabled,
+	}
+	if cfg.EmojiName != "" {
+		button.Emoji = discordgo.ComponentEmoji{
+			Name: cfg.EmojiName,
+			ID:   cfg.EmojiID,
+		}
+	}
+
+	return button
+}
+
+// interactionUserID returns the ID of the user who triggered an interaction, in
+// either a guild or a direct message.
+func interactionUserID(interaction *discordgo.InteractionCreate) string {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User.ID
+	}
+	if interaction.User != nil {
+		return interaction.User.ID
+	}
+
+	return ""
+}
+
+// noMovesResponse explains an empty learnset, such as for forms that cannot be
+// obtained in the model's version, rather than showing an empty embed. The
+// moves describe what was searched for, like "level-up moves".
+func noMovesResponse(
+	ctx context.Context,
+	mdl *model.Model,
+	moves string,
+	pokemonName string,
+) (*discordgo.InteractionResponseData, error) {
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content: fmt.Sprintf("No %s found for %s in Pokemon %s.", moves, pokemonName, version),
+	}, nil
+}
+
+// interactionScopeID returns the ID that settings are kept under for an
+// interaction, which is the guild's ID in a guild and otherwise the user's.
+func interactionScopeID(interaction *discordgo.InteractionCreate) string {
+	if interaction.GuildID != "" {
+		return interaction.GuildID
+	}
+
+	return interactionUserID(interaction)
+}
+
+// saveSettings saves the language and version of a model for the scope of an
+// interaction, so they survive restarts. It does nothing without a store.
+func saveSettings(
+	ctx context.Context,
+	st *store.UserStore,
+	mdl *model.Model,
+	interaction *discordgo.InteractionCreate,
+) error {
+	if st == nil {
+		return nil
+	}
+
+	language := string(mdl.Language.ISO639)
+	version := mdl.Version.Name
+	err := st.SaveSettings(ctx, interactionScopeID(interaction), store.Settings{
+		Language: &language,
+		Version:  &version,
+	})
+	if err != nil {
+		return fmt.Errorf("could not save settings: %w", err)
+	}
+
+	return nil
+}
+
+func (p paginator[T]) moveButtons(
+	hasNext bool,
+	cmds commands,
+	cfg config.PaginationConfig,
+	interaction *discordgo.InteractionCreate,
+) (*discordgo.ActionsRow, error) {
 	cmd, err := optionCommand[T](cmds)
 	if err != nil {
 		return nil, fmt.Errorf("could not find command in registry: %w", err)
@@ -100,6 +252,9 @@ func (p paginator[T]) moveButtons(hasNext bool, cmds commands) (*discordgo.Actio
 		return nil, nil
 	}
 
+	// only the user who ran the command can page through its results
+	userID := interactionUserID(interaction)
+
 	phome := paginator[T]{
 		Options: p.Options,
 		Page: Page{
@@ -107,16 +262,11 @@ func (p paginator[T]) moveButtons(hasNext bool, cmds commands) (*discordgo.Actio
 			Offset: 0,
 		},
 	}
-	homeID, err := customID(phome, cmd.Name())
+	homeID, err := customID(phome, cmd.Name(), userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create next button: %w", err)
 	}
-	homeButton := discordgo.Button{
-		Style:    discordgo.PrimaryButton,
-		Label:    "⏮",
-		CustomID: homeID,
-		Disabled: p.Page.Offset == 0,
-	}
+	homeButton := paginationButton(cfg.Home, homeID, p.Page.Offset == 0)
 
 	prevOffset := p.Page.Offset - p.Page.Limit
 	pprev := paginator[T]{
@@ -126,16 +276,11 @@ func (p paginator[T]) moveButtons(hasNext bool, cmds commands) (*discordgo.Actio
 			Offset: prevOffset,
 		},
 	}
-	prevID, err := customID(pprev, cmd.Name())
+	prevID, err := customID(pprev, cmd.Name(), userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create previous button: %w", err)
 	}
-	prevButton := discordgo.Button{
-		Style:    discordgo.PrimaryButton,
-		Label:    "⏴",
-		CustomID: prevID,
-		Disabled: prevOffset < 0,
-	}
+	prevButton := paginationButton(cfg.Previous, prevID, prevOffset < 0)
 
 	pnext := paginator[T]{
 		Options: p.Options,
@@ -144,166 +289,366 @@ func (p paginator[T]) moveButtons(hasNext bool, cmds commands) (*discordgo.Actio
 			Offset: p.Page.Offset + p.Page.Limit,
 		},
 	}
-	nextID, err := customID(pnext, cmd.Name())
+	nextID, err := customID(pnext, cmd.Name(), userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create next button: %w", err)
 	}
-	nextButton := discordgo.Button{
-		Style:    discordgo.PrimaryButton,
-		Label:    "⏵",
-		CustomID: nextID,
-		Disabled: !hasNext,
+	nextButton := paginationButton(cfg.Next, nextID, !hasNext)
+
+	components := []discordgo.MessageComponent{
+		homeButton,
+		prevButton,
+		nextButton,
+	}
+
+	if cfg.CloseButton {
+		closeID, err := customID(closer{}, cmd.Name(), userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create close button: %w", err)
+		}
+		closeButton := paginationButton(cfg.Close, closeID, false)
+		closeButton.Style = discordgo.DangerButton
+		components = append(components, closeButton)
 	}
 
 	return &discordgo.ActionsRow{
-		Components: []discordgo.MessageComponent{
-			homeButton,
-			prevButton,
-			nextButton,
-		},
+		Components: components,
 	}, nil
 }
 
 type efficacyNames struct {
+	tripleStrong string
 	doubleStrong string
 	strong       string
 	neutral      string
 	weak         string
 	doubleWeak   string
+	tripleWeak   string
 	immune       string
 }
 
+type efficacyFilter string
+
+const (
+	efficacyFilterAll         efficacyFilter = "all"
+	efficacyFilterEffective   efficacyFilter = "effective"
+	efficacyFilterIneffective efficacyFilter = "ineffective"
+)
+
+func (filter efficacyFilter) includes(level model.EfficacyLevel) bool {
+	switch filter {
+	case efficacyFilterEffective:
+		return level > model.NormalEffective
+	case efficacyFilterIneffective:
+		return level < model.NormalEffective
+	default:
+		return true
+	}
+}
+
+func (filter efficacyFilter) apply(effs []model.TypeEfficacy) []model.TypeEfficacy {
+	filtered := make([]model.TypeEfficacy, 0, len(effs))
+	for _, te := range effs {
+		if filter.includes(te.EfficacyLevel()) {
+			filtered = append(filtered, te)
+		}
+	}
+
+	return filtered
+}
+
 func efficaciesToFields(
 	ctx context.Context,
 	effs []model.TypeEfficacy,
 	includeAll bool,
+	filter efficacyFilter,
 	names efficacyNames,
 	emojis Emojis,
 ) ([]*discordgo.MessageEmbedField, error) {
-	n := len(effs)
-	doubleStrengths := make([]string, 0, n)
-	strengths := make([]string, 0, n)
-	neutrals := make([]string, 0, n)
-	weaks := make([]string, 0, n)
-	doubleWeaks := make([]string, 0, n)
-	immunes := make([]string, 0, n)
+	buckets, err := model.BucketEfficacies(ctx, filter.apply(effs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode type efficacies: %w", err)
+	}
 
-	for _, te := range effs {
-		typ, err := te.OpposingType(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to encode type efficacies: %w", err)
+	// neutral matchups are only listed in full charts
+	if !includeAll {
+		buckets.Neutral = nil
+	}
+
+	groups := []struct {
+		name         string
+		types        []*model.Type
+		includeEmpty bool
+	}{
+		{names.tripleStrong, buckets.TripleStrong, false},
+		{names.doubleStrong, buckets.DoubleStrong, false},
+		{names.strong, buckets.Strong, includeAll},
+		{names.neutral, buckets.Neutral, includeAll},
+		{names.weak, buckets.Weak, includeAll},
+		{names.doubleWeak, buckets.DoubleWeak, false},
+		{names.tripleWeak, buckets.TripleWeak, false},
+		{names.immune, buckets.Immune, includeAll},
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, 0, 7)
+	for _, group := range groups {
+		if len(group.types) == 0 {
+			if group.includeEmpty {
+				fields = append(fields, &discordgo.MessageEmbedField{
+					Name:  group.name,
+					Value: "_None_",
+				})
+			}
+			continue
 		}
-		emoji, err := emojis.Emoji(typ.Name)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get emoji for type efficacies: %w", err)
+
+		values := make([]string, len(group.types))
+		for i, typ := range group.types {
+			values[i], err = emojis.Emoji(typ.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get emoji for type efficacies: %w", err)
+			}
 		}
+		// emoji strings are long enough that a full category can overflow a
+		// single field, so split it across as many fields as needed
+		chunks, _ := chunkFields(group.name, values, " ", len(values))
+		fields = append(fields, chunks...)
+	}
+
+	return fields, nil
+}
 
-		switch te.EfficacyLevel() {
-		case model.DoubleSuperEffective:
-			doubleStrengths = append(doubleStrengths, emoji)
-		case model.SuperEffective:
-			strengths = append(strengths, emoji)
-		case model.NormalEffective:
-			neutrals = append(neutrals, emoji)
-		case model.NotVeryEffective:
-			weaks = append(weaks, emoji)
-		case model.DoubleNotVeryEffective:
-			doubleWeaks = append(doubleWeaks, emoji)
-		case model.Immune:
-			immunes = append(immunes, emoji)
-		default:
-			return nil, fmt.Errorf("unexpected type efficacy level: %w", ErrUnrecognizedInteraction)
+const maxFieldValueLength = 1024
+
+func chunkFields(name string, values []string, sep string, maxFields int) ([]*discordgo.MessageEmbedField, int) {
+	fields := make([]*discordgo.MessageEmbedField, 0, maxFields)
+	var chunk []string
+	length := 0
+	shown := 0
+	for _, value := range values {
+		if length+len(sep)+len(value) > maxFieldValueLength && len(chunk) > 0 {
+			if len(fields) == maxFields-1 {
+				break
+			}
+			fields = append(fields, &discordgo.MessageEmbedField{
+				Name:  name,
+				Value: strings.Join(chunk, sep),
+			})
+			name = "\u200b"
+			chunk = nil
+			length = 0
 		}
+
+		chunk = append(chunk, value)
+		length += len(sep) + len(value)
+		shown++
 	}
 
-	fields := make([]*discordgo.MessageEmbedField, 0, 6)
-	if len(doubleStrengths) > 0 {
+	if len(chunk) > 0 {
 		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.doubleStrong,
-			Value: strings.Join(doubleStrengths, " "),
+			Name:  name,
+			Value: strings.Join(chunk, sep),
 		})
 	}
 
-	if len(strengths) > 0 {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.strong,
-			Value: strings.Join(strengths, " "),
-		})
-	} else if includeAll {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.strong,
-			Value: "_None_",
-		})
+	return fields, shown
+}
+
+const (
+	maxSuggestions      = 3
+	minSuggestionPrefix = 3
+	// maxSuggestionPrefix and maxSuggestionSearches bound the searches run for a
+	// failed lookup, so long inputs cost no more than short ones.
+	maxSuggestionPrefix   = 16
+	maxSuggestionSearches = 5
+)
+
+// suggestNames searches for resources matching the longest prefix of the input
+// that has any results, starting from at most maxSuggestionPrefix runes and
+// trying at most maxSuggestionSearches prefixes, down to a minimum prefix
+// length.
+func suggestNames[T model.Localizer](
+	ctx context.Context,
+	input string,
+	search func(context.Context, string, int) ([]T, error),
+) ([]string, error) {
+	runes := []rune(input)
+	longest := len(runes)
+	if longest > maxSuggestionPrefix {
+		longest = maxSuggestionPrefix
 	}
+	for n := longest; n >= minSuggestionPrefix && n > longest-maxSuggestionSearches; n-- {
+		results, err := search(ctx, string(runes[:n]), maxSuggestions)
+		if err != nil {
+			return nil, fmt.Errorf("error while searching for suggestions: %w", err)
+		}
 
-	if includeAll {
-		if len(neutrals) > 0 {
-			fields = append(fields, &discordgo.MessageEmbedField{
-				Name:  names.neutral,
-				Value: strings.Join(neutrals, " "),
-			})
-		} else {
-			fields = append(fields, &discordgo.MessageEmbedField{
-				Name:  names.neutral,
-				Value: "_None_",
-			})
+		if len(results) == 0 {
+			continue
 		}
+
+		names := make([]string, len(results))
+		for i, res := range results {
+			name, err := res.LocalizedName(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error while getting localized name for suggestion: %w", err)
+			}
+			names[i] = name
+		}
+
+		return names, nil
 	}
 
-	if len(weaks) > 0 {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.weak,
-			Value: strings.Join(weaks, " "),
-		})
-	} else if includeAll {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.weak,
-			Value: "_None_",
-		})
+	return nil, nil
+}
+
+func notFoundResponse[T model.Localizer](
+	ctx context.Context,
+	resource string,
+	input string,
+	err error,
+	search func(context.Context, string, int) ([]T, error),
+) (*discordgo.InteractionResponseData, error) {
+	if errors.Is(err, model.ErrWrongGeneration) {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("The specified %s does not exist in this generation.", resource),
+		}, nil
 	}
 
-	if len(doubleWeaks) > 0 {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.doubleWeak,
-			Value: strings.Join(doubleWeaks, " "),
-		})
+	content := fmt.Sprintf("No %s found with that name.", resource)
+	suggestions, err := suggestNames(ctx, input, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) > 0 {
+		content = fmt.Sprintf("%s Did you mean: %s?", content, strings.Join(suggestions, ", "))
 	}
 
-	if len(immunes) > 0 {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.immune,
-			Value: strings.Join(immunes, " "),
-		})
-	} else if includeAll {
-		fields = append(fields, &discordgo.MessageEmbedField{
-			Name:  names.immune,
-			Value: "_None_",
-		})
+	return &discordgo.InteractionResponseData{
+		Content: content,
+	}, nil
+}
+
+// versionGroupName names a version group by its localized versions, like
+// "Pokemon X / Y".
+func versionGroupName(ctx context.Context, vg *model.VersionGroup) (string, error) {
+	vers, err := vg.Versions(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get versions for version group %q: %w", vg.Name, err)
 	}
 
-	return fields, nil
+	names := make([]string, len(vers))
+	for i := range vers {
+		names[i], err = vers[i].LocalizedName(ctx)
+		if err != nil {
+			return "", fmt.Errorf("could not localize name for version %q: %w", vers[i].Name, err)
+		}
+	}
+
+	return fmt.Sprintf("Pokemon %s", strings.Join(names, " / ")), nil
+}
+
+// damageClassChoices lists every damage class as an option choice. There are
+// only three, so they are offered as choices rather than autocompleted.
+func damageClassChoices(ctx context.Context, mdl *model.Model) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	classes, err := mdl.AllDamageClasses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get damage class choices: %w", err)
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(classes))
+	for i, class := range classes {
+		name, err := class.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for damage class %q: %w", class.Name, err)
+		}
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  strings.ToUpper(name[:1]) + name[1:],
+			Value: class.Name,
+		}
+	}
+
+	return choices, nil
+}
+
+func pokemonNotFound(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+	err error,
+) (*discordgo.InteractionResponseData, error) {
+	return notFoundResponse(ctx, "Pokemon", name, err, mdl.SearchPokemon)
 }
 
+func moveNotFound(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+	err error,
+) (*discordgo.InteractionResponseData, error) {
+	return notFoundResponse(ctx, "move", name, err, mdl.SearchMoves)
+}
+
+func abilityNotFound(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+	err error,
+) (*discordgo.InteractionResponseData, error) {
+	return notFoundResponse(ctx, "ability", name, err, mdl.SearchAbilities)
+}
+
+func typeNotFound(
+	ctx context.Context,
+	mdl *model.Model,
+	name string,
+	err error,
+) (*discordgo.InteractionResponseData, error) {
+	return notFoundResponse(ctx, "type", name, err, mdl.SearchTypes)
+}
+
+// pokemonSpriteFile opens the front sprite of a pokemon, falling back to the
+// bundled placeholder for forms whose sprites are not shipped.
 func pokemonSpriteFile(ctx context.Context, pokemon *model.Pokemon) (*discordgo.File, error) {
 	sprites, err := pokemon.Sprites(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting sprites for pokemon: %w", err)
 	}
 
-	sprite := sprites.Front.Default
-	spritePath, err := sprite.Filepath()
+	var reader io.Reader
+	front := sprites.Front.Default
+	if front == "" {
+		log.Printf("pokemon %q has no sprite, using placeholder", pokemon.Name)
+		reader = sprite.PokemonPlaceholder()
+	} else {
+		file, err := openSprite(front)
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Printf("sprite for pokemon %q is missing, using placeholder: %v", pokemon.Name, err)
+			reader = sprite.PokemonPlaceholder()
+		} else if err != nil {
+			return nil, err
+		} else {
+			reader = file
+		}
+	}
+
+	return &discordgo.File{
+		Name:        fmt.Sprintf("%s.png", pokemon.Name),
+		ContentType: "image/png",
+		Reader:      reader,
+	}, nil
+}
+
+func openSprite(s sprite.Sprite) (*os.File, error) {
+	spritePath, err := s.Filepath()
 	if err != nil {
 		return nil, fmt.Errorf("could not get filepath for pokemon sprite: %w", err)
 	}
 
-	reader, err := os.Open(string(spritePath))
+	reader, err := os.Open(spritePath)
 	if err != nil {
 		return nil, fmt.Errorf("could not open reader for sprite path %q: %w", spritePath, err)
 	}
 
-	return &discordgo.File{
-		Name:        fmt.Sprintf("%s.png", pokemon.Name),
-		ContentType: "image/png",
-		Reader:      reader,
-	}, nil
+	return reader, nil
 }
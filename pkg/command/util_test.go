@@ -0,0 +1,370 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+	"github.com/notjagan/pokedex/pkg/model/sprite"
+)
+
+func testEmojis(names ...string) Emojis {
+	byKey := make(map[string]*discordgo.Emoji, 2*len(names))
+	for _, name := range names {
+		for _, index := range []string{"1", "2"} {
+			byKey[name+index] = &discordgo.Emoji{ID: name + index, Name: name + index}
+		}
+	}
+
+	emojis := NewEmojis()
+	emojis.Store(byKey)
+	return emojis
+}
+
+func TestMovesToFields(t *testing.T) {
+	ctx := context.Background()
+	emojis := testEmojis("fire", "dark", "physical", "special", "status")
+
+	tests := []struct {
+		name     string
+		language model.LocalizationCode
+		version  string
+		methods  []model.LearnMethodName
+		want     []discordgo.MessageEmbedField
+	}{
+		{
+			name:     "level up",
+			language: "en",
+			version:  "sword",
+			methods:  []model.LearnMethodName{model.LevelUp},
+			want: []discordgo.MessageEmbedField{
+				{
+					Name:  "Lv. 4  ▸ Ember",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 40 `POWER` ▸ 100% ▸ 25 `PP`",
+				},
+				{
+					Name:  "Lv. 24 ▸ Flamethrower",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 90 `POWER` ▸ 100% ▸ 15 `PP`",
+				},
+			},
+		},
+		{
+			name:     "stats in version",
+			language: "en",
+			version:  "black",
+			methods:  []model.LearnMethodName{model.LevelUp},
+			want: []discordgo.MessageEmbedField{
+				{
+					Name:  "Lv. 7  ▸ Ember",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 40 `POWER` ▸ 100% ▸ 25 `PP`",
+				},
+				{
+					Name:  "Lv. 37 ▸ Flamethrower",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 95 `POWER` ▸ 100% ▸ 15 `PP`",
+				},
+			},
+		},
+		{
+			name:     "egg",
+			language: "en",
+			version:  "sword",
+			methods:  []model.LearnMethodName{model.Egg},
+			want: []discordgo.MessageEmbedField{
+				{
+					Name:  "Egg move ▸ Bite",
+					Value: "<:dark1:dark1><:dark2:dark2> ▸ <:physical1:physical1><:physical2:physical2> ▸ 60 `POWER` ▸ 100% ▸ 25 `PP`",
+				},
+			},
+		},
+		{
+			name:     "localized",
+			language: "fr",
+			version:  "sword",
+			methods:  []model.LearnMethodName{model.LevelUp},
+			want: []discordgo.MessageEmbedField{
+				{
+					Name:  "Lv. 4  ▸ Flammèche",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 40 `POWER` ▸ 100% ▸ 25 `PP`",
+				},
+				{
+					Name:  "Lv. 24 ▸ Lance-Flammes",
+					Value: "<:fire1:fire1><:fire2:fire2> ▸ <:special1:special1><:special2:special2> ▸ 90 `POWER` ▸ 100% ▸ 15 `PP`",
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetLanguageByLocalizationCode(ctx, test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = mdl.SetVersionByName(ctx, test.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pokemon, err := mdl.PokemonByName(ctx, "charmander")
+			if err != nil {
+				t.Fatal(err)
+			}
+			methods, err := mdl.LearnMethodsByName(ctx, test.methods)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pms, _, err := pokemon.SearchPokemonMoves(ctx, methods, nil, nil, 10, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fields, err := movesToFields(ctx, pms, emojis)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(fields) != len(test.want) {
+				t.Fatalf("movesToFields returned %d fields, want %d", len(fields), len(test.want))
+			}
+			for i, field := range fields {
+				if field.Name != test.want[i].Name || field.Value != test.want[i].Value {
+					t.Errorf("field %d = %q: %q, want %q: %q", i, field.Name, field.Value, test.want[i].Name, test.want[i].Value)
+				}
+			}
+		})
+	}
+}
+
+func TestMovesToFieldsMissingEmoji(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	pokemon, err := mdl.PokemonByName(ctx, "charmander")
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods, err := mdl.LearnMethodsByName(ctx, []model.LearnMethodName{model.LevelUp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pms, _, err := pokemon.SearchPokemonMoves(ctx, methods, nil, nil, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = movesToFields(ctx, pms, testEmojis("special"))
+	if err == nil || !strings.Contains(err.Error(), "fire") {
+		t.Errorf("movesToFields without type emojis = %v, want an error about the fire emoji", err)
+	}
+}
+
+// longestEmojis gives every type emojis with the longest names and IDs discord
+// allows, so type charts are as long as they can be.
+func longestEmojis(t *testing.T, ctx context.Context, mdl *model.Model) Emojis {
+	t.Helper()
+
+	types, err := mdl.AllTypes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byKey := make(map[string]*discordgo.Emoji, 2*len(types))
+	for _, typ := range types {
+		for _, index := range []string{"1", "2"} {
+			byKey[typ.Name+index] = &discordgo.Emoji{
+				ID:   strings.Repeat("9", 20),
+				Name: (typ.Name + strings.Repeat("_", 32))[:31] + index,
+			}
+		}
+	}
+
+	emojis := NewEmojis()
+	emojis.Store(byKey)
+	return emojis
+}
+
+func TestEfficaciesToFieldsLength(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	emojis := longestEmojis(t, ctx, mdl)
+
+	ice := "ice"
+	manyWeaknesses, err := mdl.TypeComboByNames(ctx, "grass", &ice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defending, err := manyWeaknesses.DefendingEfficacies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	normal, err := mdl.TypeByName(ctx, "normal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attacking, err := normal.AttackingEfficacies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := efficacyNames{
+		tripleStrong: "Triple strong",
+		doubleStrong: "Double strong",
+		strong:       "Strong",
+		neutral:      "Neutral",
+		weak:         "Weak",
+		doubleWeak:   "Double weak",
+		tripleWeak:   "Triple weak",
+		immune:       "Immune",
+	}
+	tests := []struct {
+		name       string
+		effs       []model.TypeEfficacy
+		includeAll bool
+		split      bool
+	}{
+		{name: "many weaknesses", effs: defending},
+		{name: "many weaknesses with neutral", effs: defending, includeAll: true},
+		{name: "mostly neutral", effs: attacking, includeAll: true, split: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields, err := efficaciesToFields(ctx, test.effs, test.includeAll, efficacyFilterAll, names, emojis)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var values []string
+			split := false
+			for i, field := range fields {
+				if len(field.Value) > maxFieldValueLength {
+					t.Errorf("field %q has length %d, over the limit of %d", field.Name, len(field.Value), maxFieldValueLength)
+				}
+				if i > 0 && field.Name == "\u200b" {
+					split = true
+				}
+				if field.Value != "_None_" {
+					values = append(values, strings.Fields(field.Value)...)
+				}
+			}
+
+			// every matchup is listed exactly once across the split fields
+			want := 0
+			for _, eff := range test.effs {
+				if test.includeAll || eff.EfficacyLevel() != model.NormalEffective {
+					want++
+				}
+			}
+			if len(values) != want {
+				t.Errorf("fields list %d types, want %d", len(values), want)
+			}
+			if split != test.split {
+				t.Errorf("chart split across fields = %t, want %t", split, test.split)
+			}
+		})
+	}
+}
+
+func TestPokemonSpriteFilePlaceholder(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	placeholder, err := io.ReadAll(sprite.PokemonPlaceholder())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(placeholder, []byte("\x89PNG")) {
+		t.Fatal("placeholder sprite is not a png")
+	}
+
+	// charmander has no sprite, and the sprite of charizard is not shipped next
+	// to the test binary
+	for _, name := range []string{"charmander", "charizard"} {
+		pokemon, err := mdl.PokemonByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		file, err := pokemonSpriteFile(ctx, pokemon)
+		if err != nil {
+			t.Fatalf("pokemonSpriteFile(%q): %v", name, err)
+		}
+		if file.Name != name+".png" {
+			t.Errorf("sprite file for %s is named %q", name, file.Name)
+		}
+		data, err := io.ReadAll(file.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, placeholder) {
+			t.Errorf("sprite file for %s is not the placeholder", name)
+		}
+	}
+}
+
+type nameLocalizer string
+
+func (name nameLocalizer) LocalizedName(context.Context) (string, error) {
+	return string(name), nil
+}
+
+func TestSuggestNamesBoundsSearches(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		input    string
+		match    string
+		want     []string
+		prefixes []string
+	}{
+		{
+			name:     "typo at the end",
+			input:    "pikachuu",
+			match:    "pikachu",
+			want:     []string{"pikachu"},
+			prefixes: []string{"pikachuu", "pikachu"},
+		},
+		{
+			name:     "no match",
+			input:    "xyzw",
+			prefixes: []string{"xyzw", "xyz"},
+		},
+		{
+			name:  "long input",
+			input: strings.Repeat("a", 100),
+			match: "a",
+			prefixes: []string{
+				strings.Repeat("a", 16),
+				strings.Repeat("a", 15),
+				strings.Repeat("a", 14),
+				strings.Repeat("a", 13),
+				strings.Repeat("a", 12),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var prefixes []string
+			search := func(_ context.Context, prefix string, _ int) ([]nameLocalizer, error) {
+				prefixes = append(prefixes, prefix)
+				if test.match != "" && len(prefix) <= len(test.match) && strings.HasPrefix(test.match, prefix) {
+					return []nameLocalizer{nameLocalizer(test.match)}, nil
+				}
+				return nil, nil
+			}
+
+			got, err := suggestNames(ctx, test.input, search)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Join(got, ",") != strings.Join(test.want, ",") {
+				t.Errorf("suggestNames(%q) = %v, want %v", test.input, got, test.want)
+			}
+			if strings.Join(prefixes, ",") != strings.Join(test.prefixes, ",") {
+				t.Errorf("suggestNames(%q) searched %v, want %v", test.input, prefixes, test.prefixes)
+			}
+		})
+	}
+}
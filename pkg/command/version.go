@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/store"
 )
 
 type versionOptions struct {
@@ -13,7 +15,8 @@ type versionOptions struct {
 }
 
 type versionResponder struct {
-	autocompleteLimit int
+	autocompleteLimits config.AutocompleteLimits
+	store              *store.UserStore
 }
 
 func (resp versionResponder) Handle(
@@ -38,6 +41,11 @@ func (resp versionResponder) Handle(
 			return nil, fmt.Errorf("error while changing version: %w", err)
 		}
 
+		err = saveSettings(ctx, resp.store, mdl, interaction)
+		if err != nil {
+			return nil, err
+		}
+
 		return &discordgo.InteractionResponseData{
 			Content: "Version successfully changed.",
 		}, nil
@@ -56,7 +64,7 @@ func (resp versionResponder) Autocomplete(
 		s := versionSearcher{
 			model:  mdl,
 			prefix: opt.Name.Value,
-			limit:  resp.autocompleteLimit,
+			limit:  resp.autocompleteLimits.Version,
 		}
 		return searchChoices[*model.Version](ctx, s)
 	default:
@@ -66,7 +74,8 @@ func (resp versionResponder) Autocomplete(
 
 func (builder *Builder) version(ctx context.Context) (Command, error) {
 	resp := versionResponder{
-		autocompleteLimit: builder.config.AutocompleteLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		store:              builder.store,
 	}
 
 	return command[versionOptions]{
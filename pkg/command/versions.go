@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type versionsOptions struct{}
+
+type versionsResponder struct{}
+
+type versionGroupListing struct {
+	group *model.VersionGroup
+	names []string
+}
+
+func (resp versionsResponder) Handle(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *versionsOptions,
+) (*discordgo.InteractionResponseData, error) {
+	vers, err := mdl.AllVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get all versions: %w", err)
+	}
+
+	groups := make(map[int]*versionGroupListing)
+	for i := range vers {
+		ver := &vers[i]
+		vg, err := ver.VersionGroup(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get version group for version %q: %w", ver.Name, err)
+		}
+
+		name, err := ver.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not localize name for version %q: %w", ver.Name, err)
+		}
+
+		if mdl.Version != nil && ver.ID == mdl.Version.ID {
+			name = fmt.Sprintf("**%s**", name)
+		}
+
+		listing, ok := groups[vg.ID]
+		if !ok {
+			listing = &versionGroupListing{group: vg}
+			groups[vg.ID] = listing
+		}
+		listing.names = append(listing.names, name)
+	}
+
+	listings := make([]*versionGroupListing, 0, len(groups))
+	for _, listing := range groups {
+		listings = append(listings, listing)
+	}
+	sort.Slice(listings, func(i, j int) bool {
+		return listings[i].group.Order < listings[j].group.Order
+	})
+
+	fields := make([]*discordgo.MessageEmbedField, 0, maxEmbedFields)
+	var field *discordgo.MessageEmbedField
+	genID := 0
+	for _, listing := range listings {
+		if listing.group.GenerationID != genID {
+			gen, err := listing.group.Generation(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not get generation for version group %q: %w", listing.group.Name, err)
+			}
+
+			name, err := gen.LocalizedName(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not get localized name for generation %d: %w", gen.ID, err)
+			}
+
+			field = &discordgo.MessageEmbedField{Name: name}
+			fields = append(fields, field)
+			genID = gen.ID
+		} else {
+			field.Value += "\n"
+		}
+		field.Value += strings.Join(listing.names, " / ")
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{
+			{
+				Title:       "Versions",
+				Description: "Use `/version` to switch to one of these games.",
+				Fields:      fields,
+			},
+		},
+	}, nil
+}
+
+func (builder *Builder) versions(ctx context.Context) (Command, error) {
+	return command[versionsOptions]{
+		handler: versionsResponder{},
+		command: discordgo.ApplicationCommand{
+			Name:        "versions",
+			Description: "List every game version, grouped by generation.",
+		},
+	}, nil
+}
@@ -2,27 +2,70 @@ package command
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
 	"github.com/notjagan/pokedex/pkg/model"
 )
 
+type weakPokemonOptions struct {
+	Name      discordField[string] `option:"pokemon"`
+	Abilities *bool                `option:"abilities"`
+	Show      *string              `option:"show"`
+}
+
 type weakOptions struct {
-	Pokemon *struct {
-		Name discordField[string] `option:"pokemon"`
-	} `option:"pokemon"`
-	Type *struct {
+	Pokemon *weakPokemonOptions `option:"pokemon"`
+	Type    *struct {
 		Name1 discordField[string]  `option:"type_1"`
 		Name2 *discordField[string] `option:"type_2"`
+		Name3 *discordField[string] `option:"type_3"`
+		Show  *string               `option:"show"`
 	} `option:"type"`
 }
 
+// failedTypeName returns the first of the given type names that cannot be
+// looked up, for use in not-found responses.
+func (opt *weakOptions) failedTypeName(ctx context.Context, mdl *model.Model) string {
+	names := []*discordField[string]{&opt.Type.Name1, opt.Type.Name2, opt.Type.Name3}
+	for _, name := range names {
+		if name == nil {
+			continue
+		}
+
+		_, err := mdl.TypeByName(ctx, name.Value)
+		if err != nil {
+			return name.Value
+		}
+	}
+
+	return opt.Type.Name1.Value
+}
+
+func (opt *weakOptions) filter() efficacyFilter {
+	var show *string
+	switch {
+	case opt.Pokemon != nil:
+		show = opt.Pokemon.Show
+	case opt.Type != nil:
+		show = opt.Type.Show
+	}
+
+	if show == nil {
+		return efficacyFilterAll
+	}
+
+	return efficacyFilter(*show)
+}
+
 type weakResponder struct {
-	autocompleteLimit int
-	emojis            Emojis
+	autocompleteLimits config.AutocompleteLimits
+	pokemonOrder       model.PokemonOrder
+	dexNumbers         bool
+	emojis             Emojis
 }
 
 func (resp weakResponder) Handle(
@@ -33,21 +76,15 @@ func (resp weakResponder) Handle(
 	opt *weakOptions,
 ) (*discordgo.InteractionResponseData, error) {
 	titleStrings := make([]string, 0, 3)
-	combo := mdl.NewTypeCombo()
+	var combo *model.TypeCombo
+	var pokemon *model.Pokemon
 	var sprite *discordgo.File
 	switch {
 	case opt.Pokemon != nil:
-		pokemon, err := mdl.PokemonByName(ctx, opt.Pokemon.Name.Value)
+		var err error
+		pokemon, err = mdl.PokemonByName(ctx, opt.Pokemon.Name.Value)
 		if err != nil {
-			if errors.Is(err, model.ErrWrongGeneration) {
-				return &discordgo.InteractionResponseData{
-					Content: "The specified Pokemon does not exist in this generation.",
-				}, nil
-			} else {
-				return &discordgo.InteractionResponseData{
-					Content: "No Pokemon found with that name.",
-				}, nil
-			}
+			return pokemonNotFound(ctx, mdl, opt.Pokemon.Name.Value, err)
 		}
 
 		name, err := pokemon.LocalizedName(ctx)
@@ -66,18 +103,18 @@ func (resp weakResponder) Handle(
 			return nil, fmt.Errorf("could not get sprite for pokemon %q: %w", pokemon.Name, err)
 		}
 	case opt.Type != nil:
-		typ1, err := mdl.TypeByName(ctx, opt.Type.Name1.Value)
-		if err != nil {
-			return nil, fmt.Errorf("could not get first type by name: %w", err)
+		var name2 *string
+		if opt.Type.Name2 != nil {
+			name2 = &opt.Type.Name2.Value
 		}
-		combo.Type1 = typ1
 
-		if opt.Type.Name2 != nil {
-			typ2, err := mdl.TypeByName(ctx, opt.Type.Name2.Value)
-			if err != nil {
-				return nil, fmt.Errorf("could not get second type by name: %w", err)
-			}
-			combo.Type2 = typ2
+		var err error
+		combo, err = mdl.TypeComboByNames(ctx, opt.Type.Name1.Value, name2)
+		if err == nil && opt.Type.Name3 != nil {
+			err = combo.AddHypotheticalType(ctx, opt.Type.Name3.Value)
+		}
+		if err != nil {
+			return typeNotFound(ctx, mdl, opt.failedTypeName(ctx, mdl), err)
 		}
 	default:
 		return nil, fmt.Errorf("unrecognized subcommand for command \"weak\": %w", ErrCommandFormat)
@@ -94,7 +131,7 @@ func (resp weakResponder) Handle(
 	}
 	titleStrings = append(titleStrings, t1)
 
-	if combo.Type2 != nil {
+	if combo.Type2 != nil && combo.Type2.ID != combo.Type1.ID {
 		t2, err := resp.emojis.Emoji(combo.Type2.Name)
 		if err != nil {
 			return nil, fmt.Errorf("error while constructing first type emoji string: %w", err)
@@ -102,20 +139,27 @@ func (resp weakResponder) Handle(
 		titleStrings = append(titleStrings, t2)
 	}
 
-	fields, err := efficaciesToFields(ctx, effs, false, efficacyNames{
-		doubleStrong: "Weaknesses (4x)",
-		strong:       "Weaknesses (2x)",
-		weak:         "Resistances (0.5x)",
-		doubleWeak:   "Resistances (0.25x)",
-		immune:       "Immunities",
-	}, resp.emojis)
+	if combo.Type3 != nil {
+		t3, err := resp.emojis.Emoji(combo.Type3.Name)
+		if err != nil {
+			return nil, fmt.Errorf("error while constructing third type emoji string: %w", err)
+		}
+		titleStrings = append(titleStrings, t3)
+	}
+
+	fields, err := efficaciesToFields(ctx, effs, false, opt.filter(), weakEfficacyNames, resp.emojis)
 	if err != nil {
 		return nil, fmt.Errorf("could not encode type efficacies: %w", err)
 	}
 
+	summary, err := defensiveSummary(ctx, effs, opt.filter())
+	if err != nil {
+		return nil, err
+	}
+
 	embed := &discordgo.MessageEmbed{
 		Title:       strings.Join(titleStrings, " "),
-		Description: "Defensive type chart",
+		Description: fmt.Sprintf("Defensive type chart\n%s", summary),
 		Fields:      fields,
 	}
 	data := &discordgo.InteractionResponseData{
@@ -124,6 +168,33 @@ func (resp weakResponder) Handle(
 		},
 	}
 
+	// a pokemon's third type comes from a custom dataset, so only a third type
+	// given by the user makes the chart hypothetical
+	if combo.IsHypothetical() && opt.Type != nil {
+		embed.Description = fmt.Sprintf("Hypothetical defensive type chart\n%s", summary)
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "No Pokemon has three types; this chart is for theoretical analysis only.",
+		}
+	} else if opt.Type != nil && combo.Type2 != nil && combo.Type2.ID == combo.Type1.ID {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text: "Both types are the same, so this is shown as a single type.",
+		}
+	}
+
+	if pokemon != nil && opt.Pokemon.Abilities != nil && *opt.Pokemon.Abilities {
+		embeds, err := resp.abilityEmbeds(ctx, mdl, pokemon, effs, opt.filter(), embed.Title)
+		if err != nil {
+			return nil, fmt.Errorf("could not create ability-adjusted type charts: %w", err)
+		}
+
+		if len(embeds) == 0 {
+			embed.Footer = &discordgo.MessageEmbedFooter{
+				Text: "None of this Pokemon's abilities affect its type chart.",
+			}
+		}
+		data.Embeds = append(data.Embeds, embeds...)
+	}
+
 	if sprite != nil {
 		data.Files = []*discordgo.File{
 			sprite,
@@ -136,6 +207,140 @@ func (resp weakResponder) Handle(
 	return data, nil
 }
 
+var weakShowChoices = []*discordgo.ApplicationCommandOptionChoice{
+	{Name: "Everything", Value: string(efficacyFilterAll)},
+	{Name: "Weaknesses only", Value: string(efficacyFilterEffective)},
+	{Name: "Resistances and immunities only", Value: string(efficacyFilterIneffective)},
+}
+
+var weakEfficacyNames = efficacyNames{
+	tripleStrong: "Weaknesses (8x)",
+	doubleStrong: "Weaknesses (4x)",
+	strong:       "Weaknesses (2x)",
+	weak:         "Resistances (0.5x)",
+	doubleWeak:   "Resistances (0.25x)",
+	tripleWeak:   "Resistances (0.125x)",
+	immune:       "Immunities",
+}
+
+// defensiveSummary counts the weaknesses, resistances and immunities shown in a
+// defensive type chart, like "4 weaknesses, 5 resistances, 1 immunity".
+func defensiveSummary(ctx context.Context, effs []model.TypeEfficacy, filter efficacyFilter) (string, error) {
+	buckets, err := model.BucketEfficacies(ctx, filter.apply(effs))
+	if err != nil {
+		return "", fmt.Errorf("could not bucket type efficacies for summary: %w", err)
+	}
+
+	count := func(n int, singular string, plural string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, singular)
+		}
+		return fmt.Sprintf("%d %s", n, plural)
+	}
+
+	parts := make([]string, 0, 3)
+	if filter != efficacyFilterIneffective {
+		parts = append(parts, count(buckets.SuperEffective(), "weakness", "weaknesses"))
+	}
+	if filter != efficacyFilterEffective {
+		parts = append(parts, count(buckets.NotVeryEffective(), "resistance", "resistances"))
+		parts = append(parts, count(len(buckets.Immune), "immunity", "immunities"))
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+func efficacyMultiplier(level model.EfficacyLevel) string {
+	if level == model.TripleNotVeryEffective {
+		return "0.125x"
+	}
+
+	return strconv.FormatFloat(float64(level)/100, 'f', -1, 64) + "x"
+}
+
+func (resp weakResponder) abilityEmbeds(
+	ctx context.Context,
+	mdl *model.Model,
+	pokemon *model.Pokemon,
+	effs []model.TypeEfficacy,
+	filter efficacyFilter,
+	title string,
+) ([]*discordgo.MessageEmbed, error) {
+	abilities, err := pokemon.Abilities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting abilities for pokemon: %w", err)
+	}
+
+	gen, err := mdl.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get generation for model version: %w", err)
+	}
+
+	var embeds []*discordgo.MessageEmbed
+	for _, ability := range abilities {
+		if !ability.AffectsEfficacies(gen) {
+			continue
+		}
+
+		name, err := ability.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for ability: %w", err)
+		}
+
+		adjusted, err := ability.AdjustDefendingEfficacies(ctx, gen, effs)
+		if err != nil {
+			return nil, fmt.Errorf("could not adjust type efficacies for ability %q: %w", ability.Name, err)
+		}
+
+		changes := make([]string, 0, len(effs))
+		for i, te := range adjusted {
+			if te.DamageFactor == effs[i].DamageFactor {
+				continue
+			}
+
+			typ, err := te.OpposingType(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get opposing type for adjusted efficacy: %w", err)
+			}
+			emoji, err := resp.emojis.Emoji(typ.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get emoji for adjusted efficacy: %w", err)
+			}
+			changes = append(changes, fmt.Sprintf(
+				"%s %s ▸ %s",
+				emoji,
+				efficacyMultiplier(effs[i].EfficacyLevel()),
+				efficacyMultiplier(te.EfficacyLevel()),
+			))
+		}
+
+		fields, err := efficaciesToFields(ctx, adjusted, false, filter, weakEfficacyNames, resp.emojis)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode adjusted type efficacies: %w", err)
+		}
+
+		summary, err := defensiveSummary(ctx, adjusted, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		embed := &discordgo.MessageEmbed{
+			Title:       title,
+			Description: fmt.Sprintf("Defensive type chart with %s\n%s", name, summary),
+			Fields:      fields,
+		}
+		if len(changes) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  fmt.Sprintf("Adjusted by %s", name),
+				Value: strings.Join(changes, "\n"),
+			})
+		}
+		embeds = append(embeds, embed)
+	}
+
+	return embeds, nil
+}
+
 func (resp weakResponder) Autocomplete(
 	ctx context.Context,
 	mdl *model.Model,
@@ -147,9 +352,11 @@ func (resp weakResponder) Autocomplete(
 	case opt.Pokemon != nil:
 		if opt.Pokemon.Name.Focused {
 			s := pokemonSearcher{
-				model:  mdl,
-				prefix: opt.Pokemon.Name.Value,
-				limit:  resp.autocompleteLimit,
+				model:      mdl,
+				prefix:     opt.Pokemon.Name.Value,
+				limit:      resp.autocompleteLimits.Pokemon,
+				order:      resp.pokemonOrder,
+				dexNumbers: resp.dexNumbers,
 			}
 			return searchChoices[*model.Pokemon](ctx, s)
 		}
@@ -160,6 +367,8 @@ func (resp weakResponder) Autocomplete(
 			prefix = opt.Type.Name1.Value
 		case opt.Type.Name2 != nil && opt.Type.Name2.Focused:
 			prefix = opt.Type.Name2.Value
+		case opt.Type.Name3 != nil && opt.Type.Name3.Focused:
+			prefix = opt.Type.Name3.Value
 		default:
 			return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
 		}
@@ -167,7 +376,7 @@ func (resp weakResponder) Autocomplete(
 		s := typeSearcher{
 			model:  mdl,
 			prefix: prefix,
-			limit:  resp.autocompleteLimit,
+			limit:  resp.autocompleteLimits.Type,
 		}
 		return searchChoices[*model.Type](ctx, s)
 	default:
@@ -179,8 +388,10 @@ func (resp weakResponder) Autocomplete(
 
 func (builder *Builder) weak(ctx context.Context) (Command, error) {
 	resp := weakResponder{
-		autocompleteLimit: builder.config.AutocompleteLimit,
-		emojis:            builder.emojis,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		pokemonOrder:       model.PokemonOrder(builder.config.PokemonOrder),
+		dexNumbers:         builder.config.AutocompleteDexNumbers,
+		emojis:             builder.emojis,
 	}
 
 	return command[weakOptions]{
@@ -202,6 +413,19 @@ func (builder *Builder) weak(ctx context.Context) (Command, error) {
 							Required:     true,
 							Autocomplete: true,
 						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "abilities",
+							Description: "Also show the type chart adjusted for each relevant ability",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "show",
+							Description: "Which part of the type chart to show",
+							Required:    false,
+							Choices:     weakShowChoices,
+						},
 					},
 				},
 				{
@@ -223,6 +447,20 @@ func (builder *Builder) weak(ctx context.Context) (Command, error) {
 							Required:     false,
 							Autocomplete: true,
 						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "type_3",
+							Description:  "Name of a hypothetical third type (non-canonical)",
+							Required:     false,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "show",
+							Description: "Which part of the type chart to show",
+							Required:    false,
+							Choices:     weakShowChoices,
+						},
 					},
 				},
 			},
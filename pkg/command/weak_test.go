@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func weakTypeOptions(name1 string, name2 string) *weakOptions {
+	var opt weakOptions
+	opt.Type = &struct {
+		Name1 discordField[string]  `option:"type_1"`
+		Name2 *discordField[string] `option:"type_2"`
+		Name3 *discordField[string] `option:"type_3"`
+		Show  *string               `option:"show"`
+	}{
+		Name1: discordField[string]{Value: name1},
+		Name2: &discordField[string]{Value: name2},
+	}
+
+	return &opt
+}
+
+func TestWeakRepeatedType(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	resp := weakResponder{emojis: testEmojis(
+		"normal", "fighting", "flying", "poison", "ground", "rock", "bug", "ghost", "steel",
+		"fire", "water", "grass", "electric", "psychic", "ice", "dragon", "dark", "fairy",
+	)}
+
+	tests := []struct {
+		name1    string
+		name2    string
+		repeated bool
+	}{
+		{name1: "fire", name2: "fire", repeated: true},
+		{name1: "Fire", name2: "fire", repeated: true},
+		{name1: "fire", name2: "water", repeated: false},
+	}
+	for _, test := range tests {
+		data, err := resp.Handle(ctx, mdl, nil, nil, weakTypeOptions(test.name1, test.name2))
+		if err != nil {
+			t.Fatalf("Handle(%s, %s): %v", test.name1, test.name2, err)
+		}
+
+		embed := data.Embeds[0]
+		if repeated := embed.Footer != nil; repeated != test.repeated {
+			t.Errorf("Handle(%s, %s) has a repeated type footer: %t, want %t", test.name1, test.name2, repeated, test.repeated)
+		}
+		wantTypes := 2
+		if test.repeated {
+			wantTypes = 1
+		}
+		if types := len(strings.Fields(embed.Title)); types != wantTypes {
+			t.Errorf("Handle(%s, %s) title %q shows %d types, want %d", test.name1, test.name2, embed.Title, types, wantTypes)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+type whoCanLearnOptions struct {
+	MoveName discordField[string] `option:"move"`
+}
+
+type whoCanLearnResponder struct {
+	queryLimit         int
+	autocompleteLimits config.AutocompleteLimits
+	commands           commands
+	pagination         config.PaginationConfig
+}
+
+func (resp whoCanLearnResponder) Paginate(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	p paginator[whoCanLearnOptions],
+) (*discordgo.InteractionResponseData, error) {
+	move, err := mdl.MoveByName(ctx, p.Options.MoveName.Value)
+	if err != nil {
+		return moveNotFound(ctx, mdl, p.Options.MoveName.Value, err)
+	}
+
+	moveName, err := move.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get localized name for move %q: %w", move.Name, err)
+	}
+
+	version, err := mdl.Version.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not localize current version name: %w", err)
+	}
+
+	pokemon, hasNext, err := mdl.PokemonThatLearn(ctx, move, p.Page.Limit, p.Page.Offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(pokemon) == 0 && p.Page.Offset == 0 {
+		return &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf("No Pokemon can learn %s in Pokemon %s.", moveName, version),
+		}, nil
+	}
+
+	fields := make([]*discordgo.MessageEmbedField, len(pokemon))
+	for i, pkmn := range pokemon {
+		name, err := pkmn.LocalizedFormName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get localized name for pokemon %q: %w", pkmn.Name, err)
+		}
+
+		pms, err := mdl.CanLearn(ctx, pkmn, move)
+		if err != nil {
+			return nil, err
+		}
+		labels := make([]string, len(pms))
+		for j := range pms {
+			labels[j], err = learnedBy(ctx, &pms[j])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		fields[i] = &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("#%d %s", pkmn.SpeciesID, name),
+			Value: strings.Join(labels, ", "),
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("Who Can Learn %s", moveName),
+		Description: fmt.Sprintf("Pokemon %s", version),
+		Fields:      fields,
+	}
+
+	buttons, err := p.moveButtons(hasNext, resp.commands, resp.pagination, interaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pagination buttons: %w", err)
+	}
+	var components []discordgo.MessageComponent
+	if buttons != nil {
+		components = []discordgo.MessageComponent{buttons}
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}, nil
+}
+
+func (resp whoCanLearnResponder) Initial() Page {
+	return Page{
+		Offset: 0,
+		Limit:  resp.queryLimit,
+	}
+}
+
+func (resp whoCanLearnResponder) Autocomplete(
+	ctx context.Context,
+	mdl *model.Model,
+	sess *discordgo.Session,
+	interaction *discordgo.InteractionCreate,
+	opt *whoCanLearnOptions,
+) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	switch {
+	case opt.MoveName.Focused:
+		s := moveSearcher{
+			model:  mdl,
+			prefix: opt.MoveName.Value,
+			limit:  resp.autocompleteLimits.Move,
+		}
+		return searchChoices[*model.Move](ctx, s)
+	default:
+		return nil, fmt.Errorf("no recognized field in focus: %w", ErrCommandFormat)
+	}
+}
+
+func (builder *Builder) whoCanLearn(ctx context.Context) (Command, error) {
+	resp := whoCanLearnResponder{
+		queryLimit:         builder.config.MoveLimit,
+		autocompleteLimits: builder.config.AutocompleteLimits,
+		commands:           builder.commands,
+		pagination:         builder.config.Pagination,
+	}
+
+	return command[whoCanLearnOptions]{
+		pager:         resp,
+		autocompleter: resp,
+		command: discordgo.ApplicationCommand{
+			Name:        "whocanlearn",
+			Description: "Pokemon that can learn a move, and how they learn it.",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "move",
+					Description:  "Name of the move",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+	}, nil
+}
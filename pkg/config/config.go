@@ -1,16 +1,77 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"path/filepath"
 
 	"github.com/BurntSushi/toml"
 )
 
+// MoveSections toggles the optional sections shown for a move.
+type MoveSections struct {
+	Contest    bool `toml:"contest"`
+	Flags      bool `toml:"flags"`
+	FlavorText bool `toml:"flavor_text"`
+	History    bool `toml:"history"`
+	Meta       bool `toml:"meta"`
+}
+
+// PaginationButton is the label and optional custom emoji shown on a pagination
+// button. The emoji ID is only needed for custom emojis.
+type PaginationButton struct {
+	Label     string `toml:"label"`
+	EmojiName string `toml:"emoji_name"`
+	EmojiID   string `toml:"emoji_id"`
+}
+
+// PaginationConfig sets the pagination buttons. The close button, which lets the
+// user who ran a command delete its message, is only shown if enabled.
+type PaginationConfig struct {
+	Home        PaginationButton `toml:"home"`
+	Previous    PaginationButton `toml:"previous"`
+	Next        PaginationButton `toml:"next"`
+	CloseButton bool             `toml:"close_button"`
+	Close       PaginationButton `toml:"close"`
+}
+
+// EmojiNaming is how resource emojis are named in the resource guild. Each
+// resource has two emojis, named the prefix, the resource, the suffix and then 1
+// or 2, like "poke_fire_1" for a prefix of "poke_" and a suffix of "_".
+type EmojiNaming struct {
+	Prefix string `toml:"prefix"`
+	Suffix string `toml:"suffix"`
+}
+
+// AutocompleteLimits caps the choices suggested for each kind of resource. A
+// zero limit falls back to the shared autocomplete_limit.
+type AutocompleteLimits struct {
+	Pokemon int `toml:"pokemon"`
+	Move    int `toml:"move"`
+	Ability int `toml:"ability"`
+	Type    int `toml:"type"`
+	Version int `toml:"version"`
+	Team    int `toml:"team"`
+}
+
+// CommandConfig sets up the discord commands. MoveLimit is the page size of
+// paginated commands without a limit of their own, and LearnsetLimit and
+// MovesLimit fall back to it when zero.
 type CommandConfig struct {
-	MoveLimit         int    `toml:"move_limit"`
-	AutocompleteLimit int    `toml:"autocomplete_limit"`
-	ResourceGuildID   string `toml:"resource_guild_id"`
-	ResourceTimeout   int    `toml:"resource_timeout"`
+	MoveLimit              int                `toml:"move_limit"`
+	LearnsetLimit          int                `toml:"learnset_limit"`
+	MovesLimit             int                `toml:"moves_limit"`
+	AutocompleteLimit      int                `toml:"autocomplete_limit"`
+	AutocompleteLimits     AutocompleteLimits `toml:"autocomplete_limits"`
+	AutocompleteDebounce   int                `toml:"autocomplete_debounce"`
+	AutocompleteDexNumbers bool               `toml:"autocomplete_dex_numbers"`
+	ResourceGuildID        string             `toml:"resource_guild_id"`
+	ResourceTimeout        int                `toml:"resource_timeout"`
+	PokemonOrder           string             `toml:"pokemon_order"`
+	MoveSections           MoveSections       `toml:"move_sections"`
+	Pagination             PaginationConfig   `toml:"pagination"`
+	Emojis                 EmojiNaming        `toml:"emojis"`
 }
 
 type PokemonMetadata struct {
@@ -19,6 +80,48 @@ type PokemonMetadata struct {
 	MoveCount int `toml:"move_count"`
 }
 
+// HTTPConfig sets up the HTTP API. Timeout is in milliseconds and falls back to
+// DefaultHTTPTimeout when zero.
+type HTTPConfig struct {
+	Enabled bool   `toml:"enabled"`
+	Address string `toml:"address"`
+	Timeout int    `toml:"timeout"`
+}
+
+type GRPCConfig struct {
+	Enabled bool   `toml:"enabled"`
+	Address string `toml:"address"`
+}
+
+type HealthConfig struct {
+	Enabled bool   `toml:"enabled"`
+	Address string `toml:"address"`
+}
+
+// CacheConfig bounds the model's in-memory caches, which are on unless Disabled.
+// TTL is in seconds, and zero values for TTL and MaxEntries leave entries
+// unexpired and unbounded.
+type CacheConfig struct {
+	Disabled   bool `toml:"disabled"`
+	TTL        int  `toml:"ttl"`
+	MaxEntries int  `toml:"max_entries"`
+}
+
+// RateLimit is a token bucket allowing bursts of up to Burst requests, refilled
+// at Rate requests per second.
+type RateLimit struct {
+	Rate  float64 `toml:"rate"`
+	Burst int     `toml:"burst"`
+}
+
+// RateLimitConfig sets per-user limits on commands, including button presses,
+// and on autocomplete requests.
+type RateLimitConfig struct {
+	Enabled      bool      `toml:"enabled"`
+	Commands     RateLimit `toml:"commands"`
+	Autocomplete RateLimit `toml:"autocomplete"`
+}
+
 type Config struct {
 	Discord struct {
 		Token         string        `toml:"token"`
@@ -26,10 +129,19 @@ type Config struct {
 	} `toml:"discord"`
 	DB struct {
 		Path string `toml:"path"`
+		// SlowQueryThreshold is in milliseconds, and zero disables the slow query log.
+		SlowQueryThreshold int `toml:"slow_query_threshold"`
+		// UserPath is the writable database for user data, which is disabled if empty.
+		UserPath string      `toml:"user_path"`
+		Cache    CacheConfig `toml:"cache"`
 	} `toml:"database"`
 	Pokemon struct {
 		Metadata PokemonMetadata `toml:"metadata"`
 	} `toml:"pokemon"`
+	HTTP      HTTPConfig      `toml:"http"`
+	GRPC      GRPCConfig      `toml:"grpc"`
+	Health    HealthConfig    `toml:"health"`
+	RateLimit RateLimitConfig `toml:"rate_limit"`
 }
 
 const ConfigFile = "config.toml"
@@ -41,5 +153,161 @@ func Read() (*Config, error) {
 		return nil, fmt.Errorf("error while decoding configuration from %q: %w", ConfigFile, err)
 	}
 
+	err = cfg.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration in %q: %w", ConfigFile, err)
+	}
+
 	return &cfg, nil
 }
+
+var ErrInvalidConfig = errors.New("invalid configuration value")
+
+// discord rejects embeds with more than 25 fields and autocomplete responses
+// with more than 25 choices
+const (
+	MaxMoveLimit         = 25
+	MaxAutocompleteLimit = 25
+)
+
+// DefaultHTTPTimeout is the HTTP API timeout in milliseconds when none is set.
+const DefaultHTTPTimeout = 5000
+
+// Validate checks the configuration for values discord would reject, clamping
+// limits that are too large.
+func (cfg *Config) Validate() error {
+	cmds := &cfg.Discord.CommandConfig
+	if cmds.MoveLimit <= 0 {
+		return fmt.Errorf("move_limit must be positive, got %d: %w", cmds.MoveLimit, ErrInvalidConfig)
+	} else if cmds.MoveLimit > MaxMoveLimit {
+		log.Printf("move_limit %d exceeds the maximum of %d, using %d", cmds.MoveLimit, MaxMoveLimit, MaxMoveLimit)
+		cmds.MoveLimit = MaxMoveLimit
+	}
+
+	if cmds.AutocompleteLimit <= 0 {
+		return fmt.Errorf("autocomplete_limit must be positive, got %d: %w", cmds.AutocompleteLimit, ErrInvalidConfig)
+	} else if cmds.AutocompleteLimit > MaxAutocompleteLimit {
+		log.Printf(
+			"autocomplete_limit %d exceeds the maximum of %d, using %d",
+			cmds.AutocompleteLimit,
+			MaxAutocompleteLimit,
+			MaxAutocompleteLimit,
+		)
+		cmds.AutocompleteLimit = MaxAutocompleteLimit
+	}
+
+	limits := []struct {
+		name     string
+		limit    *int
+		fallback int
+		max      int
+	}{
+		{"learnset_limit", &cmds.LearnsetLimit, cmds.MoveLimit, MaxMoveLimit},
+		{"moves_limit", &cmds.MovesLimit, cmds.MoveLimit, MaxMoveLimit},
+		{"autocomplete_limits.pokemon", &cmds.AutocompleteLimits.Pokemon, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+		{"autocomplete_limits.move", &cmds.AutocompleteLimits.Move, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+		{"autocomplete_limits.ability", &cmds.AutocompleteLimits.Ability, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+		{"autocomplete_limits.type", &cmds.AutocompleteLimits.Type, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+		{"autocomplete_limits.version", &cmds.AutocompleteLimits.Version, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+		{"autocomplete_limits.team", &cmds.AutocompleteLimits.Team, cmds.AutocompleteLimit, MaxAutocompleteLimit},
+	}
+	for _, l := range limits {
+		switch {
+		case *l.limit < 0:
+			return fmt.Errorf("%s must not be negative, got %d: %w", l.name, *l.limit, ErrInvalidConfig)
+		case *l.limit == 0:
+			*l.limit = l.fallback
+		case *l.limit > l.max:
+			log.Printf("%s %d exceeds the maximum of %d, using %d", l.name, *l.limit, l.max, l.max)
+			*l.limit = l.max
+		}
+	}
+
+	if cfg.DB.SlowQueryThreshold < 0 {
+		return fmt.Errorf(
+			"slow_query_threshold must not be negative, got %d: %w",
+			cfg.DB.SlowQueryThreshold,
+			ErrInvalidConfig,
+		)
+	}
+
+	if cfg.DB.Cache.TTL < 0 || cfg.DB.Cache.MaxEntries < 0 {
+		return fmt.Errorf(
+			"cache ttl and max_entries must not be negative, got %d and %d: %w",
+			cfg.DB.Cache.TTL,
+			cfg.DB.Cache.MaxEntries,
+			ErrInvalidConfig,
+		)
+	}
+
+	if cfg.DB.UserPath != "" && filepath.Clean(cfg.DB.UserPath) == filepath.Clean(cfg.DB.Path) {
+		return fmt.Errorf("user_path must differ from the dex database path %q: %w", cfg.DB.Path, ErrInvalidConfig)
+	}
+
+	if cmds.AutocompleteDebounce < 0 {
+		return fmt.Errorf(
+			"autocomplete_debounce must not be negative, got %d: %w",
+			cmds.AutocompleteDebounce,
+			ErrInvalidConfig,
+		)
+	}
+
+	switch cmds.PokemonOrder {
+	case "":
+		cmds.PokemonOrder = "name"
+	case "name", "dex":
+	default:
+		return fmt.Errorf("pokemon_order must be \"name\" or \"dex\", got %q: %w", cmds.PokemonOrder, ErrInvalidConfig)
+	}
+
+	buttons := []struct {
+		name     string
+		button   *PaginationButton
+		fallback string
+	}{
+		{"home", &cmds.Pagination.Home, "⏮"},
+		{"previous", &cmds.Pagination.Previous, "⏴"},
+		{"next", &cmds.Pagination.Next, "⏵"},
+		{"close", &cmds.Pagination.Close, "✖"},
+	}
+	for _, b := range buttons {
+		if b.button.EmojiID != "" && b.button.EmojiName == "" {
+			return fmt.Errorf("pagination %s button has an emoji_id but no emoji_name: %w", b.name, ErrInvalidConfig)
+		}
+		if b.button.Label == "" && b.button.EmojiName == "" {
+			b.button.Label = b.fallback
+		}
+	}
+
+	if cfg.HTTP.Enabled {
+		switch {
+		case cfg.HTTP.Timeout < 0:
+			return fmt.Errorf("http timeout must not be negative, got %d: %w", cfg.HTTP.Timeout, ErrInvalidConfig)
+		case cfg.HTTP.Timeout == 0:
+			cfg.HTTP.Timeout = DefaultHTTPTimeout
+		}
+	}
+
+	if cfg.RateLimit.Enabled {
+		limits := []struct {
+			name  string
+			limit RateLimit
+		}{
+			{"commands", cfg.RateLimit.Commands},
+			{"autocomplete", cfg.RateLimit.Autocomplete},
+		}
+		for _, l := range limits {
+			if l.limit.Rate <= 0 || l.limit.Burst <= 0 {
+				return fmt.Errorf(
+					"%s rate limit must have a positive rate and burst, got %v and %d: %w",
+					l.name,
+					l.limit.Rate,
+					l.limit.Burst,
+					ErrInvalidConfig,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/config"
+)
+
+func validConfig() config.Config {
+	var cfg config.Config
+	cfg.Discord.CommandConfig.MoveLimit = 10
+	cfg.Discord.CommandConfig.AutocompleteLimit = 10
+	return cfg
+}
+
+func TestValidateHTTPTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		timeout int
+		want    int
+		err     error
+	}{
+		{name: "set", enabled: true, timeout: 1000, want: 1000},
+		{name: "unset", enabled: true, timeout: 0, want: config.DefaultHTTPTimeout},
+		{name: "negative", enabled: true, timeout: -1, err: config.ErrInvalidConfig},
+		{name: "disabled", enabled: false, timeout: -1, want: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.HTTP.Enabled = test.enabled
+			cfg.HTTP.Timeout = test.timeout
+
+			err := cfg.Validate()
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Validate() = %v, want %v", err, test.err)
+			}
+			if err == nil && cfg.HTTP.Timeout != test.want {
+				t.Errorf("timeout = %d, want %d", cfg.HTTP.Timeout, test.want)
+			}
+		})
+	}
+}
@@ -15,10 +15,18 @@ func (ability *Ability) LocalizedName(ctx context.Context) (string, error) {
 	return ability.model.abilityLocalizedName(ctx, ability)
 }
 
+// AbilityHolder is a pokemon that can have an ability, as either a regular or a
+// hidden ability.
+type AbilityHolder struct {
+	*Pokemon
+	IsHidden bool `db:"is_hidden"`
+}
+
 type PokemonAbility struct {
 	model *Model
 
 	*Ability
 	IsHidden  bool `db:"is_hidden"`
+	Slot      int  `db:"slot"`
 	AbilityID int  `db:"ability_id"`
 }
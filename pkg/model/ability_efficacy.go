@@ -0,0 +1,98 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+type abilityEfficacyModifier struct {
+	typeName string
+	factor   int
+	matches  func(EfficacyLevel) bool
+	// minGeneration is the first generation the ability changes efficacy in, if
+	// it had a different effect when it was introduced or is newer than the
+	// generations in the database.
+	minGeneration int
+}
+
+func (mod abilityEfficacyModifier) inGeneration(gen *Generation) bool {
+	return gen.ID >= mod.minGeneration
+}
+
+func (mod abilityEfficacyModifier) applies(gen *Generation, typ *Type, level EfficacyLevel) bool {
+	if !mod.inGeneration(gen) {
+		return false
+	}
+	if mod.typeName != "" && mod.typeName != typ.Name {
+		return false
+	}
+
+	return mod.matches == nil || mod.matches(level)
+}
+
+// Only abilities that unconditionally change how much damage a type deals are
+// modeled. Partial modifiers such as Dry Skin's fire weakness or Filter's
+// reduction of super effective damage do not map onto an efficacy level and
+// are left out. Storm Drain and Lightning Rod only redirect moves before
+// Generation V, so they grant no immunity there. The Generation IX abilities are
+// gated on that generation, since the database may not include it yet.
+var abilityEfficacyModifiers = map[string][]abilityEfficacyModifier{
+	"levitate":        {{typeName: "ground", factor: 0}},
+	"flash-fire":      {{typeName: "fire", factor: 0}},
+	"water-absorb":    {{typeName: "water", factor: 0}},
+	"storm-drain":     {{typeName: "water", factor: 0, minGeneration: 5}},
+	"dry-skin":        {{typeName: "water", factor: 0}},
+	"volt-absorb":     {{typeName: "electric", factor: 0}},
+	"lightning-rod":   {{typeName: "electric", factor: 0, minGeneration: 5}},
+	"motor-drive":     {{typeName: "electric", factor: 0}},
+	"sap-sipper":      {{typeName: "grass", factor: 0}},
+	"thick-fat":       {{typeName: "fire", factor: 50}, {typeName: "ice", factor: 50}},
+	"heatproof":       {{typeName: "fire", factor: 50}},
+	"water-bubble":    {{typeName: "fire", factor: 50}},
+	"earth-eater":     {{typeName: "ground", factor: 0, minGeneration: 9}},
+	"well-baked-body": {{typeName: "fire", factor: 0, minGeneration: 9}},
+	"purifying-salt":  {{typeName: "ghost", factor: 50, minGeneration: 9}},
+	"wonder-guard": {{
+		factor: 0,
+		matches: func(level EfficacyLevel) bool {
+			return level < SuperEffective
+		},
+	}},
+}
+
+// AffectsEfficacies reports whether the ability changes type efficacies in the
+// generation.
+func (ability *Ability) AffectsEfficacies(gen *Generation) bool {
+	for _, mod := range abilityEfficacyModifiers[ability.Name] {
+		if mod.inGeneration(gen) {
+			return true
+		}
+	}
+	return false
+}
+
+// AdjustDefendingEfficacies applies the ability's effect in the generation to
+// the defending efficacies of a pokemon with it.
+func (ability *Ability) AdjustDefendingEfficacies(
+	ctx context.Context,
+	gen *Generation,
+	effs []TypeEfficacy,
+) ([]TypeEfficacy, error) {
+	mods := abilityEfficacyModifiers[ability.Name]
+	adjusted := make([]TypeEfficacy, len(effs))
+	for i, te := range effs {
+		typ, err := te.OpposingType(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get opposing type for ability %q: %w", ability.Name, err)
+		}
+
+		for _, mod := range mods {
+			if mod.applies(gen, typ, te.EfficacyLevel()) {
+				te.DamageFactor = te.DamageFactor * mod.factor / 100
+			}
+		}
+		adjusted[i] = te
+	}
+
+	return adjusted, nil
+}
@@ -0,0 +1,201 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestPokemonByAbility(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		version    string
+		ability    string
+		hiddenOnly bool
+		want       []string
+	}{
+		{name: "regular", version: "ruby", ability: "blaze", want: []string{"charmander", "charmeleon", "charizard"}},
+		{name: "hidden before generation v", version: "diamond", ability: "solar-power", want: nil},
+		{name: "hidden", version: "sword", ability: "solar-power", want: []string{"charmander", "charmeleon", "charizard"}},
+		{name: "hidden only", version: "sword", ability: "blaze", hiddenOnly: true, want: nil},
+		{name: "past ability", version: "x", ability: "levitate", want: []string{"gengar"}},
+		{name: "replaced by past ability", version: "x", ability: "cursed-body", want: nil},
+		{name: "after past ability", version: "sword", ability: "levitate", want: nil},
+		{name: "current ability", version: "sword", ability: "cursed-body", want: []string{"gengar"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetVersionByName(ctx, test.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ability, err := mdl.AbilityByName(ctx, test.ability)
+			if err != nil {
+				t.Fatalf("AbilityByName(%q): %v", test.ability, err)
+			}
+			holders, hasNext, err := mdl.PokemonByAbility(ctx, ability, test.hiddenOnly, 10, 0)
+			if err != nil {
+				t.Fatalf("PokemonByAbility(%q): %v", test.ability, err)
+			}
+			if hasNext {
+				t.Errorf("PokemonByAbility(%q) has another page", test.ability)
+			}
+
+			var names []string
+			for _, holder := range holders {
+				names = append(names, holder.Name)
+			}
+			if len(names) != len(test.want) {
+				t.Fatalf("PokemonByAbility(%q) = %v, want %v", test.ability, names, test.want)
+			}
+			for i := range names {
+				if names[i] != test.want[i] {
+					t.Fatalf("PokemonByAbility(%q) = %v, want %v", test.ability, names, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPokemonAbilitiesInGeneration(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	for version, want := range map[string]string{"x": "levitate", "sword": "cursed-body"} {
+		err := mdl.SetVersionByName(ctx, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gengar, err := mdl.PokemonByName(ctx, "gengar")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		abilities, err := gengar.Abilities(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(abilities) != 1 || abilities[0].Name != want {
+			t.Errorf("abilities of gengar in %s = %v, want [%s]", version, abilities, want)
+		}
+	}
+}
+
+func TestAdjustDefendingEfficaciesInGeneration(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	tests := []struct {
+		ability string
+		typ     string
+		gen     int
+		want    model.EfficacyLevel
+	}{
+		{ability: "lightning-rod", typ: "electric", gen: 4, want: model.NormalEffective},
+		{ability: "lightning-rod", typ: "electric", gen: 5, want: model.Immune},
+		{ability: "storm-drain", typ: "water", gen: 4, want: model.NormalEffective},
+		{ability: "storm-drain", typ: "water", gen: 5, want: model.Immune},
+		{ability: "volt-absorb", typ: "electric", gen: 3, want: model.Immune},
+	}
+	for _, test := range tests {
+		gen, err := mdl.GenerationByID(ctx, test.gen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		combo, err := mdl.TypeComboByNames(ctx, "normal", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		effs, err := combo.DefendingEfficacies(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ability := &model.Ability{Name: test.ability}
+		if got := ability.AffectsEfficacies(gen); got != (test.want == model.Immune) {
+			t.Errorf("AffectsEfficacies of %s in generation %d = %t", test.ability, test.gen, got)
+		}
+		adjusted, err := ability.AdjustDefendingEfficacies(ctx, gen, effs)
+		if err != nil {
+			t.Fatalf("AdjustDefendingEfficacies(%s): %v", test.ability, err)
+		}
+		for _, te := range adjusted {
+			typ, err := te.OpposingType(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if typ.Name == test.typ && te.EfficacyLevel() != test.want {
+				t.Errorf(
+					"%s efficacy against %s in generation %d = %d, want %d",
+					test.typ, test.ability, test.gen, te.EfficacyLevel(), test.want,
+				)
+			}
+		}
+	}
+}
+
+func TestAdjustDefendingEfficaciesGenerationIX(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	combo, err := mdl.TypeComboByNames(ctx, "psychic", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gen8, err := mdl.GenerationByID(ctx, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fixture stops at Generation VIII, and abilities only check the ID
+	gen9 := &model.Generation{ID: 9, Name: "generation-ix"}
+
+	tests := []struct {
+		ability string
+		typ     string
+		before  model.EfficacyLevel
+		after   model.EfficacyLevel
+	}{
+		{ability: "earth-eater", typ: "ground", before: model.NormalEffective, after: model.Immune},
+		{ability: "well-baked-body", typ: "fire", before: model.NormalEffective, after: model.Immune},
+		{ability: "purifying-salt", typ: "ghost", before: model.SuperEffective, after: model.NormalEffective},
+	}
+	for _, test := range tests {
+		ability := &model.Ability{Name: test.ability}
+		for _, gen := range []*model.Generation{gen8, gen9} {
+			want := test.before
+			if gen.ID >= 9 {
+				want = test.after
+			}
+			if got := ability.AffectsEfficacies(gen); got != (gen.ID >= 9) {
+				t.Errorf("AffectsEfficacies of %s in generation %d = %t", test.ability, gen.ID, got)
+			}
+
+			adjusted, err := ability.AdjustDefendingEfficacies(ctx, gen, effs)
+			if err != nil {
+				t.Fatalf("AdjustDefendingEfficacies(%s): %v", test.ability, err)
+			}
+			for _, te := range adjusted {
+				typ, err := te.OpposingType(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if typ.Name == test.typ && te.EfficacyLevel() != want {
+					t.Errorf(
+						"%s efficacy against %s in generation %d = %d, want %d",
+						test.typ, test.ability, gen.ID, te.EfficacyLevel(), want,
+					)
+				}
+			}
+		}
+	}
+}
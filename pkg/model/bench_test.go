@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+// runBenchmark times run after a warm-up call, so that it measures lookups with
+// the name and type chart caches filled.
+func runBenchmark(b *testing.B, run func(context.Context, *model.Model) error) {
+	ctx := context.Background()
+	mdl := modeltest.Bench(b)
+
+	err := run(ctx, mdl)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := run(ctx, mdl)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSearchPokemon(b *testing.B) {
+	runBenchmark(b, func(ctx context.Context, mdl *model.Model) error {
+		_, err := mdl.SearchPokemon(ctx, "char", 10)
+		return err
+	})
+}
+
+func BenchmarkDefendingEfficacies(b *testing.B) {
+	runBenchmark(b, func(ctx context.Context, mdl *model.Model) error {
+		water := "water"
+		combo, err := mdl.TypeComboByNames(ctx, "ground", &water)
+		if err != nil {
+			return err
+		}
+		_, err = combo.DefendingEfficacies(ctx)
+		return err
+	})
+}
+
+func BenchmarkSearchPokemonMoves(b *testing.B) {
+	runBenchmark(b, func(ctx context.Context, mdl *model.Model) error {
+		pokemon, err := mdl.PokemonByName(ctx, "charizard")
+		if err != nil {
+			return err
+		}
+		methods, err := mdl.LearnMethodsByName(ctx, []model.LearnMethodName{model.LevelUp, model.Machine})
+		if err != nil {
+			return err
+		}
+		_, _, err = pokemon.SearchPokemonMoves(ctx, methods, nil, nil, 10, 0)
+		return err
+	})
+}
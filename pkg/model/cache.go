@@ -0,0 +1,132 @@
+package model
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheOptions configures the model's in-memory caches. A zero TTL keeps entries
+// until they are evicted for space, and a zero MaxEntries leaves each cache
+// unbounded.
+type CacheOptions struct {
+	Disabled   bool
+	TTL        time.Duration
+	MaxEntries int
+}
+
+// CacheStats counts the lookups in a cache since the model was created.
+type CacheStats struct {
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+	Entries int    `json:"entries"`
+}
+
+type cacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// cache is the map with optional expiry and size bound behind each of the
+// model's caches. When it is full, an arbitrary entry is evicted to make room,
+// which is cheap and good enough for the small, evenly used working sets here.
+type cache[K comparable, V any] struct {
+	mu      sync.RWMutex
+	entries map[K]cacheEntry[V]
+	opts    CacheOptions
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func newCache[K comparable, V any]() *cache[K, V] {
+	return &cache[K, V]{entries: make(map[K]cacheEntry[V])}
+}
+
+func (c *cache[K, V]) get(key K) (V, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if ok && !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		ok = false
+	}
+	if !ok {
+		c.misses.Add(1)
+		var zero V
+		return zero, false
+	}
+
+	c.hits.Add(1)
+	return entry.value, true
+}
+
+func (c *cache[K, V]) set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.opts.Disabled {
+		return
+	}
+
+	if _, ok := c.entries[key]; !ok && c.opts.MaxEntries > 0 {
+		for k := range c.entries {
+			if len(c.entries) < c.opts.MaxEntries {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+
+	entry := cacheEntry[V]{value: value}
+	if c.opts.TTL > 0 {
+		entry.expires = time.Now().Add(c.opts.TTL)
+	}
+	c.entries[key] = entry
+}
+
+func (c *cache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[K]cacheEntry[V])
+}
+
+// configure applies new options, dropping every entry so that none outlive the
+// new bounds.
+func (c *cache[K, V]) configure(opts CacheOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.opts = opts
+	c.entries = make(map[K]cacheEntry[V])
+}
+
+func (c *cache[K, V]) stats() CacheStats {
+	c.mu.RLock()
+	entries := len(c.entries)
+	c.mu.RUnlock()
+
+	return CacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: entries,
+	}
+}
+
+// ConfigureCaches applies the options to every cache shared by the model and its
+// forks, emptying them.
+func (m *Model) ConfigureCaches(opts CacheOptions) {
+	m.names.configure(opts)
+	m.charts.configure(opts)
+	m.maxStats.configure(opts)
+}
+
+// CacheStats reports the lookups in each of the model's caches, by cache name.
+func (m *Model) CacheStats() map[string]CacheStats {
+	return map[string]CacheStats{
+		"names":     m.names.stats(),
+		"charts":    m.charts.stats(),
+		"max_stats": m.maxStats.stats(),
+	}
+}
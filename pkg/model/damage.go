@@ -0,0 +1,199 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultDamageLevel is the level DamageRange assumes when none is given.
+const DefaultDamageLevel = 100
+
+// DamageOptions are the battle conditions DamageRange accounts for. The zero
+// value is a level 100 battle with no weather or terrain, where the attacker
+// holds no item.
+type DamageOptions struct {
+	Level   int
+	Weather Weather
+	Terrain Terrain
+	Item    *Item
+}
+
+// DamageRange is the lowest and highest damage a move can deal from its random
+// roll, alongside the defender's HP under the same stat assumptions.
+type DamageRange struct {
+	Min int
+	Max int
+
+	DefenderHP int
+}
+
+var (
+	ErrStatusMove                  = errors.New("status moves do not deal damage")
+	ErrUnsupportedDamageGeneration = errors.New("damage is only calculated from Generation III on")
+)
+
+// damageRolls are the percentages of the base damage the random roll can give.
+const (
+	minDamageRoll = 85
+	maxDamageRoll = 100
+)
+
+// DamageRange calculates the damage the attacker's move deals to the defender
+// in the generation of the model version, using the damage formula from
+// Generation V on. Earlier generations apply the same multipliers in a slightly
+// different order, so results for them can be off by a point.
+//
+// Both pokemon are assumed to have max IVs, no EVs, a neutral nature and no
+// stat stages, and the hit is not critical. Multipliers are applied in the
+// order of the damage formula, each rounded the way the games round it:
+//   - terrains and the attacker's item boost or weaken base power, treating
+//     flying types as the only pokemon that are not grounded;
+//   - Choice Band and Choice Specs raise the attacking stat, and sand and snow
+//     raise the defending stat of rock and ice types;
+//   - sun and rain boost or weaken fire and water moves after the base damage;
+//   - the random roll, STAB and type effectiveness follow in turn;
+//   - Life Orb boosts the final damage.
+//
+// Abilities, screens, spread moves, burns, moves whose power or type depends on
+// the battle (such as Weather Ball) and the effects of weather and terrain on
+// accuracy, priority or residual damage are out of scope.
+func (m *Model) DamageRange(
+	ctx context.Context,
+	attacker *Pokemon,
+	defender *Pokemon,
+	move *Move,
+	opts DamageOptions,
+) (*DamageRange, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+	if gen.ID < 3 {
+		return nil, ErrUnsupportedDamageGeneration
+	}
+
+	level := opts.Level
+	if level == 0 {
+		level = DefaultDamageLevel
+	}
+
+	class, err := move.AttackingDamageClass(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting damage class for move %q: %w", move.Name, err)
+	}
+	if class.Name == "status" || move.Power == nil {
+		return nil, ErrStatusMove
+	}
+
+	moveType, err := move.Type(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting type for move %q: %w", move.Name, err)
+	}
+
+	attackerCombo, err := attacker.TypeCombo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting types for pokemon %q: %w", attacker.Name, err)
+	}
+	defenderCombo, err := defender.TypeCombo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting types for pokemon %q: %w", defender.Name, err)
+	}
+
+	attackStat, defenseStat := StatNameAttack, StatNameDefense
+	if class.Name == "special" {
+		attackStat, defenseStat = StatNameSpecialAttack, StatNameSpecialDefense
+	}
+	attack, err := battleStat(ctx, attacker, attackStat, level)
+	if err != nil {
+		return nil, err
+	}
+	defense, err := battleStat(ctx, defender, defenseStat, level)
+	if err != nil {
+		return nil, err
+	}
+	hp, err := battleStat(ctx, defender, StatNameHP, level)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := m.damageFactor(ctx, moveType, defenderCombo)
+	if err != nil {
+		return nil, err
+	}
+	if factor == int(Immune) {
+		return &DamageRange{DefenderHP: hp}, nil
+	}
+
+	item := opts.Item.DamageModifiers(gen, moveType, class)
+	power := atLeastOne(ChainModifiers(
+		item.BasePower,
+		opts.Terrain.MoveModifier(gen, move, moveType, attackerCombo, defenderCombo),
+	).Apply(*move.Power))
+	attack = atLeastOne(item.Attack.Apply(attack))
+	defense = atLeastOne(opts.Weather.DefenseModifier(gen, defenderCombo, class).Apply(defense))
+
+	base := (2*level/5+2)*power*attack/defense/50 + 2
+	base = opts.Weather.MoveModifier(moveType).Apply(base)
+
+	stab := STABModifier(attackerCombo, moveType)
+	roll := func(percent int) int {
+		damage := base * percent / 100
+		damage = stab.Apply(damage)
+		damage = damage * factor / int(NormalEffective)
+		damage = item.Final.Apply(damage)
+		return atLeastOne(damage)
+	}
+
+	return &DamageRange{
+		Min:        roll(minDamageRoll),
+		Max:        roll(maxDamageRoll),
+		DefenderHP: hp,
+	}, nil
+}
+
+// battleStat is the pokemon's stat at the level with max IVs, no EVs and a
+// neutral nature.
+func battleStat(ctx context.Context, pokemon *Pokemon, name StatName, level int) (int, error) {
+	stat, err := pokemon.model.StatByName(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	base, err := pokemon.BaseStat(ctx, *stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return CalculateStat(name, base, MaxIV, 0, level, NatureNeutral), nil
+}
+
+// damageFactor is the damage factor of the attacking type against the combo,
+// in percent.
+func (m *Model) damageFactor(ctx context.Context, attacking *Type, defending *TypeCombo) (int, error) {
+	effs, err := defending.DefendingEfficacies(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting defending efficacies: %w", err)
+	}
+
+	for _, te := range effs {
+		if te.OpposingTypeID == attacking.ID {
+			return te.DamageFactor, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no efficacy found for type %q", attacking.Name)
+}
+
+// atLeastOne keeps a rounded stat, power or damage from reaching zero, as the
+// games do.
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
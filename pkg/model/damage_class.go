@@ -1,8 +1,14 @@
 package model
 
+import "context"
+
 type DamageClass struct {
 	model *Model
 
 	ID   int    `db:"id"`
 	Name string `db:"name"`
 }
+
+func (class *DamageClass) LocalizedName(ctx context.Context) (string, error) {
+	return class.model.localizedDamageClassName(ctx, class)
+}
@@ -0,0 +1,249 @@
+package model
+
+import "fmt"
+
+// Damage modifiers are expressed in 4096ths, matching the fixed-point
+// arithmetic the games use when chaining multipliers onto a damage roll.
+type DamageModifier int
+
+const (
+	NeutralModifier DamageModifier = 4096
+	HalfModifier    DamageModifier = 2048
+	BoostModifier   DamageModifier = 6144
+	TerrainModifier DamageModifier = 5325
+
+	TypeBoostModifier    DamageModifier = 4915
+	OldTypeBoostModifier DamageModifier = 4506
+	GemModifier          DamageModifier = 5325
+	LifeOrbModifier      DamageModifier = 5324
+)
+
+// Apply multiplies damage by the modifier, rounding halves down as the games
+// do.
+func (mod DamageModifier) Apply(damage int) int {
+	product := damage * int(mod)
+	return (product + 2048 - 1) / 4096
+}
+
+// ChainModifiers combines modifiers that act on the same part of the damage
+// formula into one, rounding after each step as the games do.
+func ChainModifiers(mods ...DamageModifier) DamageModifier {
+	chained := int(NeutralModifier)
+	for _, mod := range mods {
+		if mod == NeutralModifier {
+			continue
+		}
+		chained = (chained*int(mod) + 2048) / 4096
+	}
+
+	return DamageModifier(chained)
+}
+
+type Weather string
+
+const (
+	WeatherNone Weather = ""
+	WeatherSun  Weather = "sun"
+	WeatherRain Weather = "rain"
+	WeatherSand Weather = "sand"
+	WeatherSnow Weather = "snow"
+)
+
+var AllWeather = []Weather{
+	WeatherSun,
+	WeatherRain,
+	WeatherSand,
+	WeatherSnow,
+}
+
+// MoveModifier covers the boost and reduction that sun and rain give fire and
+// water moves.
+func (weather Weather) MoveModifier(moveType *Type) DamageModifier {
+	switch {
+	case weather == WeatherSun && moveType.Name == "fire",
+		weather == WeatherRain && moveType.Name == "water":
+		return BoostModifier
+	case weather == WeatherSun && moveType.Name == "water",
+		weather == WeatherRain && moveType.Name == "fire":
+		return HalfModifier
+	default:
+		return NeutralModifier
+	}
+}
+
+// DefenseModifier covers the defensive boost that sand gives rock types against
+// special moves from Generation IV on, and that snow gives ice types against
+// physical moves from Generation IX on. Hail, which snow replaced, has none.
+func (weather Weather) DefenseModifier(gen *Generation, defender *TypeCombo, class *DamageClass) DamageModifier {
+	switch {
+	case weather == WeatherSand && gen.ID >= 4 && class.Name == "special" && defender.hasTypeNamed("rock"),
+		weather == WeatherSnow && gen.ID >= 9 && class.Name == "physical" && defender.hasTypeNamed("ice"):
+		return BoostModifier
+	default:
+		return NeutralModifier
+	}
+}
+
+type Terrain string
+
+const (
+	TerrainNone     Terrain = ""
+	TerrainElectric Terrain = "electric"
+	TerrainGrassy   Terrain = "grassy"
+	TerrainPsychic  Terrain = "psychic"
+	TerrainMisty    Terrain = "misty"
+)
+
+var AllTerrains = []Terrain{
+	TerrainElectric,
+	TerrainGrassy,
+	TerrainPsychic,
+	TerrainMisty,
+}
+
+// introduced is the generation the terrain first appeared in.
+func (terrain Terrain) introduced() int {
+	if terrain == TerrainPsychic {
+		return 7
+	}
+	return 6
+}
+
+var grassyTerrainHalvedMoves = map[string]bool{
+	"earthquake": true,
+	"bulldoze":   true,
+	"magnitude":  true,
+}
+
+// MoveModifier is the terrain's effect on the base power of a move. Boosts need
+// the attacker to be grounded and reductions need the defender to be, which
+// only flying types are treated as not being. Terrain boosts dropped from 1.5x
+// to 1.3x in Generation VIII.
+func (terrain Terrain) MoveModifier(gen *Generation, move *Move, moveType *Type, attacker *TypeCombo, defender *TypeCombo) DamageModifier {
+	if terrain == TerrainNone || gen.ID < terrain.introduced() {
+		return NeutralModifier
+	}
+
+	boost := BoostModifier
+	if gen.ID >= 8 {
+		boost = TerrainModifier
+	}
+
+	switch {
+	case terrain == TerrainElectric && moveType.Name == "electric" && !attacker.hasTypeNamed("flying"),
+		terrain == TerrainGrassy && moveType.Name == "grass" && !attacker.hasTypeNamed("flying"),
+		terrain == TerrainPsychic && moveType.Name == "psychic" && !attacker.hasTypeNamed("flying"):
+		return boost
+	case terrain == TerrainGrassy && grassyTerrainHalvedMoves[move.Name] && !defender.hasTypeNamed("flying"),
+		terrain == TerrainMisty && moveType.Name == "dragon" && !defender.hasTypeNamed("flying"):
+		return HalfModifier
+	default:
+		return NeutralModifier
+	}
+}
+
+func (combo *TypeCombo) HasType(typ *Type) bool {
+	return (combo.Type1 != nil && combo.Type1.ID == typ.ID) ||
+		(combo.Type2 != nil && combo.Type2.ID == typ.ID) ||
+		(combo.Type3 != nil && combo.Type3.ID == typ.ID)
+}
+
+func (combo *TypeCombo) hasTypeNamed(name string) bool {
+	return (combo.Type1 != nil && combo.Type1.Name == name) ||
+		(combo.Type2 != nil && combo.Type2.Name == name) ||
+		(combo.Type3 != nil && combo.Type3.Name == name)
+}
+
+func STABModifier(attacker *TypeCombo, moveType *Type) DamageModifier {
+	if attacker.HasType(moveType) {
+		return BoostModifier
+	}
+
+	return NeutralModifier
+}
+
+var typeBoostingItems = map[string]string{
+	"silk-scarf":     "normal",
+	"black-belt":     "fighting",
+	"fist-plate":     "fighting",
+	"sharp-beak":     "flying",
+	"sky-plate":      "flying",
+	"poison-barb":    "poison",
+	"toxic-plate":    "poison",
+	"soft-sand":      "ground",
+	"earth-plate":    "ground",
+	"hard-stone":     "rock",
+	"stone-plate":    "rock",
+	"silver-powder":  "bug",
+	"insect-plate":   "bug",
+	"spell-tag":      "ghost",
+	"spooky-plate":   "ghost",
+	"metal-coat":     "steel",
+	"iron-plate":     "steel",
+	"charcoal":       "fire",
+	"flame-plate":    "fire",
+	"mystic-water":   "water",
+	"splash-plate":   "water",
+	"miracle-seed":   "grass",
+	"meadow-plate":   "grass",
+	"magnet":         "electric",
+	"zap-plate":      "electric",
+	"twisted-spoon":  "psychic",
+	"mind-plate":     "psychic",
+	"never-melt-ice": "ice",
+	"icicle-plate":   "ice",
+	"dragon-fang":    "dragon",
+	"draco-plate":    "dragon",
+	"black-glasses":  "dark",
+	"dread-plate":    "dark",
+	"fairy-feather":  "fairy",
+	"pixie-plate":    "fairy",
+}
+
+// ItemModifiers splits a held item's effect by where it enters the damage
+// formula: base power, the attacking stat, or the final damage.
+type ItemModifiers struct {
+	BasePower DamageModifier
+	Attack    DamageModifier
+	Final     DamageModifier
+}
+
+// DamageModifiers covers type-boosting items and plates (1.1x in Generation
+// III, 1.2x after), gems (1.5x in Generation V, 1.3x after), Choice Band and
+// Choice Specs, and Life Orb. A nil item has no effect.
+func (item *Item) DamageModifiers(gen *Generation, moveType *Type, class *DamageClass) ItemModifiers {
+	mods := ItemModifiers{
+		BasePower: NeutralModifier,
+		Attack:    NeutralModifier,
+		Final:     NeutralModifier,
+	}
+	if item == nil {
+		return mods
+	}
+
+	if typeName, ok := typeBoostingItems[item.Name]; ok && typeName == moveType.Name {
+		if gen.ID >= 4 {
+			mods.BasePower = TypeBoostModifier
+		} else {
+			mods.BasePower = OldTypeBoostModifier
+		}
+	}
+
+	if item.Name == fmt.Sprintf("%s-gem", moveType.Name) {
+		if gen.ID >= 6 {
+			mods.BasePower = GemModifier
+		} else {
+			mods.BasePower = BoostModifier
+		}
+	}
+
+	switch {
+	case item.Name == "choice-band" && class.Name == "physical",
+		item.Name == "choice-specs" && class.Name == "special":
+		mods.Attack = BoostModifier
+	case item.Name == "life-orb":
+		mods.Final = LifeOrbModifier
+	}
+
+	return mods
+}
@@ -0,0 +1,156 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+type damageTest struct {
+	name     string
+	attacker string
+	defender string
+	move     string
+	opts     model.DamageOptions
+	min      int
+	max      int
+}
+
+func testDamageRanges(t *testing.T, tests []damageTest) {
+	t.Helper()
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attacker, err := mdl.PokemonByName(ctx, test.attacker)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defender, err := mdl.PokemonByName(ctx, test.defender)
+			if err != nil {
+				t.Fatal(err)
+			}
+			move, err := mdl.MoveByName(ctx, test.move)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dr, err := mdl.DamageRange(ctx, attacker, defender, move, test.opts)
+			if err != nil {
+				t.Fatalf("DamageRange: %v", err)
+			}
+			if dr.Min != test.min || dr.Max != test.max {
+				t.Errorf("DamageRange = %d-%d, want %d-%d", dr.Min, dr.Max, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestDamageRangeWeatherAndTerrain(t *testing.T) {
+	testDamageRanges(t, []damageTest{
+		{name: "no weather", attacker: "venusaur", defender: "charmander", move: "flamethrower", min: 56, max: 66},
+		{
+			name: "sun", attacker: "venusaur", defender: "charmander", move: "flamethrower",
+			opts: model.DamageOptions{Weather: model.WeatherSun}, min: 84, max: 99,
+		},
+		{
+			name: "rain", attacker: "venusaur", defender: "charmander", move: "flamethrower",
+			opts: model.DamageOptions{Weather: model.WeatherRain}, min: 28, max: 33,
+		},
+		{
+			name: "level 50", attacker: "venusaur", defender: "charmander", move: "flamethrower",
+			opts: model.DamageOptions{Level: 50}, min: 29, max: 34,
+		},
+		{name: "no terrain", attacker: "charmander", defender: "venusaur", move: "vine-whip", min: 5, max: 7},
+		{
+			name: "grassy terrain", attacker: "charmander", defender: "venusaur", move: "vine-whip",
+			opts: model.DamageOptions{Terrain: model.TerrainGrassy}, min: 7, max: 9,
+		},
+		// flying types are not grounded, so terrain does not boost their moves
+		{
+			name: "grassy terrain ungrounded", attacker: "charizard", defender: "venusaur", move: "vine-whip",
+			opts: model.DamageOptions{Terrain: model.TerrainGrassy}, min: 8, max: 10,
+		},
+	})
+}
+
+func TestDamageRangeImmune(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	attacker, err := mdl.PokemonByName(ctx, "charmander")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defender, err := mdl.PokemonByName(ctx, "gengar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	move, err := mdl.MoveByName(ctx, "tackle")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dr, err := mdl.DamageRange(ctx, attacker, defender, move, model.DamageOptions{})
+	if err != nil {
+		t.Fatalf("DamageRange: %v", err)
+	}
+	if dr.Min != 0 || dr.Max != 0 {
+		t.Errorf("DamageRange of tackle against gengar = %d-%d, want 0-0", dr.Min, dr.Max)
+	}
+
+	status, err := mdl.MoveByName(ctx, "hypnosis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = mdl.DamageRange(ctx, attacker, defender, status, model.DamageOptions{})
+	if !errors.Is(err, model.ErrStatusMove) {
+		t.Errorf("DamageRange of hypnosis: got error %v, want %v", err, model.ErrStatusMove)
+	}
+}
+
+func TestDamageRangeItems(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	charcoal, err := mdl.ItemByName(ctx, "charcoal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lifeOrb, err := mdl.ItemByName(ctx, "Life Orb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testDamageRanges(t, []damageTest{
+		{name: "stab", attacker: "charizard", defender: "venusaur", move: "flamethrower", min: 210, max: 248},
+		{
+			name: "type-boosting item", attacker: "charizard", defender: "venusaur", move: "flamethrower",
+			opts: model.DamageOptions{Item: charcoal}, min: 252, max: 296,
+		},
+		{
+			name: "type-boosting item for another type", attacker: "charizard", defender: "venusaur", move: "vine-whip",
+			opts: model.DamageOptions{Item: charcoal}, min: 8, max: 10,
+		},
+		{
+			name: "choice specs", attacker: "charizard", defender: "venusaur", move: "flamethrower",
+			opts: model.DamageOptions{Item: &model.Item{Name: "choice-specs"}}, min: 314, max: 372,
+		},
+		{
+			name: "choice band on a special move", attacker: "charizard", defender: "venusaur", move: "flamethrower",
+			opts: model.DamageOptions{Item: &model.Item{Name: "choice-band"}}, min: 210, max: 248,
+		},
+		{
+			name: "life orb", attacker: "charizard", defender: "venusaur", move: "flamethrower",
+			opts: model.DamageOptions{Item: lifeOrb}, min: 273, max: 322,
+		},
+		{
+			name: "life orb in sun", attacker: "charizard", defender: "venusaur", move: "flamethrower",
+			opts: model.DamageOptions{Item: lifeOrb, Weather: model.WeatherSun}, min: 408, max: 484,
+		},
+	})
+}
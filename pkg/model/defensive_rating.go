@@ -0,0 +1,62 @@
+package model
+
+import (
+	"context"
+	"math"
+)
+
+// immuneExponent counts an immunity as a double resistance when averaging type
+// matchups, since an immunity to one type rarely makes up for weaknesses to
+// others the way a zero multiplier would suggest.
+const immuneExponent = -2
+
+// DefensiveRating is a heuristic summary of a pokemon's bulk, combining its
+// defensive type chart with its HP and defensive base stats. It is meant for
+// quick comparisons only and ignores abilities, items and natures.
+type DefensiveRating struct {
+	// Score grows with bulk, and is around 100 for a pokemon with 100 in each
+	// defensive stat that takes neutral damage on average.
+	Score int
+	// TypeMultiplier is the average damage multiplier the pokemon takes across
+	// every attacking type, as a geometric mean.
+	TypeMultiplier float64
+
+	HP             int
+	Defense        int
+	SpecialDefense int
+}
+
+// RateDefense scores a defensive type chart and defensive base stats. The
+// average multiplier treats each halving or doubling of damage equally, and the
+// score is the square root of the HP and average defense product divided by it.
+func RateDefense(effs []TypeEfficacy, hp int, defense int, specialDefense int) DefensiveRating {
+	exponents := 0.0
+	for _, te := range effs {
+		if te.DamageFactor == int(Immune) {
+			exponents += immuneExponent
+		} else {
+			exponents += math.Log2(float64(te.DamageFactor) / float64(NormalEffective))
+		}
+	}
+
+	multiplier := 1.0
+	if len(effs) > 0 {
+		multiplier = math.Exp2(exponents / float64(len(effs)))
+	}
+
+	bulk := float64(hp) * float64(defense+specialDefense) / 2
+
+	return DefensiveRating{
+		Score:          int(math.Round(math.Sqrt(bulk / multiplier))),
+		TypeMultiplier: multiplier,
+		HP:             hp,
+		Defense:        defense,
+		SpecialDefense: specialDefense,
+	}
+}
+
+// DefensiveRating rates the pokemon's bulk in the generation of the model
+// version, using its type chart and base stats in that generation.
+func (pokemon *Pokemon) DefensiveRating(ctx context.Context) (*DefensiveRating, error) {
+	return pokemon.model.pokemonDefensiveRating(ctx, pokemon)
+}
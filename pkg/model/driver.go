@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName is the sqlite driver registered with the custom functions used by
+// model queries.
+const driverName = "sqlite3_pokedex"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			// remove_accents allows localized names to be matched regardless of diacritics.
+			return conn.RegisterFunc("remove_accents", RemoveAccents, true)
+		},
+	})
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"sync"
 )
 
 type Generation struct {
@@ -14,3 +15,53 @@ type Generation struct {
 func (gen *Generation) LocalizedName(ctx context.Context) (string, error) {
 	return gen.model.localizedGenerationName(ctx, gen)
 }
+
+// SplitSpecialGeneration is the first generation with separate Special Attack
+// and Special Defense stats. Earlier games used a single Special stat.
+const SplitSpecialGeneration = 2
+
+func (gen *Generation) HasSplitSpecial() bool {
+	return gen.ID >= SplitSpecialGeneration
+}
+
+// PhysicalSpecialSplitGeneration is the first generation where each damaging
+// move is physical or special on its own. Earlier, the move's type decided.
+const PhysicalSpecialSplitGeneration = 4
+
+func (gen *Generation) HasPhysicalSpecialSplit() bool {
+	return gen.ID >= PhysicalSpecialSplitGeneration
+}
+
+// HiddenAbilityGeneration is the first generation where pokemon can have hidden
+// abilities.
+const HiddenAbilityGeneration = 5
+
+func (gen *Generation) HasHiddenAbilities() bool {
+	return gen.ID >= HiddenAbilityGeneration
+}
+
+const (
+	FirstMegaGeneration = 6
+	LastMegaGeneration  = 7
+)
+
+func (gen *Generation) HasMegaEvolution() bool {
+	return gen.ID >= FirstMegaGeneration && gen.ID <= LastMegaGeneration
+}
+
+// GenerationCounts is how much data is available up to a generation.
+type GenerationCounts struct {
+	Species int `db:"species"`
+	Moves   int `db:"moves"`
+	Types   int `db:"types"`
+}
+
+func (gen *Generation) Counts(ctx context.Context) (*GenerationCounts, error) {
+	return gen.model.generationCounts(ctx, gen)
+}
+
+// generationCache holds a generation that is fixed for a given database.
+type generationCache struct {
+	mu  sync.Mutex
+	gen *Generation
+}
@@ -0,0 +1,14 @@
+package model
+
+import "context"
+
+type Item struct {
+	model *Model
+
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+func (item *Item) LocalizedName(ctx context.Context) (string, error) {
+	return item.model.localizedItemName(ctx, item)
+}
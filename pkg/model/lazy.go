@@ -0,0 +1,33 @@
+package model
+
+import "sync"
+
+// lazyMu guards the lazily loaded fields of model entities, so that an entity
+// can be shared between goroutines. It is only held to read or set a field, not
+// while loading it.
+var lazyMu sync.RWMutex
+
+// loadLazy returns the value cached in field, loading and caching it first if
+// it is unset. Goroutines racing to load the same field may each run load, in
+// which case the first value stored is kept and returned to all of them.
+func loadLazy[T any](field **T, load func() (*T, error)) (*T, error) {
+	lazyMu.RLock()
+	v := *field
+	lazyMu.RUnlock()
+	if v != nil {
+		return v, nil
+	}
+
+	v, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	lazyMu.Lock()
+	defer lazyMu.Unlock()
+	if *field == nil {
+		*field = v
+	}
+
+	return *field, nil
+}
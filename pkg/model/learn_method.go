@@ -5,6 +5,8 @@ type LearnMethodName string
 const (
 	LevelUp LearnMethodName = "level-up"
 	Egg     LearnMethodName = "egg"
+	Tutor   LearnMethodName = "tutor"
+	Machine LearnMethodName = "machine"
 )
 
 type LearnMethod struct {
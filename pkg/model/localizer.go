@@ -5,3 +5,31 @@ import "context"
 type Localizer interface {
 	LocalizedName(context.Context) (string, error)
 }
+
+// cachedLocalizer decorates a Localizer, remembering the first name it returns
+// so that naming the same resource again skips the lookup. Failed lookups are
+// not remembered.
+type cachedLocalizer[T Localizer] struct {
+	localizer T
+	name      *string
+}
+
+// CacheLocalizer wraps a localizer so that its name is only looked up once.
+func CacheLocalizer[T Localizer](localizer T) Localizer {
+	return &cachedLocalizer[T]{localizer: localizer}
+}
+
+func (c *cachedLocalizer[T]) LocalizedName(ctx context.Context) (string, error) {
+	name, err := loadLazy(&c.name, func() (*string, error) {
+		name, err := c.localizer.LocalizedName(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &name, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return *name, nil
+}
@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+type countingLocalizer struct {
+	name  string
+	err   error
+	calls int
+}
+
+func (l *countingLocalizer) LocalizedName(context.Context) (string, error) {
+	l.calls++
+	return l.name, l.err
+}
+
+func TestCacheLocalizer(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingLocalizer{name: "Glurak"}
+	cached := model.CacheLocalizer(inner)
+
+	for i := 0; i < 3; i++ {
+		name, err := cached.LocalizedName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name != "Glurak" {
+			t.Errorf("LocalizedName = %q, want %q", name, "Glurak")
+		}
+	}
+	if inner.calls != 1 {
+		t.Errorf("wrapped localizer was called %d times, want 1", inner.calls)
+	}
+}
+
+func TestCacheLocalizerError(t *testing.T) {
+	ctx := context.Background()
+	errLookup := errors.New("lookup failed")
+	inner := &countingLocalizer{err: errLookup}
+	cached := model.CacheLocalizer(inner)
+
+	_, err := cached.LocalizedName(ctx)
+	if !errors.Is(err, errLookup) {
+		t.Errorf("LocalizedName error = %v, want %v", err, errLookup)
+	}
+
+	inner.name, inner.err = "Glurak", nil
+	name, err := cached.LocalizedName(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Glurak" || inner.calls != 2 {
+		t.Errorf("LocalizedName after a failure = %q with %d calls, want %q with 2", name, inner.calls, "Glurak")
+	}
+}
+
+func TestSearchAllLocalizedNames(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	results, err := mdl.SearchAll(ctx, "char", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 {
+		t.Fatal("SearchAll found no results for \"char\"")
+	}
+
+	for _, res := range results {
+		first, err := res.LocalizedName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := res.LocalizedName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first == "" || first != second {
+			t.Errorf("LocalizedName of %q = %q then %q", res.Name, first, second)
+		}
+	}
+}
@@ -2,25 +2,39 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/mattn/go-sqlite3"
 	"github.com/notjagan/pokedex/pkg/model/sprite"
+	"golang.org/x/sync/errgroup"
 )
 
 type Model struct {
-	db *sqlx.DB
+	db       *loggedDB
+	names    *nameCache
+	latest   *generationCache
+	charts   *typeChartCache
+	maxStats *maxStatCache
 
 	Language *Language
 	Version  *Version
 }
 
 func New(ctx context.Context, dbPath string) (*Model, error) {
-	db, err := sqlx.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", dbPath))
+	return Open(ctx, fmt.Sprintf("file:%s?mode=ro", dbPath))
+}
+
+// Open creates a model over the sqlite database named by dsn, which may be any
+// data source name accepted by the sqlite driver, such as a shared in-memory
+// database.
+func Open(ctx context.Context, dsn string) (*Model, error) {
+	db, err := sqlx.Open(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -29,13 +43,29 @@ func New(ctx context.Context, dbPath string) (*Model, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to read from database: %w", err)
 	}
-	return &Model{db: db}, nil
+	return &Model{
+		db:       &loggedDB{DB: db},
+		names:    newCache[nameKey, string](),
+		latest:   &generationCache{},
+		charts:   newCache[int, typeChart](),
+		maxStats: newCache[int, int](),
+	}, nil
 }
 
 func (m *Model) Close() error {
 	return m.db.Close()
 }
 
+func (m *Model) Ping(ctx context.Context) error {
+	return m.db.PingContext(ctx)
+}
+
+// Fork returns a model sharing the database handle and caches of m, with no
+// language or version set. Only the original model should be closed.
+func (m *Model) Fork() *Model {
+	return &Model{db: m.db, names: m.names, latest: m.latest, charts: m.charts, maxStats: m.maxStats}
+}
+
 var ErrUnsetLanguage = errors.New("model language is nil")
 
 func (m *Model) languageByLocalizationCode(ctx context.Context, code LocalizationCode) (*Language, error) {
@@ -76,6 +106,8 @@ func (m *Model) SetLanguageByLocale(ctx context.Context, locale discordgo.Locale
 	return m.SetLanguageByLocalizationCode(ctx, code)
 }
 
+// versionByName looks up a version by its slug, like "sword", falling back to
+// its localized name.
 func (m *Model) versionByName(ctx context.Context, name string) (*Version, error) {
 	ver := Version{model: m}
 	err := m.db.QueryRowxContext(ctx,
@@ -83,14 +115,60 @@ func (m *Model) versionByName(ctx context.Context, name string) (*Version, error
 		SELECT id, version_group_id, name
 		FROM pokemon_v2_version
 		WHERE name = ?
-	`, name).StructScan(&ver)
-	if err != nil {
+	`, NormalizeName(name)).StructScan(&ver)
+	if errors.Is(err, sql.ErrNoRows) {
+		return m.versionByLocalizedName(ctx, name)
+	} else if err != nil {
 		return nil, fmt.Errorf("version %q not found: %w", name, err)
 	}
 
 	return &ver, nil
 }
 
+// VersionByLocalizedName looks up a version by its title in any language, like
+// "Sword" or "Schwert", with or without a leading "Pokémon". Titles in the
+// model's language take precedence.
+func (m *Model) VersionByLocalizedName(ctx context.Context, name string) (*Version, error) {
+	return m.versionByLocalizedName(ctx, name)
+}
+
+func (m *Model) versionByLocalizedName(ctx context.Context, name string) (*Version, error) {
+	title := strings.TrimSpace(RemoveAccents(name))
+	if strings.HasPrefix(strings.ToLower(title), versionTitlePrefix) {
+		title = strings.TrimSpace(title[len(versionTitlePrefix):])
+	}
+
+	languageID := 0
+	if m.Language != nil {
+		languageID = m.Language.ID
+	}
+
+	// titles are compared with LIKE only to ignore case, so any wildcards in
+	// them are escaped
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(title)
+
+	ver := Version{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT v.id, v.version_group_id, v.name
+		FROM pokemon_v2_version v
+		JOIN pokemon_v2_versionname n
+			ON v.id = n.version_id
+		WHERE remove_accents(n.name) LIKE ? ESCAPE '\'
+		ORDER BY n.language_id = ? DESC, v.id ASC
+		LIMIT 1
+	`, escaped, languageID).StructScan(&ver)
+	if err != nil {
+		return nil, fmt.Errorf("version with localized name %q not found: %w", name, err)
+	}
+
+	return &ver, nil
+}
+
+// versionTitlePrefix starts the full titles of the games, which the version
+// names leave out.
+const versionTitlePrefix = "pokemon "
+
 var ErrUnsetVersion = errors.New("model version is nil")
 
 func (m *Model) SetVersionByName(ctx context.Context, name string) error {
@@ -104,6 +182,35 @@ func (m *Model) SetVersionByName(ctx context.Context, name string) error {
 	return nil
 }
 
+// SetDefaultVersion sets the version to the default if it has a name in the
+// model's language, and otherwise to the most recent version that does.
+func (m *Model) SetDefaultVersion(ctx context.Context) error {
+	if m.Language == nil {
+		return ErrUnsetLanguage
+	}
+
+	var name string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT v.name
+		FROM pokemon_v2_version v
+		JOIN pokemon_v2_versiongroup vg
+			ON v.version_group_id = vg.id
+		JOIN pokemon_v2_versionname n
+			ON v.id = n.version_id
+		WHERE n.language_id = ?
+		ORDER BY v.name = ? DESC, vg."order" DESC, v.id DESC
+		LIMIT 1
+	`, m.Language.ID, VersionNameSword).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		name = VersionNameSword
+	} else if err != nil {
+		return fmt.Errorf("error while finding default version for language %q: %w", m.Language.ISO639, err)
+	}
+
+	return m.SetVersionByName(ctx, name)
+}
+
 func (m *Model) GenerationByID(ctx context.Context, id int) (*Generation, error) {
 	gen := Generation{model: m}
 	err := m.db.QueryRowxContext(ctx,
@@ -123,7 +230,7 @@ func (m *Model) versionGroupByID(ctx context.Context, id int) (*VersionGroup, er
 	vg := VersionGroup{model: m}
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
-		SELECT id, generation_id, name
+		SELECT id, generation_id, name, "order"
 		FROM pokemon_v2_versiongroup
 		WHERE id = ?
 	`, id).StructScan(&vg)
@@ -134,23 +241,83 @@ func (m *Model) versionGroupByID(ctx context.Context, id int) (*VersionGroup, er
 	return &vg, nil
 }
 
-func (m *Model) latestGeneration(ctx context.Context) (*Generation, error) {
-	var id int
+func (m *Model) generationCounts(ctx context.Context, gen *Generation) (*GenerationCounts, error) {
+	var counts GenerationCounts
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
-		SELECT max(id) as id
+		SELECT
+			(
+				SELECT COUNT(*)
+				FROM pokemon_v2_pokemonspecies
+				WHERE generation_id <= ?
+			) AS species,
+			(
+				SELECT COUNT(*)
+				FROM pokemon_v2_move mv
+				JOIN pokemon_v2_type t
+					ON mv.type_id = t.id
+				WHERE mv.generation_id <= ? AND t.name != 'shadow'
+			) AS moves,
+			(
+				SELECT COUNT(*)
+				FROM pokemon_v2_type
+				WHERE generation_id <= ? AND name NOT IN ('unknown', 'shadow')
+			) AS types
+	`, gen.ID, gen.ID, gen.ID).StructScan(&counts)
+	if err != nil {
+		return nil, fmt.Errorf("could not count data for generation %d: %w", gen.ID, err)
+	}
+
+	return &counts, nil
+}
+
+// LatestGeneration returns the most recent generation in the database. It is
+// looked up once and shared between forks.
+func (m *Model) LatestGeneration(ctx context.Context) (*Generation, error) {
+	m.latest.mu.Lock()
+	defer m.latest.mu.Unlock()
+
+	if m.latest.gen == nil {
+		var id int
+		err := m.db.QueryRowxContext(ctx,
+			/* sql */ `
+			SELECT max(id) as id
+			FROM pokemon_v2_generation
+		`).Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get latest generation id: %w", err)
+		}
+
+		gen, err := m.GenerationByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not find latest generation: %w", err)
+		}
+		m.latest.gen = gen
+	}
+
+	gen := *m.latest.gen
+	gen.model = m
+
+	return &gen, nil
+}
+
+func (m *Model) AllGenerations(ctx context.Context) ([]*Generation, error) {
+	var gens []*Generation
+	err := m.db.SelectContext(ctx, &gens,
+		/* sql */ `
+		SELECT id, name
 		FROM pokemon_v2_generation
-	`).Scan(&id)
+		ORDER BY id ASC
+	`)
 	if err != nil {
-		return nil, fmt.Errorf("could not get latest generation id: %w", err)
+		return nil, fmt.Errorf("error while getting all generations: %w", err)
 	}
 
-	gen, err := m.GenerationByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("could not find latest generation: %w", err)
+	for i := range gens {
+		gens[i].model = m
 	}
 
-	return gen, nil
+	return gens, nil
 }
 
 func (m *Model) versionHasPokemon(ctx context.Context, ver *Version, pokemon *Pokemon) (bool, error) {
@@ -175,6 +342,23 @@ func (m *Model) versionHasPokemon(ctx context.Context, ver *Version, pokemon *Po
 	return exists, nil
 }
 
+func (m *Model) versionHasLearnset(ctx context.Context, ver *Version, pokemon *Pokemon) (bool, error) {
+	var exists bool
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT EXISTS (
+			SELECT 1
+			FROM pokemon_v2_pokemonmove
+			WHERE pokemon_id = ? AND version_group_id = ?
+		)
+	`, pokemon.ID, ver.VersionGroupID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("error while querying learnset of pokemon %q for version: %w", pokemon.Name, err)
+	}
+
+	return exists, nil
+}
+
 func (m *Model) versionHasMove(ctx context.Context, ver *Version, move *Move) (bool, error) {
 	gen, err := ver.Generation(ctx)
 	if err != nil {
@@ -229,6 +413,23 @@ func (m *Model) validateMoveVersion(ctx context.Context, move *Move) error {
 	return nil
 }
 
+func (m *Model) validateAbilityVersion(ctx context.Context, ability *Ability) error {
+	if m.Version == nil {
+		return fmt.Errorf("failed to check if version has ability: %w", ErrUnsetVersion)
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	if ability.GenerationID > gen.ID {
+		return ErrWrongGeneration
+	}
+
+	return nil
+}
+
 func (m *Model) PokemonById(ctx context.Context, id int) (*Pokemon, error) {
 	pokemon := Pokemon{model: m}
 	err := m.db.QueryRowxContext(ctx,
@@ -256,7 +457,30 @@ func (m *Model) PokemonByName(ctx context.Context, name string) (*Pokemon, error
 		SELECT id, name, pokemon_species_id
 		FROM pokemon_v2_pokemon
 		WHERE name = ?
-	`, name).StructScan(&pokemon)
+	`, NormalizeName(name)).StructScan(&pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("no matching pokemon found: %w", err)
+	}
+
+	err = m.validatePokemonVersion(ctx, &pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pokemon for generation: %w", err)
+	}
+
+	return &pokemon, nil
+}
+
+// PokemonByDexNumber returns the default form of the species with the given
+// national dex number. Dex numbers are species IDs, which only match pokemon IDs
+// for default forms.
+func (m *Model) PokemonByDexNumber(ctx context.Context, number int) (*Pokemon, error) {
+	pokemon := Pokemon{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, name, pokemon_species_id
+		FROM pokemon_v2_pokemon
+		WHERE pokemon_species_id = ? AND is_default
+	`, number).StructScan(&pokemon)
 	if err != nil {
 		return nil, fmt.Errorf("no matching pokemon found: %w", err)
 	}
@@ -274,6 +498,11 @@ func (m *Model) localizedPokemonName(ctx context.Context, pokemon *Pokemon) (str
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "pokemon_species", id: pokemon.SpeciesID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -290,9 +519,114 @@ func (m *Model) localizedPokemonName(ctx context.Context, pokemon *Pokemon) (str
 		)
 	}
 
+	m.names.set(key, name)
+
+	return name, nil
+}
+
+func (m *Model) localizedPokemonFormName(ctx context.Context, pokemon *Pokemon) (string, error) {
+	if m.Language == nil {
+		return "", ErrUnsetLanguage
+	}
+
+	key := nameKey{resource: "pokemon_form", id: pokemon.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
+	var name string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT n.pokemon_name
+		FROM pokemon_v2_pokemonform f
+		JOIN pokemon_v2_pokemonformname n
+			ON f.id = n.pokemon_form_id
+		WHERE f.pokemon_id = ? AND f.is_default AND n.language_id = ?
+	`, pokemon.ID, m.Language.ID).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && name == "") {
+		return m.localizedPokemonName(ctx, pokemon)
+	} else if err != nil {
+		return "", fmt.Errorf(
+			"could not find localized form name for pokemon %q for language with code %q: %w",
+			pokemon.Name,
+			m.Language.ISO639,
+			err,
+		)
+	}
+
+	m.names.set(key, name)
+
 	return name, nil
 }
 
+func (m *Model) pokemonForms(ctx context.Context, pokemon *Pokemon) ([]*Pokemon, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	var forms []*Pokemon
+	err = m.db.SelectContext(ctx, &forms,
+		/* sql */ `
+		SELECT p.id, p.name, p.pokemon_species_id
+		FROM pokemon_v2_pokemon p
+		JOIN pokemon_v2_pokemonform f
+			ON p.id = f.pokemon_id AND f.is_default
+		JOIN pokemon_v2_versiongroup vg
+			ON f.version_group_id = vg.id
+		WHERE p.pokemon_species_id = ? AND vg.generation_id <= ?
+		ORDER BY p.is_default DESC, p.id ASC
+	`, pokemon.SpeciesID, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get forms for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	for i := range forms {
+		forms[i].model = m
+	}
+
+	return forms, nil
+}
+
+func (m *Model) pokemonMegaForms(ctx context.Context, pokemon *Pokemon) ([]*Pokemon, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	if !gen.HasMegaEvolution() {
+		return nil, nil
+	}
+
+	var megas []*Pokemon
+	err = m.db.SelectContext(ctx, &megas,
+		/* sql */ `
+		SELECT p.id, p.name, p.pokemon_species_id
+		FROM pokemon_v2_pokemon p
+		JOIN pokemon_v2_pokemonform f
+			ON p.id = f.pokemon_id AND f.is_default
+		WHERE p.pokemon_species_id = ? AND f.is_mega
+		ORDER BY p.id ASC
+	`, pokemon.SpeciesID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get mega forms for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	for i := range megas {
+		megas[i].model = m
+	}
+
+	return megas, nil
+}
+
 func (m *Model) AllVersions(ctx context.Context) ([]Version, error) {
 	var vers []Version
 	err := m.db.SelectContext(ctx, &vers,
@@ -311,6 +645,26 @@ func (m *Model) AllVersions(ctx context.Context) ([]Version, error) {
 	return vers, nil
 }
 
+func (m *Model) versionGroupVersions(ctx context.Context, vg *VersionGroup) ([]Version, error) {
+	var vers []Version
+	err := m.db.SelectContext(ctx, &vers,
+		/* sql */ `
+		SELECT id, version_group_id, name
+		FROM pokemon_v2_version
+		WHERE version_group_id = ?
+		ORDER BY id
+	`, vg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting versions for version group %q: %w", vg.Name, err)
+	}
+
+	for i := range vers {
+		vers[i].model = m
+	}
+
+	return vers, nil
+}
+
 func (m *Model) AllLanguages(ctx context.Context) ([]*Language, error) {
 	langs := make([]*Language, len(AllLocalizationCodes))
 
@@ -330,6 +684,11 @@ func (m *Model) localizedLanguageName(ctx context.Context, lang *Language) (stri
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "language", id: lang.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -341,6 +700,8 @@ func (m *Model) localizedLanguageName(ctx context.Context, lang *Language) (stri
 		return "", fmt.Errorf("error while getting localized name for language with code %q: %w", lang.ISO639, err)
 	}
 
+	m.names.set(key, name)
+
 	return name, nil
 }
 
@@ -403,9 +764,9 @@ func (m *Model) searchPokemonMoves(
 		return nil, false, fmt.Errorf("error while getting moves for pokemon in generation: %w", err)
 	}
 
-	for i := range moves {
-		moves[i].model = m
-		moves[i].Move.model = m
+	err = m.resolvePokemonMoves(ctx, moves)
+	if err != nil {
+		return nil, false, fmt.Errorf("error while resolving pokemon moves: %w", err)
 	}
 
 	var hasNext bool
@@ -419,67 +780,519 @@ func (m *Model) searchPokemonMoves(
 	return moves, hasNext, nil
 }
 
-func (m *Model) moveChanges(ctx context.Context, moveID int) ([]MoveChange, error) {
-	var changes []MoveChange
-	err := m.db.SelectContext(ctx, &changes,
-		/* sql */ `
-		SELECT power, pp, accuracy, type_id, version_group_id, move_id
-		FROM pokemon_v2_movechange
-		WHERE move_id = ? AND version_group_id > ?
-		ORDER BY version_group_id DESC
-	`, moveID, m.Version.VersionGroupID)
-	if err != nil {
-		return nil, fmt.Errorf("could not find move changes for move: %w", err)
+// resolvePokemonMoves attaches the model to learnset entries selected along with
+// their moves. Learnset entries are already scoped to the version group, so only
+// the move changes need to be applied.
+func (m *Model) resolvePokemonMoves(ctx context.Context, pms []PokemonMove) error {
+	moves := make([]*Move, len(pms))
+	for i := range pms {
+		pms[i].model = m
+		pms[i].Move.model = m
+		moves[i] = pms[i].Move
 	}
 
-	for i := range changes {
-		changes[i].model = m
+	return m.applyMoveChanges(ctx, moves)
+}
+
+func (m *Model) searchLineMoves(
+	ctx context.Context,
+	pokemon *Pokemon,
+	methods []*LearnMethod,
+	maxLevel *int,
+	limit int,
+	offset int,
+) ([]LineMove, bool, error) {
+	if m.Version == nil {
+		return nil, false, ErrUnsetVersion
 	}
 
-	return changes, nil
-}
+	lvl := 100
+	if maxLevel != nil {
+		lvl = *maxLevel
+	}
 
-func (m *Model) MoveByName(ctx context.Context, name string) (*Move, error) {
-	move := Move{model: m}
-	err := m.db.QueryRowxContext(ctx,
+	ids := make([]int, len(methods))
+	for i, method := range methods {
+		ids[i] = method.ID
+	}
+
+	// pre-evolutions are represented by the default form of each earlier species,
+	// and ties at the same level go to the latest stage
+	query, args, err := sqlx.In(
 		/* sql */ `
-		SELECT id, power, pp, accuracy, move_damage_class_id, type_id, name
-		FROM pokemon_v2_move
-		WHERE name = ?
-	`, name).StructScan(&move)
+		WITH RECURSIVE line(species_id, depth) AS (
+			SELECT ?, 0
+			UNION ALL
+			SELECT s.evolves_from_species_id, line.depth + 1
+			FROM pokemon_v2_pokemonspecies s
+			JOIN line
+				ON s.id = line.species_id
+			WHERE s.evolves_from_species_id IS NOT NULL
+		), stages(pokemon_id, depth) AS (
+			SELECT ?, 0
+			UNION ALL
+			SELECT p.id, line.depth
+			FROM pokemon_v2_pokemon p
+			JOIN line
+				ON p.pokemon_species_id = line.species_id
+			WHERE line.depth > 0 AND p.is_default
+		)
+		SELECT
+			m.id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name,
+			p.level, p.move_id, p.move_learn_method_id,
+			st.id AS stage_id, st.name AS stage_name, st.pokemon_species_id AS stage_species_id
+		FROM (
+			SELECT
+				pm.id, pm.level, pm.move_id, pm.move_learn_method_id, pm.pokemon_id,
+				ROW_NUMBER() OVER (PARTITION BY pm.move_id ORDER BY pm.level ASC, s.depth ASC, pm.id ASC) AS n
+			FROM pokemon_v2_pokemonmove pm
+			JOIN stages s
+				ON pm.pokemon_id = s.pokemon_id
+			WHERE pm.version_group_id = ? AND pm.level <= ? AND pm.move_learn_method_id IN (?)
+		) p
+		JOIN pokemon_v2_move m
+			ON p.move_id = m.id
+		JOIN pokemon_v2_pokemon st
+			ON p.pokemon_id = st.id
+		WHERE p.n = 1
+		ORDER BY p.level ASC, p.id ASC
+		LIMIT ? OFFSET ?
+	`, pokemon.SpeciesID, pokemon.ID, m.Version.VersionGroupID, lvl, ids, limit+1, offset)
 	if err != nil {
-		return nil, fmt.Errorf("no matching move found: %w", err)
+		return nil, false, fmt.Errorf("error while constructing query: %w", err)
 	}
 
-	err = m.validateMoveVersion(ctx, &move)
+	var moves []LineMove
+	err = m.db.SelectContext(ctx, &moves, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("move not found in version: %w", err)
+		return nil, false, fmt.Errorf("error while getting moves for evolution line of pokemon %q: %w", pokemon.Name, err)
+	}
+
+	resolved := make([]*Move, len(moves))
+	for i := range moves {
+		moves[i].model = m
+		moves[i].Move.model = m
+		resolved[i] = moves[i].Move
 	}
 
-	changes, err := m.moveChanges(ctx, move.ID)
+	err = m.applyMoveChanges(ctx, resolved)
 	if err != nil {
-		return nil, fmt.Errorf("error while getting move changes: %w", err)
+		return nil, false, fmt.Errorf("error while resolving pokemon moves: %w", err)
 	}
 
-	move.applyChanges(changes)
+	hasNext := len(moves) == limit+1
+	if hasNext {
+		moves = moves[:limit]
+	}
 
-	return &move, nil
+	return moves, hasNext, nil
 }
 
-func (m *Model) typeByID(ctx context.Context, id int) (*Type, error) {
-	typ := Type{model: m}
-	err := m.db.QueryRowxContext(ctx,
+// CanLearn returns each way the pokemon learns the move in the model version
+// group, ordered by learn method and level. It is empty if the pokemon cannot
+// learn the move.
+func (m *Model) CanLearn(ctx context.Context, pokemon *Pokemon, move *Move) ([]PokemonMove, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	var pms []PokemonMove
+	err := m.db.SelectContext(ctx, &pms,
 		/* sql */ `
-		SELECT id, generation_id, name
-		FROM pokemon_v2_type
-		WHERE id = ?
-	`, id).StructScan(&typ)
+		SELECT DISTINCT level, move_id, move_learn_method_id
+		FROM pokemon_v2_pokemonmove
+		WHERE pokemon_id = ? AND move_id = ? AND version_group_id = ?
+		ORDER BY move_learn_method_id ASC, level ASC
+	`, pokemon.ID, move.ID, m.Version.VersionGroupID)
 	if err != nil {
-		return nil, fmt.Errorf("no matching type found: %w", err)
+		return nil, fmt.Errorf("error while checking if pokemon %q can learn move %q: %w", pokemon.Name, move.Name, err)
 	}
 
-	return &typ, nil
-}
+	for i := range pms {
+		pms[i].model = m
+		pms[i].Move = move
+	}
+
+	return pms, nil
+}
+
+// PreEvolutionsCanLearn returns each way the pokemon's pre-evolutions learn the
+// move in the model version group, earliest stage first. Pre-evolutions are
+// represented by the default form of each earlier species.
+func (m *Model) PreEvolutionsCanLearn(ctx context.Context, pokemon *Pokemon, move *Move) ([]LineMove, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	var lms []LineMove
+	err := m.db.SelectContext(ctx, &lms,
+		/* sql */ `
+		WITH RECURSIVE line(species_id, depth) AS (
+			SELECT ?, 0
+			UNION ALL
+			SELECT s.evolves_from_species_id, line.depth + 1
+			FROM pokemon_v2_pokemonspecies s
+			JOIN line
+				ON s.id = line.species_id
+			WHERE s.evolves_from_species_id IS NOT NULL
+		)
+		SELECT DISTINCT
+			pm.level, pm.move_id, pm.move_learn_method_id,
+			p.id AS stage_id, p.name AS stage_name, p.pokemon_species_id AS stage_species_id
+		FROM pokemon_v2_pokemonmove pm
+		JOIN pokemon_v2_pokemon p
+			ON pm.pokemon_id = p.id
+		JOIN line
+			ON p.pokemon_species_id = line.species_id
+		WHERE line.depth > 0 AND p.is_default AND pm.move_id = ? AND pm.version_group_id = ?
+		ORDER BY line.depth DESC, pm.move_learn_method_id ASC, pm.level ASC
+	`, pokemon.SpeciesID, move.ID, m.Version.VersionGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("error while checking if pre-evolutions of pokemon %q can learn move %q: %w", pokemon.Name, move.Name, err)
+	}
+
+	for i := range lms {
+		lms[i].model = m
+		lms[i].Move = move
+	}
+
+	return lms, nil
+}
+
+// RandomPokemon picks a species in the generation of the model version at
+// random, as its default form.
+func (m *Model) RandomPokemon(ctx context.Context) (*Pokemon, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	pokemon := Pokemon{model: m}
+	err = m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT p.id, p.name, p.pokemon_species_id
+		FROM pokemon_v2_pokemon p
+		JOIN pokemon_v2_pokemonspecies s
+			ON p.pokemon_species_id = s.id
+		WHERE p.is_default AND s.generation_id <= ?
+		ORDER BY RANDOM()
+		LIMIT 1
+	`, gen.ID).StructScan(&pokemon)
+	if err != nil {
+		return nil, fmt.Errorf("could not pick a random pokemon: %w", err)
+	}
+
+	return &pokemon, nil
+}
+
+// PokemonThatLearn returns the pokemon that learn the move in the model version
+// group by any method, one per species in national dex order. Each species is
+// represented by its lowest numbered form that learns the move, which is the
+// default form unless only other forms learn it.
+func (m *Model) PokemonThatLearn(ctx context.Context, move *Move, limit int, offset int) ([]*Pokemon, bool, error) {
+	if m.Version == nil {
+		return nil, false, ErrUnsetVersion
+	}
+
+	var pokemon []*Pokemon
+	err := m.db.SelectContext(ctx, &pokemon,
+		/* sql */ `
+		SELECT p.id, p.name, p.pokemon_species_id
+		FROM pokemon_v2_pokemon p
+		WHERE p.id IN (
+			SELECT MIN(pm.pokemon_id)
+			FROM pokemon_v2_pokemonmove pm
+			JOIN pokemon_v2_pokemon lp
+				ON pm.pokemon_id = lp.id
+			WHERE pm.move_id = ? AND pm.version_group_id = ?
+			GROUP BY lp.pokemon_species_id
+		)
+		ORDER BY p.pokemon_species_id ASC
+		LIMIT ? OFFSET ?
+	`, move.ID, m.Version.VersionGroupID, limit+1, offset)
+	if err != nil {
+		return nil, false, fmt.Errorf("error while getting pokemon that learn move %q: %w", move.Name, err)
+	}
+
+	for _, p := range pokemon {
+		p.model = m
+	}
+
+	hasNext := len(pokemon) == limit+1
+	if hasNext {
+		pokemon = pokemon[:limit]
+	}
+
+	return pokemon, hasNext, nil
+}
+
+func (m *Model) pokemonTutorMoves(ctx context.Context, pokemon *Pokemon) ([]PokemonMove, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	method, err := m.learnMethodByName(ctx, Tutor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tutor learn method: %w", err)
+	}
+
+	var moves []PokemonMove
+	err = m.db.SelectContext(ctx, &moves,
+		/* sql */ `
+		SELECT DISTINCT
+			m.id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name,
+			p.level, p.move_id, p.move_learn_method_id
+		FROM pokemon_v2_pokemonmove p
+		JOIN pokemon_v2_move m
+			ON p.move_id = m.id
+		WHERE p.pokemon_id = ? AND p.version_group_id = ? AND p.move_learn_method_id = ?
+		ORDER BY m.name ASC
+	`, pokemon.ID, m.Version.VersionGroupID, method.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting tutor moves for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	err = m.resolvePokemonMoves(ctx, moves)
+	if err != nil {
+		return nil, fmt.Errorf("error while resolving tutor moves: %w", err)
+	}
+
+	return moves, nil
+}
+
+func (m *Model) pokemonLearnableMoves(ctx context.Context, pokemon *Pokemon) ([]*Move, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	var moves []*Move
+	err := m.db.SelectContext(ctx, &moves,
+		/* sql */ `
+		SELECT m.id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name
+		FROM pokemon_v2_move m
+		WHERE m.id IN (
+			SELECT move_id
+			FROM pokemon_v2_pokemonmove
+			WHERE pokemon_id = ? AND version_group_id = ?
+		)
+		ORDER BY m.name ASC
+	`, pokemon.ID, m.Version.VersionGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting learnable moves for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	for _, move := range moves {
+		move.model = m
+	}
+
+	err = m.applyMoveChanges(ctx, moves)
+	if err != nil {
+		return nil, fmt.Errorf("error while resolving learnable moves: %w", err)
+	}
+
+	return moves, nil
+}
+
+// moveChanges are the changes made to the moves after the model version, by
+// move and latest first. Change rows hold a move's stats from before their
+// version group, so only later groups apply; IDs are not chronological, so
+// groups are compared by order.
+func (m *Model) moveChanges(ctx context.Context, moveIDs []int) (map[int][]MoveChange, error) {
+	if len(moveIDs) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(
+		/* sql */ `
+		SELECT c.power, c.pp, c.accuracy, c.type_id, c.version_group_id, c.move_id
+		FROM pokemon_v2_movechange c
+		JOIN pokemon_v2_versiongroup vg
+			ON c.version_group_id = vg.id
+		WHERE c.move_id IN (?) AND vg."order" > (
+			SELECT "order"
+			FROM pokemon_v2_versiongroup
+			WHERE id = ?
+		)
+		ORDER BY vg."order" DESC
+	`, moveIDs, m.Version.VersionGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("error while constructing query: %w", err)
+	}
+
+	var changes []MoveChange
+	err = m.db.SelectContext(ctx, &changes, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not find move changes for moves: %w", err)
+	}
+
+	byMove := make(map[int][]MoveChange)
+	for _, change := range changes {
+		change.model = m
+		byMove[change.MoveID] = append(byMove[change.MoveID], change)
+	}
+
+	return byMove, nil
+}
+
+func (m *Model) moveHistory(ctx context.Context, moveID int) ([]MoveChange, error) {
+	var changes []MoveChange
+	err := m.db.SelectContext(ctx, &changes,
+		/* sql */ `
+		SELECT c.power, c.pp, c.accuracy, c.type_id, c.version_group_id, c.move_id
+		FROM pokemon_v2_movechange c
+		JOIN pokemon_v2_versiongroup vg
+			ON c.version_group_id = vg.id
+		WHERE c.move_id = ?
+		ORDER BY vg."order"
+	`, moveID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find move history for move: %w", err)
+	}
+
+	// each change holds the stats from before it, so the stats after it are
+	// those of the next change to set them, or of the move as it is now
+	var cur MoveChange
+	err = m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT power, pp, accuracy, type_id
+		FROM pokemon_v2_move
+		WHERE id = ?
+	`, moveID).StructScan(&cur)
+	if err != nil {
+		return nil, fmt.Errorf("could not find latest stats for move: %w", err)
+	}
+
+	for i := len(changes) - 1; i >= 0; i-- {
+		change := &changes[i]
+		change.model = m
+		if change.Power != nil {
+			change.NewPower, cur.Power = cur.Power, change.Power
+		}
+		if change.PP != nil {
+			change.NewPP, cur.PP = cur.PP, change.PP
+		}
+		if change.Accuracy != nil {
+			change.NewAccuracy, cur.Accuracy = cur.Accuracy, change.Accuracy
+		}
+		if change.TypeID != nil {
+			change.NewTypeID, cur.TypeID = cur.TypeID, change.TypeID
+		}
+	}
+
+	return changes, nil
+}
+
+func (m *Model) moveGeneration(ctx context.Context, move *Move) (*Generation, error) {
+	var id int
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT generation_id
+		FROM pokemon_v2_move
+		WHERE id = ?
+	`, move.ID).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("could not find generation for move %q: %w", move.Name, err)
+	}
+
+	return m.GenerationByID(ctx, id)
+}
+
+func (m *Model) MoveByName(ctx context.Context, name string) (*Move, error) {
+	move := Move{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, power, pp, accuracy, move_damage_class_id, type_id, name
+		FROM pokemon_v2_move
+		WHERE name = ?
+	`, NormalizeName(name)).StructScan(&move)
+	if err != nil {
+		return nil, fmt.Errorf("no matching move found: %w", err)
+	}
+
+	err = m.resolveMove(ctx, &move, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &move, nil
+}
+
+// MoveByID looks up a move as of the model version. With validate set, moves
+// that are not in the model version are rejected the same way MoveByName
+// rejects them; callers that already know the move exists in the version, such
+// as learnsets, can skip the check.
+func (m *Model) MoveByID(ctx context.Context, id int, validate bool) (*Move, error) {
+	move := Move{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, power, pp, accuracy, move_damage_class_id, type_id, name
+		FROM pokemon_v2_move
+		WHERE id = ?
+	`, id).StructScan(&move)
+	if err != nil {
+		return nil, fmt.Errorf("no move found with id %d: %w", id, err)
+	}
+
+	err = m.resolveMove(ctx, &move, validate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &move, nil
+}
+
+// resolveMove applies any later changes so the move reflects its stats in the
+// model version, first checking that the move exists in that version if
+// validate is set.
+func (m *Model) resolveMove(ctx context.Context, move *Move, validate bool) error {
+	if validate {
+		err := m.validateMoveVersion(ctx, move)
+		if err != nil {
+			return fmt.Errorf("move not found in version: %w", err)
+		}
+	}
+
+	return m.applyMoveChanges(ctx, []*Move{move})
+}
+
+// applyMoveChanges updates the moves to their stats in the model version, looking
+// up the changes to all of them at once.
+func (m *Model) applyMoveChanges(ctx context.Context, moves []*Move) error {
+	ids := make([]int, len(moves))
+	for i, move := range moves {
+		ids[i] = move.ID
+	}
+
+	changes, err := m.moveChanges(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("error while getting move changes: %w", err)
+	}
+
+	for _, move := range moves {
+		move.applyChanges(changes[move.ID])
+	}
+
+	return nil
+}
+
+func (m *Model) typeByID(ctx context.Context, id int) (*Type, error) {
+	typ := Type{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, generation_id, name
+		FROM pokemon_v2_type
+		WHERE id = ?
+	`, id).StructScan(&typ)
+	if err != nil {
+		return nil, fmt.Errorf("no matching type found: %w", err)
+	}
+
+	return &typ, nil
+}
 
 func (m *Model) TypeByName(ctx context.Context, name string) (*Type, error) {
 	typ := Type{model: m}
@@ -487,8 +1300,8 @@ func (m *Model) TypeByName(ctx context.Context, name string) (*Type, error) {
 		/* sql */ `
 		SELECT id, generation_id, name
 		FROM pokemon_v2_type
-		WHERE name = ?
-	`, name).StructScan(&typ)
+		WHERE name = ? AND name NOT IN ('unknown', 'shadow')
+	`, NormalizeName(name)).StructScan(&typ)
 	if err != nil {
 		return nil, fmt.Errorf("no matching type found: %w", err)
 	}
@@ -496,6 +1309,77 @@ func (m *Model) TypeByName(ctx context.Context, name string) (*Type, error) {
 	return &typ, nil
 }
 
+func (m *Model) validateTypeVersion(ctx context.Context, typ *Type) error {
+	if m.Version == nil {
+		return fmt.Errorf("failed to check if version has type: %w", ErrUnsetVersion)
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	if typ.GenerationID > gen.ID {
+		return ErrWrongGeneration
+	}
+
+	return nil
+}
+
+func (m *Model) typeComboByNames(ctx context.Context, name1 string, name2 *string) (*TypeCombo, error) {
+	combo := m.NewTypeCombo()
+	typ1, err := m.TypeByName(ctx, name1)
+	if err != nil {
+		return nil, fmt.Errorf("could not get first type by name: %w", err)
+	}
+
+	err = m.validateTypeVersion(ctx, typ1)
+	if err != nil {
+		return nil, fmt.Errorf("invalid first type for generation: %w", err)
+	}
+	combo.Type1 = typ1
+
+	if name2 == nil {
+		return combo, nil
+	}
+
+	typ2, err := m.TypeByName(ctx, *name2)
+	if err != nil {
+		return nil, fmt.Errorf("could not get second type by name: %w", err)
+	}
+
+	err = m.validateTypeVersion(ctx, typ2)
+	if err != nil {
+		return nil, fmt.Errorf("invalid second type for generation: %w", err)
+	}
+	combo.Type2 = typ2
+
+	return combo, nil
+}
+
+func (m *Model) addHypotheticalType(ctx context.Context, combo *TypeCombo, name string) error {
+	typ, err := m.TypeByName(ctx, name)
+	if err != nil {
+		return fmt.Errorf("could not get third type by name: %w", err)
+	}
+
+	err = m.validateTypeVersion(ctx, typ)
+	if err != nil {
+		return fmt.Errorf("invalid third type for generation: %w", err)
+	}
+
+	switch {
+	case combo.HasType(typ):
+	// a second slot repeating the first holds no type of its own
+	case combo.Type2 == nil || combo.Type2.ID == combo.Type1.ID:
+		combo.Type2 = typ
+	default:
+		combo.Type3 = typ
+	}
+
+	return nil
+}
+
 func (m *Model) learnMethodByID(ctx context.Context, id int) (*LearnMethod, error) {
 	method := LearnMethod{model: m}
 	err := m.db.QueryRowxContext(ctx,
@@ -554,11 +1438,125 @@ func (m *Model) damageClassByID(ctx context.Context, ID int) (*DamageClass, erro
 	return &class, nil
 }
 
+func (m *Model) moveAttackingDamageClass(ctx context.Context, move *Move) (*DamageClass, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	class, err := move.DamageClass(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if class.Name == "status" {
+		return class, nil
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+	if gen.HasPhysicalSpecialSplit() {
+		return class, nil
+	}
+
+	typ, err := move.Type(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// pseudo-types have no damage class, and keep the class of the move
+	var id *int
+	err = m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT move_damage_class_id
+		FROM pokemon_v2_type
+		WHERE id = ?
+	`, typ.ID).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("could not get damage class for type %q: %w", typ.Name, err)
+	}
+	if id == nil {
+		return class, nil
+	}
+
+	return m.damageClassByID(ctx, *id)
+}
+
+func (m *Model) DamageClassByName(ctx context.Context, name string) (*DamageClass, error) {
+	class := DamageClass{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, name
+		FROM pokemon_v2_movedamageclass
+		WHERE name = ?
+	`, NormalizeName(name)).StructScan(&class)
+	if err != nil {
+		return nil, fmt.Errorf("no matching damage class found: %w", err)
+	}
+
+	return &class, nil
+}
+
+func (m *Model) AllDamageClasses(ctx context.Context) ([]*DamageClass, error) {
+	var classes []*DamageClass
+	err := m.db.SelectContext(ctx, &classes,
+		/* sql */ `
+		SELECT id, name
+		FROM pokemon_v2_movedamageclass
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("could not get all damage classes: %w", err)
+	}
+
+	for i := range classes {
+		classes[i].model = m
+	}
+
+	return classes, nil
+}
+
+func (m *Model) localizedDamageClassName(ctx context.Context, class *DamageClass) (string, error) {
+	if m.Language == nil {
+		return "", ErrUnsetLanguage
+	}
+
+	key := nameKey{resource: "damage_class", id: class.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
+	var name string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT name
+		FROM pokemon_v2_movedamageclassname
+		WHERE move_damage_class_id = ? AND language_id = ?
+	`, class.ID, m.Language.ID).Scan(&name)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not find localized name for damage class %q for language with code %q: %w",
+			class.Name,
+			m.Language.ISO639,
+			err,
+		)
+	}
+
+	m.names.set(key, name)
+
+	return name, nil
+}
+
 func (m *Model) localizedMoveName(ctx context.Context, move *Move) (string, error) {
 	if m.Language == nil {
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "move", id: move.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -575,14 +1573,150 @@ func (m *Model) localizedMoveName(ctx context.Context, move *Move) (string, erro
 		)
 	}
 
+	m.names.set(key, name)
+
 	return name, nil
 }
 
+var ErrNoFlavorText = errors.New("no flavor text found")
+
+// flavor text is stored with the line breaks used in game
+var flavorTextReplacer = strings.NewReplacer("-\n", "-", "\n", " ", "\f", " ")
+
+func (m *Model) moveFlavorText(ctx context.Context, move *Move) (string, error) {
+	if m.Language == nil {
+		return "", ErrUnsetLanguage
+	}
+	if m.Version == nil {
+		return "", ErrUnsetVersion
+	}
+
+	// prefer the current version group, then earlier ones, then later ones
+	var text string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		WITH cur AS (
+			SELECT "order"
+			FROM pokemon_v2_versiongroup
+			WHERE id = ?
+		)
+		SELECT f.flavor_text
+		FROM pokemon_v2_moveflavortext f
+		JOIN pokemon_v2_versiongroup vg
+			ON f.version_group_id = vg.id
+		WHERE f.move_id = ? AND f.language_id = ?
+		ORDER BY
+			vg."order" > (SELECT "order" FROM cur) ASC,
+			ABS(vg."order" - (SELECT "order" FROM cur)) ASC
+		LIMIT 1
+	`, m.Version.VersionGroupID, move.ID, m.Language.ID).Scan(&text)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("move %q has no flavor text for language with code %q: %w",
+			move.Name,
+			m.Language.ISO639,
+			ErrNoFlavorText,
+		)
+	} else if err != nil {
+		return "", fmt.Errorf("could not get flavor text for move %q: %w", move.Name, err)
+	}
+
+	return flavorTextReplacer.Replace(text), nil
+}
+
+var ErrNoMoveMeta = errors.New("no move meta found")
+
+func (m *Model) moveMeta(ctx context.Context, move *Move) (*MoveMeta, error) {
+	if m.Language == nil {
+		return nil, ErrUnsetLanguage
+	}
+
+	var meta MoveMeta
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT
+			mm.min_hits,
+			mm.max_hits,
+			COALESCE(mm.crit_rate, 0) AS crit_rate,
+			COALESCE(mm.ailment_chance, 0) AS ailment_chance,
+			COALESCE(mm.flinch_chance, 0) AS flinch_chance,
+			COALESCE(mm.stat_chance, 0) AS stat_chance,
+			COALESCE(mm.drain, 0) AS drain,
+			COALESCE(mm.healing, 0) AS healing,
+			COALESCE(mm.move_meta_ailment_id, 0) AS move_meta_ailment_id,
+			COALESCE(n.name, '') AS ailment
+		FROM pokemon_v2_movemeta mm
+		LEFT JOIN pokemon_v2_movemetaailmentname n
+			ON mm.move_meta_ailment_id = n.move_meta_ailment_id AND n.language_id = ?
+		WHERE mm.move_id = ?
+	`, m.Language.ID, move.ID).StructScan(&meta)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("move %q has no meta: %w", move.Name, ErrNoMoveMeta)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get meta for move %q: %w", move.Name, err)
+	}
+
+	return &meta, nil
+}
+
+func (m *Model) moveFlags(ctx context.Context, move *Move) ([]string, error) {
+	if m.Language == nil {
+		return nil, ErrUnsetLanguage
+	}
+
+	var flags []string
+	err := m.db.SelectContext(ctx, &flags,
+		/* sql */ `
+		SELECT n.name
+		FROM pokemon_v2_moveattributemap a
+		JOIN pokemon_v2_moveattributename n
+			ON a.move_attribute_id = n.move_attribute_id
+		WHERE a.move_id = ? AND n.language_id = ?
+		ORDER BY a.move_attribute_id ASC
+	`, move.ID, m.Language.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get flags for move %q: %w", move.Name, err)
+	}
+
+	return flags, nil
+}
+
+var ErrNoContestData = errors.New("no contest data found")
+
+func (m *Model) moveContest(ctx context.Context, move *Move) (*MoveContest, error) {
+	if m.Language == nil {
+		return nil, ErrUnsetLanguage
+	}
+
+	var contest MoveContest
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT n.name AS type, e.appeal, e.jam
+		FROM pokemon_v2_move mv
+		JOIN pokemon_v2_contesttypename n
+			ON mv.contest_type_id = n.contest_type_id
+		JOIN pokemon_v2_contesteffect e
+			ON mv.contest_effect_id = e.id
+		WHERE mv.id = ? AND n.language_id = ?
+	`, move.ID, m.Language.ID).StructScan(&contest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("move %q has no contest data: %w", move.Name, ErrNoContestData)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get contest data for move %q: %w", move.Name, err)
+	}
+
+	return &contest, nil
+}
+
 func (m *Model) localizedGenerationName(ctx context.Context, gen *Generation) (string, error) {
 	if m.Language == nil {
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "generation", id: gen.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -599,159 +1733,420 @@ func (m *Model) localizedGenerationName(ctx context.Context, gen *Generation) (s
 		)
 	}
 
-	return name, nil
-}
+	m.names.set(key, name)
+
+	return name, nil
+}
+
+func (m *Model) localizedVersionName(ctx context.Context, ver *Version) (string, error) {
+	if m.Language == nil {
+		return "", ErrUnsetLanguage
+	}
+
+	key := nameKey{resource: "version", id: ver.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
+	var name string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT name
+		FROM pokemon_v2_versionname
+		WHERE version_id = ? AND language_id = ?
+	`, ver.ID, m.Language.ID).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) && m.Language.ISO639 != LocalizationCodeEnglish {
+		// version names are game titles, so fall back to the English title
+		err = m.db.QueryRowxContext(ctx,
+			/* sql */ `
+			SELECT n.name
+			FROM pokemon_v2_versionname n
+			JOIN pokemon_v2_language l
+				ON n.language_id = l.id
+			WHERE n.version_id = ? AND l.iso639 = ?
+		`, ver.ID, LocalizationCodeEnglish).Scan(&name)
+	}
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not find localized name for version %q for language with code %q: %w",
+			ver.Name,
+			m.Language.ISO639,
+			err,
+		)
+	}
+
+	m.names.set(key, name)
+
+	return name, nil
+}
+
+func (m *Model) localizedTypeName(ctx context.Context, typ *Type) (string, error) {
+	if m.Language == nil {
+		return "", ErrUnsetLanguage
+	}
+
+	key := nameKey{resource: "type", id: typ.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
+	var name string
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT name
+		FROM pokemon_v2_typename
+		WHERE type_id = ? AND language_id = ?
+	`, typ.ID, m.Language.ID).Scan(&name)
+	if err != nil {
+		return "", fmt.Errorf(
+			"could not find localized name for type %q for language with code %q: %w",
+			typ.Name,
+			m.Language.ISO639,
+			err,
+		)
+	}
+
+	m.names.set(key, name)
+
+	return name, nil
+}
+
+func (m *Model) SearchVersions(ctx context.Context, prefix string, limit int) ([]*Version, error) {
+	return prefixSearch(ctx, m, prefixQuery{
+		resource: "versions",
+		query: /* sql */ `
+		SELECT v.id, v.version_group_id, v.name
+		FROM pokemon_v2_version v
+		JOIN pokemon_v2_versionname n
+			ON v.id = n.version_id
+		WHERE remove_accents(n.name) LIKE ? AND n.language_id = ?
+		ORDER BY n.name asc
+		LIMIT ?
+	`,
+	}, prefix, limit, func(ver *Version) {
+		ver.model = m
+	})
+}
+
+func (m *Model) SearchPokemon(ctx context.Context, prefix string, limit int) ([]*Pokemon, error) {
+	return m.SearchPokemonOrdered(ctx, prefix, limit, PokemonOrderName)
+}
+
+var ErrUnknownPokemonOrder = errors.New("unknown pokemon order")
+
+// SearchPokemonOrdered searches pokemon by localized name, ordering the results
+// alphabetically or by national dex number.
+func (m *Model) SearchPokemonOrdered(ctx context.Context, prefix string, limit int, order PokemonOrder) ([]*Pokemon, error) {
+	orderBy, ok := pokemonOrderClauses[order]
+	if !ok {
+		return nil, fmt.Errorf("cannot search pokemon with order %q: %w", order, ErrUnknownPokemonOrder)
+	}
+
+	return prefixSearch(ctx, m, prefixQuery{
+		resource:     "pokemon",
+		byGeneration: true,
+		query: fmt.Sprintf( /* sql */ `
+		SELECT MIN(p.id) as id, p.name, p.pokemon_species_id
+		FROM pokemon_v2_pokemon p
+		JOIN pokemon_v2_pokemonspeciesname n
+			ON p.pokemon_species_id = n.pokemon_species_id
+		JOIN pokemon_v2_pokemonspecies s
+			ON p.pokemon_species_id = s.id
+		WHERE remove_accents(n.name) LIKE ? AND n.language_id = ? AND s.generation_id <= ?
+		GROUP BY p.pokemon_species_id
+		ORDER BY %s
+		LIMIT ?
+	`, orderBy),
+	}, prefix, limit, func(pokemon *Pokemon) {
+		pokemon.model = m
+	})
+}
+
+func (m *Model) SearchMoves(ctx context.Context, prefix string, limit int) ([]*Move, error) {
+	return prefixSearch(ctx, m, prefixQuery{
+		resource:     "moves",
+		byGeneration: true,
+		query: /* sql */ `
+		SELECT MIN(m.id) as id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name
+		FROM pokemon_v2_move m
+		JOIN pokemon_v2_movename n
+			ON m.id = n.move_id
+		WHERE remove_accents(n.name) LIKE ? AND n.language_id = ? AND m.generation_id <= ?
+		GROUP BY n.name
+		ORDER BY n.name ASC
+		LIMIT ?
+	`,
+	}, prefix, limit, func(move *Move) {
+		move.model = m
+	})
+}
+
+func (m *Model) SearchAbilities(ctx context.Context, prefix string, limit int) ([]*Ability, error) {
+	return prefixSearch(ctx, m, prefixQuery{
+		resource:     "abilities",
+		byGeneration: true,
+		query: /* sql */ `
+		SELECT MIN(a.id) as id, a.is_main_series, a.generation_id, a.name
+		FROM pokemon_v2_ability a
+		JOIN pokemon_v2_abilityname n
+			ON a.id = n.ability_id
+		WHERE remove_accents(n.name) LIKE ? AND n.language_id = ? AND a.generation_id <= ? AND a.is_main_series
+		GROUP BY n.name
+		ORDER BY n.name ASC
+		LIMIT ?
+	`,
+	}, prefix, limit, func(ability *Ability) {
+		ability.model = m
+	})
+}
+
+// SearchMovesFiltered returns the moves in the model's generation matching the
+// filters, strongest first. Filters apply to each move's stats as of the model's
+// version group.
+func (m *Model) SearchMovesFiltered(
+	ctx context.Context,
+	filters MoveFilters,
+	limit int,
+	offset int,
+) ([]*Move, bool, error) {
+	if m.Language == nil {
+		return nil, false, ErrUnsetLanguage
+	}
+	if m.Version == nil {
+		return nil, false, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	var typeID *int
+	if filters.Type != nil {
+		typeID = &filters.Type.ID
+	}
+	var classID *int
+	if filters.DamageClass != nil {
+		classID = &filters.DamageClass.ID
+	}
+
+	// each stat comes from the earliest change after the version group that set
+	// it, matching how moveChanges are applied
+	var moves []*Move
+	err = m.db.SelectContext(ctx, &moves,
+		/* sql */ `
+		WITH current AS (
+			SELECT "order"
+			FROM pokemon_v2_versiongroup
+			WHERE id = ?
+		), changes AS (
+			SELECT c.move_id, c.power, c.pp, c.accuracy, c.type_id, vg."order"
+			FROM pokemon_v2_movechange c
+			JOIN pokemon_v2_versiongroup vg
+				ON c.version_group_id = vg.id
+			WHERE vg."order" > (SELECT "order" FROM current)
+		), moves AS (
+			SELECT
+				m.id, m.name, m.move_damage_class_id, m.priority,
+				COALESCE((
+					SELECT power FROM changes
+					WHERE move_id = m.id AND power IS NOT NULL
+					ORDER BY "order" ASC LIMIT 1
+				), m.power) AS power,
+				COALESCE((
+					SELECT pp FROM changes
+					WHERE move_id = m.id AND pp IS NOT NULL
+					ORDER BY "order" ASC LIMIT 1
+				), m.pp) AS pp,
+				COALESCE((
+					SELECT accuracy FROM changes
+					WHERE move_id = m.id AND accuracy IS NOT NULL
+					ORDER BY "order" ASC LIMIT 1
+				), m.accuracy) AS accuracy,
+				COALESCE((
+					SELECT type_id FROM changes
+					WHERE move_id = m.id AND type_id IS NOT NULL
+					ORDER BY "order" ASC LIMIT 1
+				), m.type_id) AS type_id
+			FROM pokemon_v2_move m
+			WHERE m.generation_id <= ?
+		)
+		SELECT m.id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name
+		FROM moves m
+		JOIN pokemon_v2_type t
+			ON m.type_id = t.id
+		JOIN pokemon_v2_movename n
+			ON m.id = n.move_id AND n.language_id = ?
+		WHERE t.name NOT IN ('unknown', 'shadow')
+			AND (? IS NULL OR m.type_id = ?)
+			AND (? IS NULL OR m.move_damage_class_id = ?)
+			AND (? IS NULL OR m.power >= ?)
+			AND (? IS NULL OR m.accuracy IS NULL OR m.accuracy >= ?)
+			AND (? IS NULL OR m.priority = ?)
+		ORDER BY m.power DESC NULLS LAST, n.name ASC
+		LIMIT ? OFFSET ?
+	`,
+		m.Version.VersionGroupID,
+		gen.ID,
+		m.Language.ID,
+		typeID, typeID,
+		classID, classID,
+		filters.MinPower, filters.MinPower,
+		filters.MinAccuracy, filters.MinAccuracy,
+		filters.Priority, filters.Priority,
+		limit+1, offset,
+	)
+	if err != nil {
+		return nil, false, fmt.Errorf("error while searching moves with filters: %w", err)
+	}
+
+	for _, move := range moves {
+		move.model = m
+	}
 
-func (m *Model) localizedVersionName(ctx context.Context, ver *Version) (string, error) {
-	if m.Language == nil {
-		return "", ErrUnsetLanguage
+	hasNext := len(moves) == limit+1
+	if hasNext {
+		moves = moves[:limit]
 	}
 
-	var name string
+	return moves, hasNext, nil
+}
+
+func (m *Model) ItemByName(ctx context.Context, name string) (*Item, error) {
+	item := Item{model: m}
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
-		SELECT name
-		FROM pokemon_v2_versionname
-		WHERE version_id = ? AND language_id = ?
-	`, ver.ID, m.Language.ID).Scan(&name)
+		SELECT id, name
+		FROM pokemon_v2_item
+		WHERE name = ?
+	`, NormalizeName(name)).StructScan(&item)
 	if err != nil {
-		return "", fmt.Errorf(
-			"could not find localized name for version %q for language with code %q: %w",
-			ver.Name,
-			m.Language.ISO639,
-			err,
-		)
+		return nil, fmt.Errorf("no matching item found: %w", err)
 	}
 
-	return name, nil
+	return &item, nil
 }
 
-func (m *Model) localizedTypeName(ctx context.Context, typ *Type) (string, error) {
+func (m *Model) localizedItemName(ctx context.Context, item *Item) (string, error) {
 	if m.Language == nil {
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "item", id: item.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
 		SELECT name
-		FROM pokemon_v2_typename
-		WHERE type_id = ? AND language_id = ?
-	`, typ.ID, m.Language.ID).Scan(&name)
+		FROM pokemon_v2_itemname
+		WHERE item_id = ? AND language_id = ?
+	`, item.ID, m.Language.ID).Scan(&name)
 	if err != nil {
 		return "", fmt.Errorf(
-			"could not find localized name for type %q for language with code %q: %w",
-			typ.Name,
+			"could not find localized name for item %q for language with code %q: %w",
+			item.Name,
 			m.Language.ISO639,
 			err,
 		)
 	}
 
+	m.names.set(key, name)
+
 	return name, nil
 }
 
-func (m *Model) SearchVersions(ctx context.Context, prefix string, limit int) ([]*Version, error) {
-	if m.Language == nil {
-		return nil, ErrUnsetLanguage
-	}
-
-	pattern := fmt.Sprintf("%s%%", prefix)
-	var vers []*Version
-	err := m.db.SelectContext(ctx, &vers,
-		/* sql */ `
-		SELECT v.id, v.version_group_id, v.name
-		FROM pokemon_v2_version v
-		JOIN pokemon_v2_versionname n
-			ON v.id = n.version_id
-		WHERE n.name LIKE ? AND n.language_id = ?
-		ORDER BY n.name asc
+func (m *Model) SearchItems(ctx context.Context, prefix string, limit int) ([]*Item, error) {
+	return prefixSearch(ctx, m, prefixQuery{
+		resource: "items",
+		query: /* sql */ `
+		SELECT i.id, i.name
+		FROM pokemon_v2_item i
+		JOIN pokemon_v2_itemname n
+			ON i.id = n.item_id
+		WHERE remove_accents(n.name) LIKE ? AND n.language_id = ?
+		ORDER BY n.name ASC
 		LIMIT ?
-	`, pattern, m.Language.ID, limit)
-	if err != nil {
-		return nil, fmt.Errorf("error while getting versions with prefix: %w", err)
-	}
-
-	for i := range vers {
-		vers[i].model = m
-	}
-
-	return vers, nil
+	`,
+	}, prefix, limit, func(item *Item) {
+		item.model = m
+	})
 }
 
-func (m *Model) SearchPokemon(ctx context.Context, prefix string, limit int) ([]*Pokemon, error) {
-	if m.Language == nil {
-		return nil, ErrUnsetLanguage
-	}
+var ErrUnknownSearchCategory = errors.New("unknown search category")
+
+// SearchAll searches every category concurrently. If any category fails, the
+// results of the categories that succeeded are still returned alongside the
+// error.
+func (m *Model) SearchAll(ctx context.Context, prefix string, limitPerCategory int) ([]SearchResult, error) {
 	if m.Version == nil {
 		return nil, ErrUnsetVersion
 	}
 
-	gen, err := m.Version.Generation(ctx)
+	// resolve the generation up front so the concurrent searches only read the
+	// version's cached generation instead of racing to populate it
+	_, err := m.Version.Generation(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
 	}
 
-	pattern := fmt.Sprintf("%s%%", prefix)
-	var ps []*Pokemon
-	err = m.db.SelectContext(ctx, &ps,
-		/* sql */ `
-		SELECT MIN(p.id) as id, p.name, p.pokemon_species_id
-		FROM pokemon_v2_pokemon p
-		JOIN pokemon_v2_pokemonspeciesname n
-			ON p.pokemon_species_id = n.pokemon_species_id
-		JOIN pokemon_v2_pokemonspecies s
-			ON p.pokemon_species_id = s.id
-		WHERE n.name LIKE ? AND n.language_id = ? AND s.generation_id <= ?
-		GROUP BY p.pokemon_species_id
-		ORDER BY n.name ASC
-		LIMIT ?
-	`, pattern, m.Language.ID, gen.ID, limit)
-	if err != nil {
-		return nil, fmt.Errorf("error while getting pokemon with prefix: %w", err)
-	}
+	categoryResults := make([][]SearchResult, len(AllSearchCategories))
+	var g errgroup.Group
+	for i, category := range AllSearchCategories {
+		i, category := i, category
+		g.Go(func() error {
+			results, err := m.searchCategory(ctx, category, prefix, limitPerCategory)
+			if err != nil {
+				return fmt.Errorf("error while searching category %q: %w", category, err)
+			}
+			categoryResults[i] = results
 
-	for i := range ps {
-		ps[i].model = m
+			return nil
+		})
 	}
+	err = g.Wait()
 
-	return ps, nil
-}
-
-func (m *Model) SearchMoves(ctx context.Context, prefix string, limit int) ([]*Move, error) {
-	if m.Language == nil {
-		return nil, ErrUnsetLanguage
-	}
-	if m.Version == nil {
-		return nil, ErrUnsetVersion
+	all := make([]SearchResult, 0, len(AllSearchCategories)*limitPerCategory)
+	for _, results := range categoryResults {
+		all = append(all, results...)
 	}
 
-	gen, err := m.Version.Generation(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+		return all, fmt.Errorf("error while searching all categories: %w", err)
 	}
 
-	pattern := fmt.Sprintf("%s%%", prefix)
-	var moves []*Move
-	err = m.db.SelectContext(ctx, &moves,
-		/* sql */ `
-		SELECT MIN(m.id) as id, m.power, m.pp, m.accuracy, m.move_damage_class_id, m.type_id, m.name
-		FROM pokemon_v2_move m
-		JOIN pokemon_v2_movename n
-			ON m.id = n.move_id
-		WHERE n.name LIKE ? AND n.language_id = ? AND m.generation_id <= ?
-		GROUP BY n.name
-		ORDER BY n.name ASC
-		LIMIT ?
-	`, pattern, m.Language.ID, gen.ID, limit)
-	if err != nil {
-		return nil, fmt.Errorf("error while getting moves with prefix: %w", err)
-	}
+	return all, nil
+}
 
-	for i := range moves {
-		moves[i].model = m
+// hypotheticalDefendingEfficacies multiplies the single-type efficacies of each
+// distinct type in the combo, which generalizes to the hypothetical third type.
+func (m *Model) hypotheticalDefendingEfficacies(ctx context.Context, combo *TypeCombo) ([]TypeEfficacy, error) {
+	var effs []TypeEfficacy
+	seen := make(map[int]bool, 3)
+	for _, typ := range []*Type{combo.Type1, combo.Type2, combo.Type3} {
+		if typ == nil || seen[typ.ID] {
+			continue
+		}
+		seen[typ.ID] = true
+
+		mono, err := m.defendingTypeEfficacies(ctx, &TypeCombo{model: m, Type1: typ})
+		if err != nil {
+			return nil, err
+		}
+
+		if effs == nil {
+			effs = mono
+			continue
+		}
+
+		for i := range effs {
+			effs[i].DamageFactor = effs[i].DamageFactor * mono[i].DamageFactor / 100
+		}
 	}
 
-	return moves, nil
+	return effs, nil
 }
 
 func (m *Model) defendingTypeEfficacies(ctx context.Context, combo *TypeCombo) ([]TypeEfficacy, error) {
@@ -759,7 +2154,13 @@ func (m *Model) defendingTypeEfficacies(ctx context.Context, combo *TypeCombo) (
 		return nil, ErrUnsetVersion
 	}
 
-	g, err := m.latestGeneration(ctx)
+	// a third type from a custom dataset can sit next to an empty second slot,
+	// so any third type is multiplied in rather than only hypothetical ones
+	if combo.Type3 != nil {
+		return m.hypotheticalDefendingEfficacies(ctx, combo)
+	}
+
+	g, err := m.LatestGeneration(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting latest generation: %w", err)
 	}
@@ -770,7 +2171,7 @@ func (m *Model) defendingTypeEfficacies(ctx context.Context, combo *TypeCombo) (
 	}
 
 	var effs []TypeEfficacy
-	if combo.Type2 == nil {
+	if combo.IsMonoType() {
 		err = m.db.SelectContext(ctx, &effs,
 			/* sql */ `
 			SELECT DISTINCT damage_type_id AS opposing_type_id, FIRST_VALUE(damage_factor) OVER (
@@ -786,7 +2187,10 @@ func (m *Model) defendingTypeEfficacies(ctx context.Context, combo *TypeCombo) (
 			) e
 			JOIN pokemon_v2_type dt
 				ON e.damage_type_id = dt.id
-			WHERE target_type_id = ? AND dt.generation_id <= ? AND e.generation_id >= ?
+			WHERE target_type_id = ?
+				AND dt.generation_id <= ?
+				AND e.generation_id >= ?
+				AND dt.name NOT IN ('unknown', 'shadow')
 			ORDER BY damage_type_id
 		`, g.ID, combo.Type1.ID, gen.ID, gen.ID)
 		if err != nil {
@@ -821,6 +2225,7 @@ func (m *Model) defendingTypeEfficacies(ctx context.Context, combo *TypeCombo) (
 			WHERE e1.target_type_id = ?
 				AND e2.target_type_id = ?
 				AND dt.generation_id <= ?
+				AND dt.name NOT IN ('unknown', 'shadow')
 				AND e1.generation_id >= ?
 				AND e2.generation_id >= ?
 			ORDER BY dt.id
@@ -846,7 +2251,7 @@ func (m *Model) attackingTypeEfficacies(ctx context.Context, typ *Type) ([]TypeE
 		return nil, ErrUnsetVersion
 	}
 
-	g, err := m.latestGeneration(ctx)
+	g, err := m.LatestGeneration(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error while getting latest generation: %w", err)
 	}
@@ -872,7 +2277,10 @@ func (m *Model) attackingTypeEfficacies(ctx context.Context, typ *Type) ([]TypeE
 		) e
 		JOIN pokemon_v2_type tt
 			ON e.target_type_id = tt.id
-		WHERE damage_type_id = ? AND tt.generation_id <= ? AND e.generation_id >= ?
+		WHERE damage_type_id = ?
+			AND tt.generation_id <= ?
+			AND e.generation_id >= ?
+			AND tt.name NOT IN ('unknown', 'shadow')
 		ORDER BY target_type_id
 	`, g.ID, typ.ID, gen.ID, gen.ID)
 	if err != nil {
@@ -883,46 +2291,376 @@ func (m *Model) attackingTypeEfficacies(ctx context.Context, typ *Type) ([]TypeE
 		)
 	}
 
-	for i := range effs {
-		effs[i].model = m
+	for i := range effs {
+		effs[i].model = m
+	}
+
+	return effs, nil
+}
+
+func (m *Model) bestAttackingTypeEfficacies(ctx context.Context, types []*Type) ([]TypeEfficacy, error) {
+	best := make(map[int]int)
+	var ids []int
+	for _, typ := range types {
+		effs, err := m.attackingTypeEfficacies(ctx, typ)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting efficacies for type %q: %w", typ.Name, err)
+		}
+
+		for _, te := range effs {
+			factor, ok := best[te.OpposingTypeID]
+			if !ok {
+				ids = append(ids, te.OpposingTypeID)
+			}
+			if !ok || te.DamageFactor > factor {
+				best[te.OpposingTypeID] = te.DamageFactor
+			}
+		}
+	}
+
+	sort.Ints(ids)
+	effs := make([]TypeEfficacy, len(ids))
+	for i, id := range ids {
+		effs[i] = TypeEfficacy{
+			model:          m,
+			DamageFactor:   best[id],
+			OpposingTypeID: id,
+		}
+	}
+
+	return effs, nil
+}
+
+func (m *Model) AllTypes(ctx context.Context) ([]*Type, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	var types []*Type
+	err = m.db.SelectContext(ctx, &types,
+		/* sql */ `
+		SELECT id, generation_id, name
+		FROM pokemon_v2_type
+		WHERE generation_id <= ? AND name NOT IN ('unknown', 'shadow')
+		ORDER BY id
+	`, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get all types for generation: %w", err)
+	}
+
+	for i := range types {
+		types[i].model = m
+	}
+
+	return types, nil
+}
+
+// ExcludedTypes returns the types that were introduced after the generation of
+// the model version, which type charts for that version leave out.
+func (m *Model) ExcludedTypes(ctx context.Context) ([]*Type, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	var types []*Type
+	err = m.db.SelectContext(ctx, &types,
+		/* sql */ `
+		SELECT id, generation_id, name
+		FROM pokemon_v2_type
+		WHERE generation_id > ? AND name NOT IN ('unknown', 'shadow')
+		ORDER BY id
+	`, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get excluded types for generation: %w", err)
+	}
+
+	for i := range types {
+		types[i].model = m
+	}
+
+	return types, nil
+}
+
+func (m *Model) typeChart(ctx context.Context, gen *Generation) (typeChart, error) {
+	if chart, ok := m.charts.get(gen.ID); ok {
+		return chart, nil
+	}
+
+	g, err := m.LatestGeneration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting latest generation: %w", err)
+	}
+
+	var effs []struct {
+		DamageTypeID int `db:"damage_type_id"`
+		TargetTypeID int `db:"target_type_id"`
+		DamageFactor int `db:"damage_factor"`
+	}
+	err = m.db.SelectContext(ctx, &effs,
+		/* sql */ `
+		SELECT DISTINCT damage_type_id, target_type_id, FIRST_VALUE(damage_factor) OVER (
+			PARTITION BY damage_type_id, target_type_id
+			ORDER BY e.generation_id ASC
+		) AS damage_factor
+		FROM (
+			SELECT damage_factor, damage_type_id, target_type_id, ? as generation_id
+			FROM pokemon_v2_typeefficacy
+			UNION ALL
+			SELECT damage_factor, damage_type_id, target_type_id, generation_id
+			FROM pokemon_v2_typeefficacypast
+		) e
+		JOIN pokemon_v2_type dt
+			ON e.damage_type_id = dt.id
+		JOIN pokemon_v2_type tt
+			ON e.target_type_id = tt.id
+		WHERE e.generation_id >= ? AND dt.generation_id <= ? AND tt.generation_id <= ?
+	`, g.ID, gen.ID, gen.ID, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get type chart for generation %d: %w", gen.ID, err)
+	}
+
+	chart := make(typeChart)
+	for _, eff := range effs {
+		if chart[eff.DamageTypeID] == nil {
+			chart[eff.DamageTypeID] = make(map[int]int)
+		}
+		chart[eff.DamageTypeID][eff.TargetTypeID] = eff.DamageFactor
+	}
+	m.charts.set(gen.ID, chart)
+
+	return chart, nil
+}
+
+func (m *Model) typeMatchupChanges(ctx context.Context, typ *Type, other *Generation) ([]MatchupChange, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+	if typ.GenerationID > gen.ID || typ.GenerationID > other.ID {
+		return nil, nil
+	}
+
+	chart, err := m.typeChart(ctx, gen)
+	if err != nil {
+		return nil, err
+	}
+	otherChart, err := m.typeChart(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := m.AllTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []MatchupChange
+	for _, attacking := range []bool{true, false} {
+		for _, opposing := range types {
+			// a type against itself is the same matchup either way round
+			if opposing.GenerationID > other.ID || (!attacking && opposing.ID == typ.ID) {
+				continue
+			}
+
+			attacker, defender := typ.ID, opposing.ID
+			if !attacking {
+				attacker, defender = defender, attacker
+			}
+
+			factor := chart.factor(attacker, defender)
+			otherFactor := otherChart.factor(attacker, defender)
+			if factor != otherFactor {
+				changes = append(changes, MatchupChange{
+					model:             m,
+					Attacking:         attacking,
+					OpposingTypeID:    opposing.ID,
+					DamageFactor:      factor,
+					OtherDamageFactor: otherFactor,
+					opposingType:      opposing,
+				})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// dexCoverage scans the types of every fully evolved species in the model's
+// generation, using the default form of each and their types as of that
+// generation.
+func (m *Model) dexCoverage(ctx context.Context, attacking *Type) (*DexCoverage, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	chart, err := m.typeChart(ctx, gen)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []struct {
+		PokemonID int  `db:"pokemon_id"`
+		TypeID    *int `db:"type_id"`
+	}
+	err = m.db.SelectContext(ctx, &types,
+		/* sql */ `
+		WITH final AS (
+			SELECT p.id
+			FROM pokemon_v2_pokemon p
+			JOIN pokemon_v2_pokemonspecies s
+				ON p.pokemon_species_id = s.id
+			WHERE p.is_default AND s.generation_id <= ? AND NOT EXISTS (
+				SELECT *
+				FROM pokemon_v2_pokemonspecies e
+				WHERE e.evolves_from_species_id = s.id AND e.generation_id <= ?
+			)
+		), past AS (
+			SELECT DISTINCT pokemon_id, slot, FIRST_VALUE(type_id) OVER (
+				PARTITION BY pokemon_id, slot
+				ORDER BY generation_id ASC
+			) AS type_id
+			FROM pokemon_v2_pokemontypepast
+			WHERE generation_id >= ?
+		)
+		SELECT f.id AS pokemon_id, CASE
+			WHEN EXISTS(SELECT * FROM past WHERE past.pokemon_id = f.id) THEN pt.type_id
+			ELSE t.type_id
+		END AS type_id
+		FROM final f
+		JOIN pokemon_v2_pokemontype t
+			ON f.id = t.pokemon_id
+		LEFT JOIN past pt
+			ON t.pokemon_id = pt.pokemon_id AND t.slot = pt.slot
+		ORDER BY f.id, t.slot
+	`, gen.ID, gen.ID, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get types of fully evolved pokemon: %w", err)
+	}
+
+	factors := make(map[int]int)
+	for _, t := range types {
+		factor, ok := factors[t.PokemonID]
+		if !ok {
+			factor = int(NormalEffective)
+		}
+		if t.TypeID != nil {
+			factor = factor * chart.factor(attacking.ID, *t.TypeID) / 100
+		}
+		factors[t.PokemonID] = factor
+	}
+
+	coverage := DexCoverage{Total: len(factors)}
+	for _, factor := range factors {
+		switch level := EfficacyLevel(factor); {
+		case level >= SuperEffective:
+			coverage.SuperEffective++
+		case level == NormalEffective:
+			coverage.Neutral++
+		case level == Immune:
+			coverage.Immune++
+		default:
+			coverage.Resisted++
+		}
 	}
 
-	return effs, nil
+	return &coverage, nil
 }
 
-func (m *Model) SearchTypes(ctx context.Context, prefix string, limit int) ([]*Type, error) {
-	if m.Language == nil {
-		return nil, ErrUnsetLanguage
+func (m *Model) coverageGaps(ctx context.Context, attacking []*Type) ([]*TypeCombo, error) {
+	types, err := m.AllTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting defending types: %w", err)
 	}
-	if m.Version == nil {
-		return nil, ErrUnsetVersion
+
+	factors := make([]map[int]int, len(attacking))
+	for i, typ := range attacking {
+		effs, err := m.attackingTypeEfficacies(ctx, typ)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting efficacies for type %q: %w", typ.Name, err)
+		}
+
+		factors[i] = make(map[int]int, len(effs))
+		for _, te := range effs {
+			factors[i][te.OpposingTypeID] = te.DamageFactor
+		}
 	}
 
-	gen, err := m.Version.Generation(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	factor := func(f map[int]int, typ *Type) int {
+		if typ == nil {
+			return int(NormalEffective)
+		}
+		if factor, ok := f[typ.ID]; ok {
+			return factor
+		}
+		return int(NormalEffective)
+	}
+
+	var gaps []*TypeCombo
+	for i, t1 := range types {
+		for j := i; j < len(types); j++ {
+			var t2 *Type
+			if j != i {
+				t2 = types[j]
+			}
+
+			best := 0
+			for _, f := range factors {
+				damage := factor(f, t1) * factor(f, t2) / 100
+				if damage > best {
+					best = damage
+				}
+			}
+
+			if EfficacyLevel(best) < SuperEffective {
+				gaps = append(gaps, &TypeCombo{
+					model: m,
+					Type1: t1,
+					Type2: t2,
+				})
+			}
+		}
 	}
 
-	pattern := fmt.Sprintf("%s%%", prefix)
-	var types []*Type
-	err = m.db.SelectContext(ctx, &types,
-		/* sql */ `
+	return gaps, nil
+}
+
+func (m *Model) SearchTypes(ctx context.Context, prefix string, limit int) ([]*Type, error) {
+	return prefixSearch(ctx, m, prefixQuery{
+		resource:     "types",
+		byGeneration: true,
+		query: /* sql */ `
 		SELECT t.id, t.generation_id, t.name
 		FROM pokemon_v2_type t
 		JOIN pokemon_v2_typename n
 			ON t.id = n.type_id
-		WHERE t.generation_id <= ? AND n.name LIKE ? AND n.language_id = ?
+		WHERE remove_accents(n.name) LIKE ?
+			AND n.language_id = ?
+			AND t.generation_id <= ?
+			AND t.name NOT IN ('unknown', 'shadow')
 		LIMIT ?
-	`, gen.ID, pattern, m.Language.ID, limit)
-	if err != nil {
-		return nil, fmt.Errorf("could not get all types for generation: %w", err)
-	}
-
-	for i := range types {
-		types[i].model = m
-	}
-
-	return types, nil
+	`,
+	}, prefix, limit, func(typ *Type) {
+		typ.model = m
+	})
 }
 
 func (m *Model) pokemonTypeCombo(ctx context.Context, pokemon *Pokemon) (*TypeCombo, error) {
@@ -962,26 +2700,38 @@ func (m *Model) pokemonTypeCombo(ctx context.Context, pokemon *Pokemon) (*TypeCo
 		return nil, fmt.Errorf("failed to get type ids for pokemon %q: %w", pokemon.Name, err)
 	}
 
-	t1, err := m.typeByID(ctx, *ids[0].ID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get first type for pokemon %q: %w", pokemon.Name, err)
+	// custom datasets may have a third type slot, which fills the combo's third
+	// type rather than being dropped
+	if len(ids) == 0 || len(ids) > maxTypeSlots || ids[0].ID == nil {
+		return nil, fmt.Errorf("pokemon %q has %d type slots: %w", pokemon.Name, len(ids), ErrUnexpectedTypeSlots)
 	}
 
-	var t2 *Type
-	if len(ids) > 1 && ids[1].ID != nil {
-		t2, err = m.typeByID(ctx, *ids[1].ID)
+	types := make([]*Type, maxTypeSlots)
+	for i, id := range ids {
+		if id.ID == nil {
+			continue
+		}
+
+		types[i], err = m.typeByID(ctx, *id.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get second type for pokemon %q: %w", pokemon.Name, err)
+			return nil, fmt.Errorf("failed to get type in slot %d for pokemon %q: %w", i+1, pokemon.Name, err)
 		}
 	}
 
 	return &TypeCombo{
 		model: m,
-		Type1: t1,
-		Type2: t2,
+		Type1: types[0],
+		Type2: types[1],
+		Type3: types[2],
 	}, nil
 }
 
+// maxTypeSlots is the most types a combo can hold, including the third type
+// found only in custom datasets.
+const maxTypeSlots = 3
+
+var ErrUnexpectedTypeSlots = errors.New("unexpected number of type slots")
+
 var ErrSpritesNotFound = errors.New("could not find sprites")
 
 func SpritesForVersion(ctx context.Context, ps *sprite.PokemonSprites, ver Version) (*sprite.Sprites, error) {
@@ -1032,26 +2782,187 @@ func (m *Model) pokemonSprites(ctx context.Context, pokemon *Pokemon) (*sprite.P
 	return &ps, nil
 }
 
-func (m *Model) pokemonAbilities(ctx context.Context, pokemon *Pokemon) ([]PokemonAbility, error) {
+// hasTable checks for a table that only some datasets include.
+func (m *Model) hasTable(ctx context.Context, name string) (bool, error) {
+	var exists bool
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT EXISTS(
+			SELECT *
+			FROM sqlite_master
+			WHERE type = 'table' AND name = ?
+		)
+	`, name).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("could not check for table %q: %w", name, err)
+	}
+
+	return exists, nil
+}
+
+func (m *Model) abilityByID(ctx context.Context, id int) (*Ability, error) {
+	ability := Ability{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, is_main_series, generation_id, name
+		FROM pokemon_v2_ability
+		WHERE id = ?
+	`, id).StructScan(&ability)
+	if err != nil {
+		return nil, fmt.Errorf("could not get ability with id %d: %w", id, err)
+	}
+
+	return &ability, nil
+}
+
+// AbilityByName looks up a main series ability by its slug, which must exist in
+// the model version.
+func (m *Model) AbilityByName(ctx context.Context, name string) (*Ability, error) {
+	ability := Ability{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, is_main_series, generation_id, name
+		FROM pokemon_v2_ability
+		WHERE name = ? AND is_main_series
+	`, NormalizeName(name)).StructScan(&ability)
+	if err != nil {
+		return nil, fmt.Errorf("no matching ability found: %w", err)
+	}
+
+	err = m.validateAbilityVersion(ctx, &ability)
+	if err != nil {
+		return nil, fmt.Errorf("ability not found in version: %w", err)
+	}
+
+	return &ability, nil
+}
+
+// PokemonByAbility returns the pokemon that can have the ability in the
+// generation of the model version, one per species in national dex order. Each
+// species is represented by its lowest numbered form with the ability, and forms
+// from later generations, or mega forms outside the mega evolution generations,
+// are left out. Hidden abilities are only included from
+// Generation V, and hiddenOnly leaves out pokemon with the ability as a regular
+// ability. Abilities are matched as they were in the generation, like
+// pokemonAbilities does.
+func (m *Model) PokemonByAbility(
+	ctx context.Context,
+	ability *Ability,
+	hiddenOnly bool,
+	limit int,
+	offset int,
+) ([]AbilityHolder, bool, error) {
 	if m.Version == nil {
-		return nil, ErrUnsetVersion
+		return nil, false, ErrUnsetVersion
 	}
 
 	gen, err := m.Version.Generation(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+		return nil, false, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	hasPast, err := m.hasTable(ctx, "pokemon_v2_pokemonabilitypast")
+	if err != nil {
+		return nil, false, err
+	}
+
+	// slots holds the ability in each slot of every pokemon in the generation.
+	// Past abilities replace the current ones in the slots they changed, and
+	// take a NULL ability for slots that were empty.
+	slots := /* sql */ `
+		slots AS (
+			SELECT pokemon_id, slot, ability_id, is_hidden
+			FROM pokemon_v2_pokemonability
+		)`
+	args := []any{ability.ID, gen.ID, gen.HasMegaEvolution(), hiddenOnly, gen.HasHiddenAbilities(), limit + 1, offset}
+	if hasPast {
+		slots = /* sql */ `
+		past AS (
+			SELECT pokemon_id, slot, ability_id, is_hidden
+			FROM (
+				SELECT pokemon_id, slot, ability_id, is_hidden, row_number() OVER (
+					PARTITION BY pokemon_id, slot
+					ORDER BY generation_id ASC
+				) AS n
+				FROM pokemon_v2_pokemonabilitypast
+				WHERE generation_id >= ?
+			)
+			WHERE n = 1
+		),
+		slots AS (
+			SELECT pokemon_id, slot, ability_id, is_hidden
+			FROM past
+			UNION ALL
+			SELECT a.pokemon_id, a.slot, a.ability_id, a.is_hidden
+			FROM pokemon_v2_pokemonability a
+			WHERE NOT EXISTS (
+				SELECT *
+				FROM past
+				WHERE past.pokemon_id = a.pokemon_id AND past.slot = a.slot
+			)
+		)`
+		args = append([]any{gen.ID}, args...)
+	}
+
+	var holders []AbilityHolder
+	err = m.db.SelectContext(ctx, &holders, fmt.Sprintf( /* sql */ `
+		WITH %s,
+		holders AS (
+			SELECT p.id, p.pokemon_species_id, MIN(a.is_hidden) AS is_hidden
+			FROM slots a
+			JOIN pokemon_v2_pokemon p
+				ON a.pokemon_id = p.id
+			JOIN pokemon_v2_pokemonform f
+				ON p.id = f.pokemon_id AND f.is_default
+			JOIN pokemon_v2_versiongroup vg
+				ON f.version_group_id = vg.id
+			WHERE a.ability_id = ? AND vg.generation_id <= ?
+				AND (NOT f.is_mega OR ?)
+				AND (a.is_hidden OR NOT ?)
+				AND (NOT a.is_hidden OR ?)
+			GROUP BY p.id
+		)
+		SELECT p.id, p.name, p.pokemon_species_id, h.is_hidden
+		FROM holders h
+		JOIN pokemon_v2_pokemon p
+			ON h.id = p.id
+		WHERE h.id IN (
+			SELECT MIN(id)
+			FROM holders
+			GROUP BY pokemon_species_id
+		)
+		ORDER BY p.pokemon_species_id ASC
+		LIMIT ? OFFSET ?
+	`, slots), args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("error while getting pokemon with ability %q: %w", ability.Name, err)
+	}
+
+	for i := range holders {
+		holders[i].model = m
+	}
+
+	hasNext := len(holders) == limit+1
+	if hasNext {
+		holders = holders[:limit]
 	}
 
+	return holders, hasNext, nil
+}
+
+// currentPokemonAbilities are the abilities of the pokemon in the latest games,
+// regardless of the model version.
+func (m *Model) currentPokemonAbilities(ctx context.Context, pokemon *Pokemon) ([]PokemonAbility, error) {
 	var abilities []PokemonAbility
-	err = m.db.SelectContext(ctx, &abilities,
+	err := m.db.SelectContext(ctx, &abilities,
 		/* sql */ `
-		SELECT a.id, a.is_main_series, a.generation_id, a.name, p.is_hidden, p.ability_id
+		SELECT a.id, a.is_main_series, a.generation_id, a.name, p.is_hidden, p.slot, p.ability_id
 		FROM pokemon_v2_pokemonability p
 		JOIN pokemon_v2_ability a
 			ON p.ability_id = a.id
-		WHERE p.pokemon_id = ? AND a.generation_id <= ?
+		WHERE p.pokemon_id = ?
 		ORDER BY p.slot ASC
-	`, pokemon.ID, gen.ID)
+	`, pokemon.ID)
 	if err != nil {
 		return nil, fmt.Errorf("could not get abilities for pokemon %q: %w", pokemon.Name, err)
 	}
@@ -1064,11 +2975,150 @@ func (m *Model) pokemonAbilities(ctx context.Context, pokemon *Pokemon) ([]Pokem
 	return abilities, nil
 }
 
+// pastPokemonAbilities replaces the abilities in slots that changed after the
+// generation, the same way past typings do. A nil ability is a slot that was
+// empty in the generation. Datasets without past abilities have no changes.
+func (m *Model) pastPokemonAbilities(
+	ctx context.Context,
+	pokemon *Pokemon,
+	gen *Generation,
+) (map[int]*PokemonAbility, error) {
+	ok, err := m.hasTable(ctx, "pokemon_v2_pokemonabilitypast")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	var rows []struct {
+		Slot      int  `db:"slot"`
+		AbilityID *int `db:"ability_id"`
+		IsHidden  bool `db:"is_hidden"`
+	}
+	err = m.db.SelectContext(ctx, &rows,
+		/* sql */ `
+		SELECT slot, ability_id, is_hidden
+		FROM (
+			SELECT slot, ability_id, is_hidden, row_number() OVER (
+				PARTITION BY slot
+				ORDER BY generation_id ASC
+			) AS n
+			FROM pokemon_v2_pokemonabilitypast
+			WHERE pokemon_id = ? AND generation_id >= ?
+		)
+		WHERE n = 1
+	`, pokemon.ID, gen.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get past abilities for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	past := make(map[int]*PokemonAbility, len(rows))
+	for _, row := range rows {
+		if row.AbilityID == nil {
+			past[row.Slot] = nil
+			continue
+		}
+
+		ability, err := m.abilityByID(ctx, *row.AbilityID)
+		if err != nil {
+			return nil, err
+		}
+		past[row.Slot] = &PokemonAbility{
+			model:     m,
+			Ability:   ability,
+			IsHidden:  row.IsHidden,
+			Slot:      row.Slot,
+			AbilityID: ability.ID,
+		}
+	}
+
+	return past, nil
+}
+
+// pokemonAbilities are the abilities the pokemon had in the generation of the
+// model version, leaving out abilities introduced later and hidden abilities
+// from before they existed.
+func (m *Model) pokemonAbilities(ctx context.Context, pokemon *Pokemon) ([]PokemonAbility, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	current, err := m.currentPokemonAbilities(ctx, pokemon)
+	if err != nil {
+		return nil, err
+	}
+
+	past, err := m.pastPokemonAbilities(ctx, pokemon, gen)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make(map[int]*PokemonAbility, len(current)+len(past))
+	for i := range current {
+		slots[current[i].Slot] = &current[i]
+	}
+	for slot, ability := range past {
+		slots[slot] = ability
+	}
+
+	abilities := make([]PokemonAbility, 0, len(slots))
+	for _, ability := range slots {
+		if ability == nil || ability.GenerationID > gen.ID || (ability.IsHidden && !gen.HasHiddenAbilities()) {
+			continue
+		}
+		abilities = append(abilities, *ability)
+	}
+	sort.Slice(abilities, func(i, j int) bool {
+		return abilities[i].Slot < abilities[j].Slot
+	})
+
+	return abilities, nil
+}
+
+func (m *Model) pokemonUnavailableAbilities(ctx context.Context, pokemon *Pokemon) ([]PokemonAbility, error) {
+	available, err := pokemon.Abilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := m.currentPokemonAbilities(ctx, pokemon)
+	if err != nil {
+		return nil, err
+	}
+
+	var unavailable []PokemonAbility
+	for _, ability := range current {
+		found := false
+		for _, a := range available {
+			if a.AbilityID == ability.AbilityID && a.IsHidden == ability.IsHidden {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unavailable = append(unavailable, ability)
+		}
+	}
+
+	return unavailable, nil
+}
+
 func (m *Model) abilityLocalizedName(ctx context.Context, ability *Ability) (string, error) {
 	if m.Language == nil {
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "ability", id: ability.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -1080,32 +3130,177 @@ func (m *Model) abilityLocalizedName(ctx context.Context, ability *Ability) (str
 		return "", fmt.Errorf("could not find localized name for ability %q: %w", ability.Name, err)
 	}
 
+	m.names.set(key, name)
+
 	return name, nil
 }
 
 func (m *Model) pokemonStats(ctx context.Context, pokemon *Pokemon) (*PokemonStats, error) {
-	var s []struct {
-		StatID   int `db:"stat_id"`
-		BaseStat int `db:"base_stat"`
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
 	}
-	err := m.db.SelectContext(ctx, &s,
+
+	var s []PokemonStat
+	err = m.db.SelectContext(ctx, &s,
 		/* sql */ `
-		SELECT stat_id, base_stat
+		SELECT p.stat_id, s.name AS stat_name, p.base_stat, p.effort
 		FROM pokemon_v2_pokemonstat p
-		WHERE pokemon_id = ?
+		JOIN pokemon_v2_stat s
+			ON p.stat_id = s.id
+		WHERE p.pokemon_id = ?
 	`, pokemon.ID)
 	if err != nil {
 		return nil, fmt.Errorf("could not get stats for pokemon %q: %w", pokemon.Name, err)
 	}
 
-	var stats PokemonStats = make(map[int]int, len(s))
+	var stats PokemonStats = make(map[int]PokemonStat, len(s))
 	for _, stat := range s {
-		stats[stat.StatID] = stat.BaseStat
+		if base, ok := pastBaseStatIn(pokemon.Name, stat.StatName, gen); ok {
+			stat.BaseStat = base
+		}
+		stats[stat.StatID] = stat
 	}
 
 	return &stats, nil
 }
 
+// pokemonStatRank ranks the pokemon's base stat against the base stats the
+// default forms of every other species had in the generation of the model
+// version. Forms are ranked in place of their species.
+func (m *Model) pokemonStatRank(ctx context.Context, pokemon *Pokemon, stat Stat) (*StatRank, error) {
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	bs, err := pokemon.BaseStat(ctx, stat)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+	}
+
+	// the database only stores current base stats, so the stats that changed
+	// since the generation are joined in from the curated table
+	past := []string{"SELECT NULL AS name, NULL AS base_stat WHERE 0"}
+	var pastArgs []any
+	for name := range pastBaseStats {
+		if base, ok := pastBaseStatIn(name, StatName(stat.Name), gen); ok {
+			past = append(past, "SELECT ?, ?")
+			pastArgs = append(pastArgs, name, base)
+		}
+	}
+
+	args := append(pastArgs, pokemon.SpeciesID, bs, stat.ID, gen.ID, pokemon.SpeciesID)
+	var rank StatRank
+	err = m.db.QueryRowxContext(ctx, fmt.Sprintf( /* sql */ `
+		WITH past AS (
+			%s
+		), ranked AS (
+			SELECT
+				p.pokemon_species_id,
+				RANK() OVER (ORDER BY CASE
+					WHEN p.pokemon_species_id = ? THEN ?
+					ELSE COALESCE(past.base_stat, ps.base_stat)
+				END DESC) AS rank,
+				COUNT(*) OVER () AS total
+			FROM pokemon_v2_pokemonstat ps
+			JOIN pokemon_v2_pokemon p
+				ON ps.pokemon_id = p.id
+			JOIN pokemon_v2_pokemonspecies s
+				ON p.pokemon_species_id = s.id
+			LEFT JOIN past
+				ON p.name = past.name
+			WHERE ps.stat_id = ? AND p.is_default AND s.generation_id <= ?
+		)
+		SELECT rank, total
+		FROM ranked
+		WHERE pokemon_species_id = ?
+	`, strings.Join(past, " UNION ALL ")), args...).StructScan(&rank)
+	if err != nil {
+		return nil, fmt.Errorf("could not rank stat %q for pokemon %q: %w", stat.Name, pokemon.Name, err)
+	}
+
+	return &rank, nil
+}
+
+func (m *Model) pokemonDefensiveRating(ctx context.Context, pokemon *Pokemon) (*DefensiveRating, error) {
+	combo, err := pokemon.TypeCombo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get type combo for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get defending efficacies for pokemon %q: %w", pokemon.Name, err)
+	}
+
+	names := []StatName{StatNameHP, StatNameDefense, StatNameSpecialDefense}
+	stats := make([]int, len(names))
+	for i, name := range names {
+		stat, err := m.StatByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get stat %q: %w", name, err)
+		}
+
+		stats[i], err = pokemon.BaseStat(ctx, *stat)
+		if err != nil {
+			return nil, fmt.Errorf("could not get base stat %q for pokemon %q: %w", name, pokemon.Name, err)
+		}
+	}
+
+	rating := RateDefense(effs, stats[0], stats[1], stats[2])
+
+	return &rating, nil
+}
+
+// MaxBaseStat returns the highest base stat of any default form of a species in
+// the generation of the model version, across every intrinsic stat.
+func (m *Model) MaxBaseStat(ctx context.Context) (int, error) {
+	if m.Version == nil {
+		return 0, ErrUnsetVersion
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get generation for model version: %w", err)
+	}
+
+	if max, ok := m.maxStats.get(gen.ID); ok {
+		return max, nil
+	}
+
+	var max int
+	err = m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT COALESCE(MAX(ps.base_stat), 0)
+		FROM pokemon_v2_pokemonstat ps
+		JOIN pokemon_v2_stat st
+			ON ps.stat_id = st.id
+		JOIN pokemon_v2_pokemon p
+			ON ps.pokemon_id = p.id
+		JOIN pokemon_v2_pokemonspecies s
+			ON p.pokemon_species_id = s.id
+		WHERE st.is_battle_only = 0 AND p.is_default AND s.generation_id <= ?
+	`, gen.ID).Scan(&max)
+	if err != nil {
+		return 0, fmt.Errorf("could not get max base stat for generation %d: %w", gen.ID, err)
+	}
+	m.maxStats.set(gen.ID, max)
+
+	return max, nil
+}
+
+// IntrinsicStats returns the six stats every pokemon has, in the order games
+// since generation 3 show them. Game indices follow the generation 1 and 2 order
+// instead, which puts speed before the special stats.
 func (m *Model) IntrinsicStats(ctx context.Context) ([]Stat, error) {
 	var stats []Stat
 	err := m.db.SelectContext(ctx, &stats,
@@ -1113,7 +3308,7 @@ func (m *Model) IntrinsicStats(ctx context.Context) ([]Stat, error) {
 		SELECT id, name
 		FROM pokemon_v2_stat
 		WHERE is_battle_only = 0
-		ORDER BY game_index ASC
+		ORDER BY id ASC
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("could not get all intrinsic stats: %w", err)
@@ -1126,11 +3321,31 @@ func (m *Model) IntrinsicStats(ctx context.Context) ([]Stat, error) {
 	return stats, nil
 }
 
+func (m *Model) StatByName(ctx context.Context, name StatName) (*Stat, error) {
+	stat := Stat{model: m}
+	err := m.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT id, name
+		FROM pokemon_v2_stat
+		WHERE name = ?
+	`, name).StructScan(&stat)
+	if err != nil {
+		return nil, fmt.Errorf("no matching stat found: %w", err)
+	}
+
+	return &stat, nil
+}
+
 func (m *Model) statLocalizedName(ctx context.Context, stat *Stat) (string, error) {
 	if m.Language == nil {
 		return "", ErrUnsetLanguage
 	}
 
+	key := nameKey{resource: "stat", id: stat.ID, language: m.Language.ID}
+	if name, ok := m.names.get(key); ok {
+		return name, nil
+	}
+
 	var name string
 	err := m.db.QueryRowxContext(ctx,
 		/* sql */ `
@@ -1138,9 +3353,23 @@ func (m *Model) statLocalizedName(ctx context.Context, stat *Stat) (string, erro
 		FROM pokemon_v2_statname
 		WHERE stat_id = ? AND language_id = ?
 	`, stat.ID, m.Language.ID).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) && m.Language.ISO639 != LocalizationCodeEnglish {
+		// some languages have no stat names, and stat sections should still
+		// render for them
+		err = m.db.QueryRowxContext(ctx,
+			/* sql */ `
+			SELECT n.name
+			FROM pokemon_v2_statname n
+			JOIN pokemon_v2_language l
+				ON n.language_id = l.id
+			WHERE n.stat_id = ? AND l.iso639 = ?
+		`, stat.ID, LocalizationCodeEnglish).Scan(&name)
+	}
 	if err != nil {
 		return "", fmt.Errorf("could not find localized name for stat %q: %w", stat.Name, err)
 	}
 
+	m.names.set(key, name)
+
 	return name, nil
 }
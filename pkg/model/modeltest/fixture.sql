@@ -0,0 +1,1691 @@
+-- A subset of the PokeAPI database for tests: every generation, version, type,
+-- stat and learn method, plus a handful of pokemon (the Bulbasaur and
+-- Charmander lines, Gengar and Ho-Oh) with a few of their moves and abilities.
+-- English and French names only. Past abilities, which newer datasets have,
+-- are included with Gengar's Levitate before Generation VII.
+PRAGMA foreign_keys=OFF;
+BEGIN TRANSACTION;
+CREATE TABLE IF NOT EXISTS "pokemon_v2_language" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "iso3166" varchar(2) NOT NULL, "name" varchar(100) NOT NULL, "official" bool NOT NULL, "order" integer NULL, "iso639" varchar(10) NOT NULL);
+INSERT INTO pokemon_v2_language VALUES(1,'jp','ja-Hrkt',1,1,'ja');
+INSERT INTO pokemon_v2_language VALUES(2,'jp','roomaji',1,3,'ja');
+INSERT INTO pokemon_v2_language VALUES(3,'kr','ko',1,4,'ko');
+INSERT INTO pokemon_v2_language VALUES(4,'cn','zh-Hant',1,5,'zh');
+INSERT INTO pokemon_v2_language VALUES(5,'fr','fr',1,8,'fr');
+INSERT INTO pokemon_v2_language VALUES(6,'de','de',1,9,'de');
+INSERT INTO pokemon_v2_language VALUES(7,'es','es',1,10,'es');
+INSERT INTO pokemon_v2_language VALUES(8,'it','it',1,11,'it');
+INSERT INTO pokemon_v2_language VALUES(9,'us','en',1,7,'en');
+INSERT INTO pokemon_v2_language VALUES(10,'cz','cs',0,12,'cs');
+INSERT INTO pokemon_v2_language VALUES(11,'jp','ja',1,2,'ja');
+INSERT INTO pokemon_v2_language VALUES(12,'cn','zh-Hans',1,6,'zh');
+INSERT INTO pokemon_v2_language VALUES(13,'br','pt-BR',0,13,'pt-BR');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_languagename" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "local_language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_languagename VALUES(26,5,5,'Français');
+INSERT INTO pokemon_v2_languagename VALUES(29,5,9,'French');
+INSERT INTO pokemon_v2_languagename VALUES(50,9,5,'Anglais');
+INSERT INTO pokemon_v2_languagename VALUES(53,9,9,'English');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_generation" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "region_id" integer NULL UNIQUE REFERENCES "pokemon_v2_region" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_generation VALUES(1,1,'generation-i');
+INSERT INTO pokemon_v2_generation VALUES(2,2,'generation-ii');
+INSERT INTO pokemon_v2_generation VALUES(3,3,'generation-iii');
+INSERT INTO pokemon_v2_generation VALUES(4,4,'generation-iv');
+INSERT INTO pokemon_v2_generation VALUES(5,5,'generation-v');
+INSERT INTO pokemon_v2_generation VALUES(6,6,'generation-vi');
+INSERT INTO pokemon_v2_generation VALUES(7,7,'generation-vii');
+INSERT INTO pokemon_v2_generation VALUES(8,8,'generation-viii');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_generationname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_generationname VALUES(3,1,5,'Génération I');
+INSERT INTO pokemon_v2_generationname VALUES(6,1,9,'Generation I');
+INSERT INTO pokemon_v2_generationname VALUES(10,2,5,'Génération II');
+INSERT INTO pokemon_v2_generationname VALUES(13,2,9,'Generation II');
+INSERT INTO pokemon_v2_generationname VALUES(17,3,5,'Génération III');
+INSERT INTO pokemon_v2_generationname VALUES(20,3,9,'Generation III');
+INSERT INTO pokemon_v2_generationname VALUES(24,4,5,'Génération IV');
+INSERT INTO pokemon_v2_generationname VALUES(27,4,9,'Generation IV');
+INSERT INTO pokemon_v2_generationname VALUES(31,5,5,'Génération V');
+INSERT INTO pokemon_v2_generationname VALUES(34,5,9,'Generation V');
+INSERT INTO pokemon_v2_generationname VALUES(38,6,5,'Génération VI');
+INSERT INTO pokemon_v2_generationname VALUES(41,6,9,'Generation VI');
+INSERT INTO pokemon_v2_generationname VALUES(45,7,5,'Génération VII');
+INSERT INTO pokemon_v2_generationname VALUES(48,7,9,'Generation VII');
+INSERT INTO pokemon_v2_generationname VALUES(51,8,5,'Génération VIII');
+INSERT INTO pokemon_v2_generationname VALUES(54,8,9,'Generation VIII');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_versiongroup" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "order" integer NULL, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_versiongroup VALUES(1,1,1,'red-blue');
+INSERT INTO pokemon_v2_versiongroup VALUES(2,2,1,'yellow');
+INSERT INTO pokemon_v2_versiongroup VALUES(3,3,2,'gold-silver');
+INSERT INTO pokemon_v2_versiongroup VALUES(4,4,2,'crystal');
+INSERT INTO pokemon_v2_versiongroup VALUES(5,5,3,'ruby-sapphire');
+INSERT INTO pokemon_v2_versiongroup VALUES(6,6,3,'emerald');
+INSERT INTO pokemon_v2_versiongroup VALUES(7,9,3,'firered-leafgreen');
+INSERT INTO pokemon_v2_versiongroup VALUES(8,10,4,'diamond-pearl');
+INSERT INTO pokemon_v2_versiongroup VALUES(9,11,4,'platinum');
+INSERT INTO pokemon_v2_versiongroup VALUES(10,12,4,'heartgold-soulsilver');
+INSERT INTO pokemon_v2_versiongroup VALUES(11,13,5,'black-white');
+INSERT INTO pokemon_v2_versiongroup VALUES(12,7,3,'colosseum');
+INSERT INTO pokemon_v2_versiongroup VALUES(13,8,3,'xd');
+INSERT INTO pokemon_v2_versiongroup VALUES(14,14,5,'black-2-white-2');
+INSERT INTO pokemon_v2_versiongroup VALUES(15,15,6,'x-y');
+INSERT INTO pokemon_v2_versiongroup VALUES(16,16,6,'omega-ruby-alpha-sapphire');
+INSERT INTO pokemon_v2_versiongroup VALUES(17,17,7,'sun-moon');
+INSERT INTO pokemon_v2_versiongroup VALUES(18,18,7,'ultra-sun-ultra-moon');
+INSERT INTO pokemon_v2_versiongroup VALUES(19,19,7,'lets-go-pikachu-lets-go-eevee');
+INSERT INTO pokemon_v2_versiongroup VALUES(20,20,8,'sword-shield');
+INSERT INTO pokemon_v2_versiongroup VALUES(21,21,8,'the-isle-of-armor');
+INSERT INTO pokemon_v2_versiongroup VALUES(22,22,8,'the-crown-tundra');
+INSERT INTO pokemon_v2_versiongroup VALUES(23,23,8,'brilliant-diamond-and-shining-pearl');
+INSERT INTO pokemon_v2_versiongroup VALUES(24,24,8,'legends-arceus');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_version" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "version_group_id" integer NULL REFERENCES "pokemon_v2_versiongroup" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_version VALUES(1,1,'red');
+INSERT INTO pokemon_v2_version VALUES(2,1,'blue');
+INSERT INTO pokemon_v2_version VALUES(3,2,'yellow');
+INSERT INTO pokemon_v2_version VALUES(4,3,'gold');
+INSERT INTO pokemon_v2_version VALUES(5,3,'silver');
+INSERT INTO pokemon_v2_version VALUES(6,4,'crystal');
+INSERT INTO pokemon_v2_version VALUES(7,5,'ruby');
+INSERT INTO pokemon_v2_version VALUES(8,5,'sapphire');
+INSERT INTO pokemon_v2_version VALUES(9,6,'emerald');
+INSERT INTO pokemon_v2_version VALUES(10,7,'firered');
+INSERT INTO pokemon_v2_version VALUES(11,7,'leafgreen');
+INSERT INTO pokemon_v2_version VALUES(12,8,'diamond');
+INSERT INTO pokemon_v2_version VALUES(13,8,'pearl');
+INSERT INTO pokemon_v2_version VALUES(14,9,'platinum');
+INSERT INTO pokemon_v2_version VALUES(15,10,'heartgold');
+INSERT INTO pokemon_v2_version VALUES(16,10,'soulsilver');
+INSERT INTO pokemon_v2_version VALUES(17,11,'black');
+INSERT INTO pokemon_v2_version VALUES(18,11,'white');
+INSERT INTO pokemon_v2_version VALUES(19,12,'colosseum');
+INSERT INTO pokemon_v2_version VALUES(20,13,'xd');
+INSERT INTO pokemon_v2_version VALUES(21,14,'black-2');
+INSERT INTO pokemon_v2_version VALUES(22,14,'white-2');
+INSERT INTO pokemon_v2_version VALUES(23,15,'x');
+INSERT INTO pokemon_v2_version VALUES(24,15,'y');
+INSERT INTO pokemon_v2_version VALUES(25,16,'omega-ruby');
+INSERT INTO pokemon_v2_version VALUES(26,16,'alpha-sapphire');
+INSERT INTO pokemon_v2_version VALUES(27,17,'sun');
+INSERT INTO pokemon_v2_version VALUES(28,17,'moon');
+INSERT INTO pokemon_v2_version VALUES(29,18,'ultra-sun');
+INSERT INTO pokemon_v2_version VALUES(30,18,'ultra-moon');
+INSERT INTO pokemon_v2_version VALUES(31,19,'lets-go-pikachu');
+INSERT INTO pokemon_v2_version VALUES(32,19,'lets-go-eevee');
+INSERT INTO pokemon_v2_version VALUES(33,20,'sword');
+INSERT INTO pokemon_v2_version VALUES(34,20,'shield');
+INSERT INTO pokemon_v2_version VALUES(35,21,'the-isle-of-armor');
+INSERT INTO pokemon_v2_version VALUES(36,22,'the-crown-tundra');
+INSERT INTO pokemon_v2_version VALUES(37,23,'brilliant-diamond');
+INSERT INTO pokemon_v2_version VALUES(38,23,'shining-pearl');
+INSERT INTO pokemon_v2_version VALUES(39,24,'legends-arceus');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_versionname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "version_id" integer NULL REFERENCES "pokemon_v2_version" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_versionname VALUES(4,5,1,'Rouge');
+INSERT INTO pokemon_v2_versionname VALUES(8,9,1,'Red');
+INSERT INTO pokemon_v2_versionname VALUES(13,5,2,'Bleu');
+INSERT INTO pokemon_v2_versionname VALUES(17,9,2,'Blue');
+INSERT INTO pokemon_v2_versionname VALUES(22,5,3,'Jaune');
+INSERT INTO pokemon_v2_versionname VALUES(26,9,3,'Yellow');
+INSERT INTO pokemon_v2_versionname VALUES(31,5,4,'Or');
+INSERT INTO pokemon_v2_versionname VALUES(35,9,4,'Gold');
+INSERT INTO pokemon_v2_versionname VALUES(40,5,5,'Argent');
+INSERT INTO pokemon_v2_versionname VALUES(44,9,5,'Silver');
+INSERT INTO pokemon_v2_versionname VALUES(49,5,6,'Cristal');
+INSERT INTO pokemon_v2_versionname VALUES(53,9,6,'Crystal');
+INSERT INTO pokemon_v2_versionname VALUES(58,5,7,'Rubis');
+INSERT INTO pokemon_v2_versionname VALUES(62,9,7,'Ruby');
+INSERT INTO pokemon_v2_versionname VALUES(67,5,8,'Saphir');
+INSERT INTO pokemon_v2_versionname VALUES(71,9,8,'Sapphire');
+INSERT INTO pokemon_v2_versionname VALUES(76,5,9,'Émeraude');
+INSERT INTO pokemon_v2_versionname VALUES(80,9,9,'Emerald');
+INSERT INTO pokemon_v2_versionname VALUES(85,5,10,'Rouge Feu');
+INSERT INTO pokemon_v2_versionname VALUES(89,9,10,'FireRed');
+INSERT INTO pokemon_v2_versionname VALUES(94,5,11,'Vert Feuille');
+INSERT INTO pokemon_v2_versionname VALUES(98,9,11,'LeafGreen');
+INSERT INTO pokemon_v2_versionname VALUES(103,5,12,'Diamant');
+INSERT INTO pokemon_v2_versionname VALUES(107,9,12,'Diamond');
+INSERT INTO pokemon_v2_versionname VALUES(112,5,13,'Perle');
+INSERT INTO pokemon_v2_versionname VALUES(116,9,13,'Pearl');
+INSERT INTO pokemon_v2_versionname VALUES(121,5,14,'Platine');
+INSERT INTO pokemon_v2_versionname VALUES(125,9,14,'Platinum');
+INSERT INTO pokemon_v2_versionname VALUES(130,5,15,'Or HeartGold');
+INSERT INTO pokemon_v2_versionname VALUES(134,9,15,'HeartGold');
+INSERT INTO pokemon_v2_versionname VALUES(139,5,16,'Argent SoulSilver');
+INSERT INTO pokemon_v2_versionname VALUES(143,9,16,'SoulSilver');
+INSERT INTO pokemon_v2_versionname VALUES(148,5,17,'Noir');
+INSERT INTO pokemon_v2_versionname VALUES(152,9,17,'Black');
+INSERT INTO pokemon_v2_versionname VALUES(157,5,18,'Blanc');
+INSERT INTO pokemon_v2_versionname VALUES(161,9,18,'White');
+INSERT INTO pokemon_v2_versionname VALUES(165,5,19,'Colosseum');
+INSERT INTO pokemon_v2_versionname VALUES(169,9,19,'Colosseum');
+INSERT INTO pokemon_v2_versionname VALUES(172,5,20,'XD');
+INSERT INTO pokemon_v2_versionname VALUES(176,9,20,'XD');
+INSERT INTO pokemon_v2_versionname VALUES(180,5,21,'Noir 2');
+INSERT INTO pokemon_v2_versionname VALUES(184,9,21,'Black 2');
+INSERT INTO pokemon_v2_versionname VALUES(189,5,22,'Blanc 2');
+INSERT INTO pokemon_v2_versionname VALUES(193,9,22,'White 2');
+INSERT INTO pokemon_v2_versionname VALUES(198,5,23,'X');
+INSERT INTO pokemon_v2_versionname VALUES(202,9,23,'X');
+INSERT INTO pokemon_v2_versionname VALUES(207,5,24,'Y');
+INSERT INTO pokemon_v2_versionname VALUES(211,9,24,'Y');
+INSERT INTO pokemon_v2_versionname VALUES(216,5,25,'Rubis Oméga');
+INSERT INTO pokemon_v2_versionname VALUES(220,9,25,'Omega Ruby');
+INSERT INTO pokemon_v2_versionname VALUES(225,5,26,'Saphir Alpha');
+INSERT INTO pokemon_v2_versionname VALUES(229,9,26,'Alpha Sapphire');
+INSERT INTO pokemon_v2_versionname VALUES(234,5,27,'Soleil');
+INSERT INTO pokemon_v2_versionname VALUES(238,9,27,'Sun');
+INSERT INTO pokemon_v2_versionname VALUES(243,5,28,'Lune');
+INSERT INTO pokemon_v2_versionname VALUES(247,9,28,'Moon');
+INSERT INTO pokemon_v2_versionname VALUES(252,5,29,'Ultra-Soleil');
+INSERT INTO pokemon_v2_versionname VALUES(256,9,29,'Ultra Sun');
+INSERT INTO pokemon_v2_versionname VALUES(261,5,30,'Ultra-Lune');
+INSERT INTO pokemon_v2_versionname VALUES(265,9,30,'Ultra Moon');
+INSERT INTO pokemon_v2_versionname VALUES(270,5,31,'Let''s Go, Pikachu');
+INSERT INTO pokemon_v2_versionname VALUES(274,9,31,'Let''s Go, Pikachu!');
+INSERT INTO pokemon_v2_versionname VALUES(279,5,32,'Let''s Go, Évoli');
+INSERT INTO pokemon_v2_versionname VALUES(283,9,32,'Let''s Go, Eevee!');
+INSERT INTO pokemon_v2_versionname VALUES(288,5,33,'Épée');
+INSERT INTO pokemon_v2_versionname VALUES(292,9,33,'Sword');
+INSERT INTO pokemon_v2_versionname VALUES(297,5,34,'Bouclier');
+INSERT INTO pokemon_v2_versionname VALUES(301,9,34,'Shield');
+INSERT INTO pokemon_v2_versionname VALUES(306,5,35,'L''île solitaire de l''Armure');
+INSERT INTO pokemon_v2_versionname VALUES(310,9,35,'The Isle of Armor');
+INSERT INTO pokemon_v2_versionname VALUES(315,5,36,'Les terres enneigées de la Couronne');
+INSERT INTO pokemon_v2_versionname VALUES(319,9,36,'The Crown Tundra');
+INSERT INTO pokemon_v2_versionname VALUES(324,5,37,'Diamant Étincelant');
+INSERT INTO pokemon_v2_versionname VALUES(328,9,37,'Brilliant Diamond');
+INSERT INTO pokemon_v2_versionname VALUES(333,5,38,'Perle Scintillante');
+INSERT INTO pokemon_v2_versionname VALUES(337,9,38,'Shining Pearl');
+INSERT INTO pokemon_v2_versionname VALUES(342,5,39,'Légendes : Arceus');
+INSERT INTO pokemon_v2_versionname VALUES(346,9,39,'Legends: Arceus');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_movedamageclass" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_movedamageclass VALUES(1,'status');
+INSERT INTO pokemon_v2_movedamageclass VALUES(2,'physical');
+INSERT INTO pokemon_v2_movedamageclass VALUES(3,'special');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_movedamageclassname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "move_damage_class_id" integer NULL REFERENCES "pokemon_v2_movedamageclass" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_movedamageclassname VALUES(2,5,1,'statut');
+INSERT INTO pokemon_v2_movedamageclassname VALUES(5,9,1,'status');
+INSERT INTO pokemon_v2_movedamageclassname VALUES(7,5,2,'physique');
+INSERT INTO pokemon_v2_movedamageclassname VALUES(10,9,2,'physical');
+INSERT INTO pokemon_v2_movedamageclassname VALUES(12,5,3,'spéciale');
+INSERT INTO pokemon_v2_movedamageclassname VALUES(15,9,3,'special');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_type" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "move_damage_class_id" integer NULL REFERENCES "pokemon_v2_movedamageclass" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_type VALUES(1,1,2,'normal');
+INSERT INTO pokemon_v2_type VALUES(2,1,2,'fighting');
+INSERT INTO pokemon_v2_type VALUES(3,1,2,'flying');
+INSERT INTO pokemon_v2_type VALUES(4,1,2,'poison');
+INSERT INTO pokemon_v2_type VALUES(5,1,2,'ground');
+INSERT INTO pokemon_v2_type VALUES(6,1,2,'rock');
+INSERT INTO pokemon_v2_type VALUES(7,1,2,'bug');
+INSERT INTO pokemon_v2_type VALUES(8,1,2,'ghost');
+INSERT INTO pokemon_v2_type VALUES(9,2,2,'steel');
+INSERT INTO pokemon_v2_type VALUES(10,1,3,'fire');
+INSERT INTO pokemon_v2_type VALUES(11,1,3,'water');
+INSERT INTO pokemon_v2_type VALUES(12,1,3,'grass');
+INSERT INTO pokemon_v2_type VALUES(13,1,3,'electric');
+INSERT INTO pokemon_v2_type VALUES(14,1,3,'psychic');
+INSERT INTO pokemon_v2_type VALUES(15,1,3,'ice');
+INSERT INTO pokemon_v2_type VALUES(16,1,3,'dragon');
+INSERT INTO pokemon_v2_type VALUES(17,2,3,'dark');
+INSERT INTO pokemon_v2_type VALUES(18,6,NULL,'fairy');
+INSERT INTO pokemon_v2_type VALUES(10001,2,NULL,'unknown');
+INSERT INTO pokemon_v2_type VALUES(10002,3,NULL,'shadow');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_typename" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_typename VALUES(4,5,1,'Normal');
+INSERT INTO pokemon_v2_typename VALUES(8,9,1,'Normal');
+INSERT INTO pokemon_v2_typename VALUES(14,5,2,'Combat');
+INSERT INTO pokemon_v2_typename VALUES(18,9,2,'Fighting');
+INSERT INTO pokemon_v2_typename VALUES(24,5,3,'Vol');
+INSERT INTO pokemon_v2_typename VALUES(28,9,3,'Flying');
+INSERT INTO pokemon_v2_typename VALUES(34,5,4,'Poison');
+INSERT INTO pokemon_v2_typename VALUES(38,9,4,'Poison');
+INSERT INTO pokemon_v2_typename VALUES(44,5,5,'Sol');
+INSERT INTO pokemon_v2_typename VALUES(48,9,5,'Ground');
+INSERT INTO pokemon_v2_typename VALUES(54,5,6,'Roche');
+INSERT INTO pokemon_v2_typename VALUES(58,9,6,'Rock');
+INSERT INTO pokemon_v2_typename VALUES(64,5,7,'Insecte');
+INSERT INTO pokemon_v2_typename VALUES(68,9,7,'Bug');
+INSERT INTO pokemon_v2_typename VALUES(74,5,8,'Spectre');
+INSERT INTO pokemon_v2_typename VALUES(78,9,8,'Ghost');
+INSERT INTO pokemon_v2_typename VALUES(84,5,9,'Acier');
+INSERT INTO pokemon_v2_typename VALUES(88,9,9,'Steel');
+INSERT INTO pokemon_v2_typename VALUES(94,5,10,'Feu');
+INSERT INTO pokemon_v2_typename VALUES(98,9,10,'Fire');
+INSERT INTO pokemon_v2_typename VALUES(104,5,11,'Eau');
+INSERT INTO pokemon_v2_typename VALUES(108,9,11,'Water');
+INSERT INTO pokemon_v2_typename VALUES(114,5,12,'Plante');
+INSERT INTO pokemon_v2_typename VALUES(118,9,12,'Grass');
+INSERT INTO pokemon_v2_typename VALUES(124,5,13,'Électrik');
+INSERT INTO pokemon_v2_typename VALUES(128,9,13,'Electric');
+INSERT INTO pokemon_v2_typename VALUES(134,5,14,'Psy');
+INSERT INTO pokemon_v2_typename VALUES(138,9,14,'Psychic');
+INSERT INTO pokemon_v2_typename VALUES(144,5,15,'Glace');
+INSERT INTO pokemon_v2_typename VALUES(148,9,15,'Ice');
+INSERT INTO pokemon_v2_typename VALUES(154,5,16,'Dragon');
+INSERT INTO pokemon_v2_typename VALUES(158,9,16,'Dragon');
+INSERT INTO pokemon_v2_typename VALUES(164,5,17,'Ténèbres');
+INSERT INTO pokemon_v2_typename VALUES(168,9,17,'Dark');
+INSERT INTO pokemon_v2_typename VALUES(174,5,18,'Fée');
+INSERT INTO pokemon_v2_typename VALUES(178,9,18,'Fairy');
+INSERT INTO pokemon_v2_typename VALUES(183,5,10001,'???');
+INSERT INTO pokemon_v2_typename VALUES(187,9,10001,'???');
+INSERT INTO pokemon_v2_typename VALUES(190,5,10002,'Obscur');
+INSERT INTO pokemon_v2_typename VALUES(193,9,10002,'Shadow');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_typeefficacy" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "damage_factor" integer NOT NULL, "damage_type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED, "target_type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_typeefficacy VALUES(1,100,1,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(2,100,1,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(3,100,1,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(4,100,1,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(5,100,1,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(6,50,1,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(7,100,1,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(8,0,1,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(9,50,1,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(10,100,1,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(11,100,1,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(12,100,1,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(13,100,1,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(14,100,1,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(15,100,1,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(16,100,1,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(17,100,1,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(18,100,1,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(19,200,2,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(20,100,2,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(21,50,2,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(22,50,2,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(23,100,2,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(24,200,2,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(25,50,2,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(26,0,2,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(27,200,2,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(28,100,2,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(29,100,2,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(30,100,2,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(31,100,2,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(32,50,2,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(33,200,2,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(34,100,2,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(35,200,2,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(36,50,2,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(37,100,3,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(38,200,3,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(39,100,3,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(40,100,3,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(41,100,3,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(42,50,3,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(43,200,3,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(44,100,3,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(45,50,3,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(46,100,3,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(47,100,3,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(48,200,3,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(49,50,3,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(50,100,3,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(51,100,3,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(52,100,3,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(53,100,3,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(54,100,3,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(55,100,4,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(56,100,4,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(57,100,4,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(58,50,4,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(59,50,4,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(60,50,4,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(61,100,4,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(62,50,4,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(63,0,4,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(64,100,4,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(65,100,4,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(66,200,4,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(67,100,4,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(68,100,4,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(69,100,4,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(70,100,4,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(71,100,4,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(72,200,4,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(73,100,5,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(74,100,5,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(75,0,5,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(76,200,5,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(77,100,5,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(78,200,5,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(79,50,5,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(80,100,5,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(81,200,5,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(82,200,5,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(83,100,5,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(84,50,5,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(85,200,5,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(86,100,5,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(87,100,5,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(88,100,5,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(89,100,5,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(90,100,5,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(91,100,6,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(92,50,6,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(93,200,6,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(94,100,6,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(95,50,6,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(96,100,6,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(97,200,6,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(98,100,6,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(99,50,6,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(100,200,6,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(101,100,6,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(102,100,6,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(103,100,6,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(104,100,6,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(105,200,6,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(106,100,6,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(107,100,6,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(108,100,6,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(109,100,7,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(110,50,7,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(111,50,7,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(112,50,7,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(113,100,7,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(114,100,7,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(115,100,7,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(116,50,7,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(117,50,7,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(118,50,7,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(119,100,7,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(120,200,7,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(121,100,7,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(122,200,7,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(123,100,7,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(124,100,7,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(125,200,7,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(126,50,7,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(127,0,8,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(128,100,8,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(129,100,8,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(130,100,8,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(131,100,8,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(132,100,8,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(133,100,8,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(134,200,8,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(135,100,8,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(136,100,8,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(137,100,8,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(138,100,8,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(139,100,8,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(140,200,8,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(141,100,8,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(142,100,8,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(143,50,8,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(144,100,8,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(145,100,9,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(146,100,9,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(147,100,9,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(148,100,9,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(149,100,9,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(150,200,9,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(151,100,9,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(152,100,9,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(153,50,9,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(154,50,9,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(155,50,9,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(156,100,9,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(157,50,9,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(158,100,9,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(159,200,9,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(160,100,9,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(161,100,9,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(162,200,9,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(163,100,10,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(164,100,10,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(165,100,10,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(166,100,10,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(167,100,10,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(168,50,10,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(169,200,10,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(170,100,10,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(171,200,10,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(172,50,10,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(173,50,10,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(174,200,10,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(175,100,10,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(176,100,10,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(177,200,10,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(178,50,10,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(179,100,10,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(180,100,10,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(181,100,11,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(182,100,11,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(183,100,11,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(184,100,11,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(185,200,11,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(186,200,11,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(187,100,11,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(188,100,11,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(189,100,11,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(190,200,11,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(191,50,11,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(192,50,11,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(193,100,11,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(194,100,11,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(195,100,11,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(196,50,11,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(197,100,11,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(198,100,11,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(199,100,12,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(200,100,12,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(201,50,12,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(202,50,12,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(203,200,12,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(204,200,12,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(205,50,12,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(206,100,12,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(207,50,12,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(208,50,12,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(209,200,12,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(210,50,12,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(211,100,12,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(212,100,12,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(213,100,12,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(214,50,12,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(215,100,12,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(216,100,12,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(217,100,13,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(218,100,13,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(219,200,13,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(220,100,13,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(221,0,13,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(222,100,13,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(223,100,13,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(224,100,13,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(225,100,13,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(226,100,13,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(227,200,13,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(228,50,13,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(229,50,13,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(230,100,13,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(231,100,13,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(232,50,13,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(233,100,13,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(234,100,13,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(235,100,14,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(236,200,14,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(237,100,14,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(238,200,14,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(239,100,14,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(240,100,14,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(241,100,14,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(242,100,14,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(243,50,14,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(244,100,14,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(245,100,14,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(246,100,14,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(247,100,14,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(248,50,14,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(249,100,14,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(250,100,14,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(251,0,14,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(252,100,14,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(253,100,15,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(254,100,15,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(255,200,15,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(256,100,15,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(257,200,15,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(258,100,15,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(259,100,15,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(260,100,15,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(261,50,15,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(262,50,15,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(263,50,15,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(264,200,15,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(265,100,15,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(266,100,15,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(267,50,15,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(268,200,15,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(269,100,15,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(270,100,15,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(271,100,16,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(272,100,16,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(273,100,16,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(274,100,16,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(275,100,16,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(276,100,16,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(277,100,16,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(278,100,16,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(279,50,16,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(280,100,16,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(281,100,16,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(282,100,16,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(283,100,16,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(284,100,16,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(285,100,16,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(286,200,16,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(287,100,16,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(288,0,16,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(289,100,17,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(290,50,17,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(291,100,17,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(292,100,17,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(293,100,17,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(294,100,17,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(295,100,17,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(296,200,17,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(297,100,17,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(298,100,17,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(299,100,17,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(300,100,17,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(301,100,17,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(302,200,17,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(303,100,17,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(304,100,17,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(305,50,17,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(306,50,17,18);
+INSERT INTO pokemon_v2_typeefficacy VALUES(307,100,18,1);
+INSERT INTO pokemon_v2_typeefficacy VALUES(308,200,18,2);
+INSERT INTO pokemon_v2_typeefficacy VALUES(309,100,18,3);
+INSERT INTO pokemon_v2_typeefficacy VALUES(310,50,18,4);
+INSERT INTO pokemon_v2_typeefficacy VALUES(311,100,18,5);
+INSERT INTO pokemon_v2_typeefficacy VALUES(312,100,18,6);
+INSERT INTO pokemon_v2_typeefficacy VALUES(313,100,18,7);
+INSERT INTO pokemon_v2_typeefficacy VALUES(314,100,18,8);
+INSERT INTO pokemon_v2_typeefficacy VALUES(315,50,18,9);
+INSERT INTO pokemon_v2_typeefficacy VALUES(316,50,18,10);
+INSERT INTO pokemon_v2_typeefficacy VALUES(317,100,18,11);
+INSERT INTO pokemon_v2_typeefficacy VALUES(318,100,18,12);
+INSERT INTO pokemon_v2_typeefficacy VALUES(319,100,18,13);
+INSERT INTO pokemon_v2_typeefficacy VALUES(320,100,18,14);
+INSERT INTO pokemon_v2_typeefficacy VALUES(321,100,18,15);
+INSERT INTO pokemon_v2_typeefficacy VALUES(322,200,18,16);
+INSERT INTO pokemon_v2_typeefficacy VALUES(323,200,18,17);
+INSERT INTO pokemon_v2_typeefficacy VALUES(324,100,18,18);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_typeefficacypast" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "damage_factor" integer NOT NULL, "damage_type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "target_type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(1,200,4,1,7);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(2,200,7,1,4);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(3,0,8,1,14);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(4,100,15,1,10);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(5,50,8,5,9);
+INSERT INTO pokemon_v2_typeefficacypast VALUES(6,50,17,5,9);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_stat" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "is_battle_only" bool NOT NULL, "game_index" integer NOT NULL, "move_damage_class_id" integer NULL REFERENCES "pokemon_v2_movedamageclass" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_stat VALUES(1,0,1,NULL,'hp');
+INSERT INTO pokemon_v2_stat VALUES(2,0,2,2,'attack');
+INSERT INTO pokemon_v2_stat VALUES(3,0,3,2,'defense');
+INSERT INTO pokemon_v2_stat VALUES(4,0,5,3,'special-attack');
+INSERT INTO pokemon_v2_stat VALUES(5,0,6,3,'special-defense');
+INSERT INTO pokemon_v2_stat VALUES(6,0,4,NULL,'speed');
+INSERT INTO pokemon_v2_stat VALUES(7,1,0,NULL,'accuracy');
+INSERT INTO pokemon_v2_stat VALUES(8,1,0,NULL,'evasion');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_statname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "stat_id" integer NULL REFERENCES "pokemon_v2_stat" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_statname VALUES(4,5,1,'PV');
+INSERT INTO pokemon_v2_statname VALUES(8,9,1,'HP');
+INSERT INTO pokemon_v2_statname VALUES(13,5,2,'Attaque');
+INSERT INTO pokemon_v2_statname VALUES(17,9,2,'Attack');
+INSERT INTO pokemon_v2_statname VALUES(22,5,3,'Défense');
+INSERT INTO pokemon_v2_statname VALUES(26,9,3,'Defense');
+INSERT INTO pokemon_v2_statname VALUES(31,5,4,'Attaque Spéciale');
+INSERT INTO pokemon_v2_statname VALUES(35,9,4,'Special Attack');
+INSERT INTO pokemon_v2_statname VALUES(40,5,5,'Défense Spéciale');
+INSERT INTO pokemon_v2_statname VALUES(44,9,5,'Special Defense');
+INSERT INTO pokemon_v2_statname VALUES(49,5,6,'Vitesse');
+INSERT INTO pokemon_v2_statname VALUES(53,9,6,'Speed');
+INSERT INTO pokemon_v2_statname VALUES(57,5,7,'Précision');
+INSERT INTO pokemon_v2_statname VALUES(61,9,7,'accuracy');
+INSERT INTO pokemon_v2_statname VALUES(64,5,8,'Esquive');
+INSERT INTO pokemon_v2_statname VALUES(68,9,8,'evasion');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_movelearnmethod" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_movelearnmethod VALUES(1,'level-up');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(2,'egg');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(3,'tutor');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(4,'machine');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(5,'stadium-surfing-pikachu');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(6,'light-ball-egg');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(7,'colosseum-purification');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(8,'xd-shadow');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(9,'xd-purification');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(10,'form-change');
+INSERT INTO pokemon_v2_movelearnmethod VALUES(11,'zygarde-cube');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonspecies" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "name" varchar(100) NOT NULL, "order" integer NULL, "gender_rate" integer NULL, "capture_rate" integer NULL, "base_happiness" integer NULL, "is_baby" bool NOT NULL, "hatch_counter" integer NULL, "has_gender_differences" bool NOT NULL, "forms_switchable" bool NOT NULL, "evolution_chain_id" integer NULL REFERENCES "pokemon_v2_evolutionchain" ("id") DEFERRABLE INITIALLY DEFERRED, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "growth_rate_id" integer NULL REFERENCES "pokemon_v2_growthrate" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_color_id" integer NULL REFERENCES "pokemon_v2_pokemoncolor" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_habitat_id" integer NULL REFERENCES "pokemon_v2_pokemonhabitat" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_shape_id" integer NULL REFERENCES "pokemon_v2_pokemonshape" ("id") DEFERRABLE INITIALLY DEFERRED, "is_legendary" bool NOT NULL, "is_mythical" bool NOT NULL, "evolves_from_species_id" integer NULL REFERENCES "pokemon_v2_pokemonspecies" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(1,'bulbasaur',1,1,45,50,0,20,0,0,1,1,4,5,3,8,0,0,NULL);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(2,'ivysaur',2,1,45,50,0,20,0,0,1,1,4,5,3,8,0,0,1);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(3,'venusaur',3,1,45,50,0,20,1,1,1,1,4,5,3,8,0,0,2);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(4,'charmander',4,1,45,50,0,20,0,0,2,1,4,8,4,6,0,0,NULL);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(5,'charmeleon',5,1,45,50,0,20,0,0,2,1,4,8,4,6,0,0,4);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(6,'charizard',6,1,45,50,0,20,0,1,2,1,4,8,4,6,0,0,5);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(12,'butterfree',14,4,45,50,0,15,1,0,4,1,2,9,2,13,0,0,NULL);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(94,'gengar',102,4,45,50,0,20,0,1,40,1,4,7,1,6,0,0,93);
+INSERT INTO pokemon_v2_pokemonspecies VALUES(250,'ho-oh',275,-1,3,0,0,120,0,0,128,2,1,8,5,9,1,0,NULL);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonspeciesname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "genus" varchar(30) NOT NULL, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_species_id" integer NULL REFERENCES "pokemon_v2_pokemonspecies" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(5,'Pokémon Graine',5,1,'Bulbizarre');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(9,'Seed Pokémon',9,1,'Bulbasaur');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(16,'Pokémon Graine',5,2,'Herbizarre');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(20,'Seed Pokémon',9,2,'Ivysaur');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(27,'Pokémon Graine',5,3,'Florizarre');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(31,'Seed Pokémon',9,3,'Venusaur');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(38,'Pokémon Lézard',5,4,'Salamèche');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(42,'Lizard Pokémon',9,4,'Charmander');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(49,'Pokémon Flamme',5,5,'Reptincel');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(53,'Flame Pokémon',9,5,'Charmeleon');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(60,'Pokémon Flamme',5,6,'Dracaufeu');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(64,'Flame Pokémon',9,6,'Charizard');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(126,'Pokémon Papillon',5,12,'Papilusion');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(130,'Butterfly Pokémon',9,12,'Butterfree');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(1028,'Pokémon Ombre',5,94,'Ectoplasma');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(1032,'Shadow Pokémon',9,94,'Gengar');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(2744,'Pokémon Arcenciel',5,250,'Ho-Oh');
+INSERT INTO pokemon_v2_pokemonspeciesname VALUES(2748,'Rainbow Pokémon',9,250,'Ho-Oh');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemon" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "name" varchar(100) NOT NULL, "order" integer NULL, "height" integer NULL, "weight" integer NULL, "is_default" bool NOT NULL, "pokemon_species_id" integer NULL REFERENCES "pokemon_v2_pokemonspecies" ("id") DEFERRABLE INITIALLY DEFERRED, "base_experience" integer NULL);
+INSERT INTO pokemon_v2_pokemon VALUES(1,'bulbasaur',1,7,69,1,1,64);
+INSERT INTO pokemon_v2_pokemon VALUES(2,'ivysaur',2,10,130,1,2,142);
+INSERT INTO pokemon_v2_pokemon VALUES(3,'venusaur',3,20,1000,1,3,263);
+INSERT INTO pokemon_v2_pokemon VALUES(4,'charmander',5,6,85,1,4,62);
+INSERT INTO pokemon_v2_pokemon VALUES(5,'charmeleon',6,11,190,1,5,142);
+INSERT INTO pokemon_v2_pokemon VALUES(6,'charizard',7,17,905,1,6,267);
+INSERT INTO pokemon_v2_pokemon VALUES(12,'butterfree',16,11,320,1,12,178);
+INSERT INTO pokemon_v2_pokemon VALUES(94,'gengar',149,15,405,1,94,250);
+INSERT INTO pokemon_v2_pokemon VALUES(250,'ho-oh',345,38,1990,1,250,340);
+INSERT INTO pokemon_v2_pokemon VALUES(10034,'charizard-mega-x',8,17,1105,0,6,285);
+INSERT INTO pokemon_v2_pokemon VALUES(10035,'charizard-mega-y',9,17,1005,0,6,285);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemontype" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "slot" integer NOT NULL, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemontype VALUES(1,1,1,12);
+INSERT INTO pokemon_v2_pokemontype VALUES(2,2,1,4);
+INSERT INTO pokemon_v2_pokemontype VALUES(3,1,2,12);
+INSERT INTO pokemon_v2_pokemontype VALUES(4,2,2,4);
+INSERT INTO pokemon_v2_pokemontype VALUES(5,1,3,12);
+INSERT INTO pokemon_v2_pokemontype VALUES(6,2,3,4);
+INSERT INTO pokemon_v2_pokemontype VALUES(7,1,4,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(8,1,5,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(9,1,6,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(10,2,6,3);
+INSERT INTO pokemon_v2_pokemontype VALUES(16,1,12,7);
+INSERT INTO pokemon_v2_pokemontype VALUES(17,2,12,3);
+INSERT INTO pokemon_v2_pokemontype VALUES(140,1,94,8);
+INSERT INTO pokemon_v2_pokemontype VALUES(141,2,94,4);
+INSERT INTO pokemon_v2_pokemontype VALUES(364,1,250,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(365,2,250,3);
+INSERT INTO pokemon_v2_pokemontype VALUES(1412,1,10034,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(1413,2,10034,16);
+INSERT INTO pokemon_v2_pokemontype VALUES(1414,1,10035,10);
+INSERT INTO pokemon_v2_pokemontype VALUES(1415,2,10035,3);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemontypepast" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "slot" integer NOT NULL, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonform" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "order" integer NULL, "form_name" varchar(30) NOT NULL, "is_default" bool NOT NULL, "is_battle_only" bool NOT NULL, "is_mega" bool NOT NULL, "version_group_id" integer NULL REFERENCES "pokemon_v2_versiongroup" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "form_order" integer NULL, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_pokemonform VALUES(1,1,'',1,0,0,1,1,1,'bulbasaur');
+INSERT INTO pokemon_v2_pokemonform VALUES(2,2,'',1,0,0,1,2,1,'ivysaur');
+INSERT INTO pokemon_v2_pokemonform VALUES(3,3,'',1,0,0,1,3,1,'venusaur');
+INSERT INTO pokemon_v2_pokemonform VALUES(4,5,'',1,0,0,1,4,1,'charmander');
+INSERT INTO pokemon_v2_pokemonform VALUES(5,6,'',1,0,0,1,5,1,'charmeleon');
+INSERT INTO pokemon_v2_pokemonform VALUES(6,7,'',1,0,0,1,6,1,'charizard');
+INSERT INTO pokemon_v2_pokemonform VALUES(12,16,'',1,0,0,1,12,1,'butterfree');
+INSERT INTO pokemon_v2_pokemonform VALUES(94,150,'',1,0,0,1,94,1,'gengar');
+INSERT INTO pokemon_v2_pokemonform VALUES(250,373,'',1,0,0,3,250,1,'ho-oh');
+INSERT INTO pokemon_v2_pokemonform VALUES(10134,8,'mega-x',1,1,1,15,10034,2,'charizard-mega-x');
+INSERT INTO pokemon_v2_pokemonform VALUES(10135,9,'mega-y',1,1,1,15,10035,3,'charizard-mega-y');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonformname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "name" varchar(100) NOT NULL, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_form_id" integer NULL REFERENCES "pokemon_v2_pokemonform" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_name" varchar(60) NOT NULL);
+INSERT INTO pokemon_v2_pokemonformname VALUES(1494,'Méga-Dracaufeu X',5,10134,'Méga-Dracaufeu X');
+INSERT INTO pokemon_v2_pokemonformname VALUES(1498,'Mega Charizard X',9,10134,'Mega Charizard X');
+INSERT INTO pokemon_v2_pokemonformname VALUES(1504,'Méga-Dracaufeu Y',5,10135,'Méga-Dracaufeu Y');
+INSERT INTO pokemon_v2_pokemonformname VALUES(1508,'Mega Charizard Y',9,10135,'Mega Charizard Y');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonstat" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "base_stat" integer NOT NULL, "effort" integer NOT NULL, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "stat_id" integer NULL REFERENCES "pokemon_v2_stat" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1,45,0,1,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(2,49,0,1,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(3,49,0,1,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(4,65,1,1,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5,65,0,1,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(6,45,0,1,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(7,60,0,2,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(8,62,0,2,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(9,63,0,2,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(10,80,1,2,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(11,80,1,2,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(12,60,0,2,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(13,80,0,3,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(14,82,0,3,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(15,83,0,3,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(16,100,2,3,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(17,100,1,3,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(18,80,0,3,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(19,39,0,4,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(20,52,0,4,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(21,43,0,4,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(22,60,0,4,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(23,50,0,4,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(24,65,1,4,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(25,58,0,5,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(26,64,0,5,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(27,58,0,5,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(28,80,1,5,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(29,65,0,5,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(30,80,1,5,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(31,78,0,6,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(32,84,0,6,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(33,78,0,6,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(34,109,3,6,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(35,85,0,6,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(36,100,0,6,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(67,60,0,12,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(68,45,0,12,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(69,50,0,12,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(70,90,2,12,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(71,80,1,12,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(72,70,0,12,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(559,60,0,94,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(560,65,0,94,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(561,60,0,94,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(562,130,3,94,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(563,75,0,94,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(564,110,0,94,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1495,106,0,250,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1496,130,0,250,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1497,90,0,250,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1498,110,0,250,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1499,154,3,250,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(1500,90,0,250,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5629,78,0,10034,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5630,130,0,10034,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5631,111,0,10034,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5632,130,3,10034,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5633,85,0,10034,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5634,100,0,10034,6);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5635,78,0,10035,1);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5636,104,0,10035,2);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5637,78,0,10035,3);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5638,159,3,10035,4);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5639,115,0,10035,5);
+INSERT INTO pokemon_v2_pokemonstat VALUES(5640,100,0,10035,6);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_ability" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "is_main_series" bool NOT NULL, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_ability VALUES(26,1,3,'levitate');
+INSERT INTO pokemon_v2_ability VALUES(34,1,3,'chlorophyll');
+INSERT INTO pokemon_v2_ability VALUES(46,1,3,'pressure');
+INSERT INTO pokemon_v2_ability VALUES(65,1,3,'overgrow');
+INSERT INTO pokemon_v2_ability VALUES(66,1,3,'blaze');
+INSERT INTO pokemon_v2_ability VALUES(70,1,3,'drought');
+INSERT INTO pokemon_v2_ability VALUES(94,1,4,'solar-power');
+INSERT INTO pokemon_v2_ability VALUES(130,1,5,'cursed-body');
+INSERT INTO pokemon_v2_ability VALUES(144,1,5,'regenerator');
+INSERT INTO pokemon_v2_ability VALUES(181,1,6,'tough-claws');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_abilityname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "ability_id" integer NULL REFERENCES "pokemon_v2_ability" ("id") DEFERRABLE INITIALLY DEFERRED, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_abilityname VALUES(254,26,5,'Lévitation');
+INSERT INTO pokemon_v2_abilityname VALUES(258,26,9,'Levitate');
+INSERT INTO pokemon_v2_abilityname VALUES(334,34,5,'Chlorophylle');
+INSERT INTO pokemon_v2_abilityname VALUES(338,34,9,'Chlorophyll');
+INSERT INTO pokemon_v2_abilityname VALUES(454,46,5,'Pression');
+INSERT INTO pokemon_v2_abilityname VALUES(458,46,9,'Pressure');
+INSERT INTO pokemon_v2_abilityname VALUES(644,65,5,'Engrais');
+INSERT INTO pokemon_v2_abilityname VALUES(648,65,9,'Overgrow');
+INSERT INTO pokemon_v2_abilityname VALUES(654,66,5,'Brasier');
+INSERT INTO pokemon_v2_abilityname VALUES(658,66,9,'Blaze');
+INSERT INTO pokemon_v2_abilityname VALUES(694,70,5,'Sécheresse');
+INSERT INTO pokemon_v2_abilityname VALUES(698,70,9,'Drought');
+INSERT INTO pokemon_v2_abilityname VALUES(934,94,5,'Force Soleil');
+INSERT INTO pokemon_v2_abilityname VALUES(938,94,9,'Solar Power');
+INSERT INTO pokemon_v2_abilityname VALUES(1294,130,5,'Corps Maudit');
+INSERT INTO pokemon_v2_abilityname VALUES(1298,130,9,'Cursed Body');
+INSERT INTO pokemon_v2_abilityname VALUES(1434,144,5,'Régé-Force');
+INSERT INTO pokemon_v2_abilityname VALUES(1438,144,9,'Regenerator');
+INSERT INTO pokemon_v2_abilityname VALUES(1804,181,5,'Griffe Dure');
+INSERT INTO pokemon_v2_abilityname VALUES(1808,181,9,'Tough Claws');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonability" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "is_hidden" bool NOT NULL, "slot" integer NOT NULL, "ability_id" integer NULL REFERENCES "pokemon_v2_ability" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemonability VALUES(1,0,1,65,1);
+INSERT INTO pokemon_v2_pokemonability VALUES(2,1,3,34,1);
+INSERT INTO pokemon_v2_pokemonability VALUES(3,0,1,65,2);
+INSERT INTO pokemon_v2_pokemonability VALUES(4,1,3,34,2);
+INSERT INTO pokemon_v2_pokemonability VALUES(5,0,1,65,3);
+INSERT INTO pokemon_v2_pokemonability VALUES(6,1,3,34,3);
+INSERT INTO pokemon_v2_pokemonability VALUES(7,0,1,66,4);
+INSERT INTO pokemon_v2_pokemonability VALUES(8,1,3,94,4);
+INSERT INTO pokemon_v2_pokemonability VALUES(9,0,1,66,5);
+INSERT INTO pokemon_v2_pokemonability VALUES(10,1,3,94,5);
+INSERT INTO pokemon_v2_pokemonability VALUES(11,0,1,66,6);
+INSERT INTO pokemon_v2_pokemonability VALUES(12,1,3,94,6);
+INSERT INTO pokemon_v2_pokemonability VALUES(243,0,1,130,94);
+INSERT INTO pokemon_v2_pokemonability VALUES(654,0,1,46,250);
+INSERT INTO pokemon_v2_pokemonability VALUES(655,1,3,144,250);
+INSERT INTO pokemon_v2_pokemonability VALUES(2215,0,1,181,10034);
+INSERT INTO pokemon_v2_pokemonability VALUES(2216,0,1,70,10035);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonabilitypast" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "is_hidden" bool NOT NULL, "slot" integer NOT NULL, "ability_id" integer NULL REFERENCES "pokemon_v2_ability" ("id") DEFERRABLE INITIALLY DEFERRED, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemonabilitypast VALUES(1,0,1,26,6,94);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonsprites" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "sprites" varchar(20000) NOT NULL);
+INSERT INTO pokemon_v2_pokemonsprites VALUES(4,4,'{"front_default": null}');
+INSERT INTO pokemon_v2_pokemonsprites VALUES(6,6,'{"front_default": "/media/sprites/pokemon/6.png"}');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_move" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "power" integer NULL, "pp" integer NULL, "accuracy" integer NULL, "priority" integer NULL, "move_effect_chance" integer NULL, "generation_id" integer NULL REFERENCES "pokemon_v2_generation" ("id") DEFERRABLE INITIALLY DEFERRED, "move_damage_class_id" integer NULL REFERENCES "pokemon_v2_movedamageclass" ("id") DEFERRABLE INITIALLY DEFERRED, "move_effect_id" integer NULL REFERENCES "pokemon_v2_moveeffect" ("id") DEFERRABLE INITIALLY DEFERRED, "move_target_id" integer NULL REFERENCES "pokemon_v2_movetarget" ("id") DEFERRABLE INITIALLY DEFERRED, "type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED, "contest_effect_id" integer NULL REFERENCES "pokemon_v2_contesteffect" ("id") DEFERRABLE INITIALLY DEFERRED, "contest_type_id" integer NULL REFERENCES "pokemon_v2_contesttype" ("id") DEFERRABLE INITIALLY DEFERRED, "super_contest_effect_id" integer NULL REFERENCES "pokemon_v2_supercontesteffect" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_move VALUES(2,50,25,100,0,NULL,1,2,44,10,2,2,5,5,'karate-chop');
+INSERT INTO pokemon_v2_move VALUES(7,75,15,100,0,10,1,2,5,10,10,1,2,17,'fire-punch');
+INSERT INTO pokemon_v2_move VALUES(22,45,25,100,0,NULL,1,2,1,10,12,1,1,5,'vine-whip');
+INSERT INTO pokemon_v2_move VALUES(33,40,35,100,0,NULL,1,2,1,10,1,1,5,5,'tackle');
+INSERT INTO pokemon_v2_move VALUES(44,60,25,100,0,30,1,2,32,10,17,5,5,5,'bite');
+INSERT INTO pokemon_v2_move VALUES(52,40,25,100,0,10,1,3,5,10,10,1,2,5,'ember');
+INSERT INTO pokemon_v2_move VALUES(53,90,15,100,0,10,1,3,5,10,10,1,2,17,'flamethrower');
+INSERT INTO pokemon_v2_move VALUES(75,55,25,95,0,NULL,1,2,44,11,12,2,1,5,'razor-leaf');
+INSERT INTO pokemon_v2_move VALUES(85,90,15,100,0,10,1,3,7,10,13,1,1,17,'thunderbolt');
+INSERT INTO pokemon_v2_move VALUES(95,NULL,20,60,0,NULL,1,1,2,10,14,5,4,19,'hypnosis');
+INSERT INTO pokemon_v2_move VALUES(126,110,5,85,0,10,1,3,5,10,10,1,2,17,'fire-blast');
+INSERT INTO pokemon_v2_move VALUES(221,100,5,95,0,50,2,2,126,10,10,1,2,22,'sacred-fire');
+INSERT INTO pokemon_v2_move VALUES(247,80,15,100,0,20,2,3,73,10,8,22,4,17,'shadow-ball');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_movename" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "move_id" integer NULL REFERENCES "pokemon_v2_move" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_movename VALUES(14,5,2,'Poing Karaté');
+INSERT INTO pokemon_v2_movename VALUES(18,9,2,'Karate Chop');
+INSERT INTO pokemon_v2_movename VALUES(64,5,7,'Poing Feu');
+INSERT INTO pokemon_v2_movename VALUES(68,9,7,'Fire Punch');
+INSERT INTO pokemon_v2_movename VALUES(214,5,22,'Fouet Lianes');
+INSERT INTO pokemon_v2_movename VALUES(218,9,22,'Vine Whip');
+INSERT INTO pokemon_v2_movename VALUES(324,5,33,'Charge');
+INSERT INTO pokemon_v2_movename VALUES(328,9,33,'Tackle');
+INSERT INTO pokemon_v2_movename VALUES(434,5,44,'Morsure');
+INSERT INTO pokemon_v2_movename VALUES(438,9,44,'Bite');
+INSERT INTO pokemon_v2_movename VALUES(514,5,52,'Flammèche');
+INSERT INTO pokemon_v2_movename VALUES(518,9,52,'Ember');
+INSERT INTO pokemon_v2_movename VALUES(524,5,53,'Lance-Flammes');
+INSERT INTO pokemon_v2_movename VALUES(528,9,53,'Flamethrower');
+INSERT INTO pokemon_v2_movename VALUES(744,5,75,'Tranch’Herbe');
+INSERT INTO pokemon_v2_movename VALUES(748,9,75,'Razor Leaf');
+INSERT INTO pokemon_v2_movename VALUES(844,5,85,'Tonnerre');
+INSERT INTO pokemon_v2_movename VALUES(848,9,85,'Thunderbolt');
+INSERT INTO pokemon_v2_movename VALUES(944,5,95,'Hypnose');
+INSERT INTO pokemon_v2_movename VALUES(948,9,95,'Hypnosis');
+INSERT INTO pokemon_v2_movename VALUES(1254,5,126,'Déflagration');
+INSERT INTO pokemon_v2_movename VALUES(1258,9,126,'Fire Blast');
+INSERT INTO pokemon_v2_movename VALUES(2204,5,221,'Feu Sacré');
+INSERT INTO pokemon_v2_movename VALUES(2208,9,221,'Sacred Fire');
+INSERT INTO pokemon_v2_movename VALUES(2464,5,247,'Ball’Ombre');
+INSERT INTO pokemon_v2_movename VALUES(2468,9,247,'Shadow Ball');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_movechange" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "power" integer NULL, "pp" integer NULL, "accuracy" integer NULL, "move_effect_chance" integer NULL, "move_effect_id" integer NULL REFERENCES "pokemon_v2_moveeffect" ("id") DEFERRABLE INITIALLY DEFERRED, "type_id" integer NULL REFERENCES "pokemon_v2_type" ("id") DEFERRABLE INITIALLY DEFERRED, "version_group_id" integer NULL REFERENCES "pokemon_v2_versiongroup" ("id") DEFERRABLE INITIALLY DEFERRED, "move_id" integer NULL REFERENCES "pokemon_v2_move" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_movechange VALUES(1,NULL,NULL,NULL,NULL,NULL,1,3,2);
+INSERT INTO pokemon_v2_movechange VALUES(10,NULL,10,NULL,NULL,NULL,NULL,8,22);
+INSERT INTO pokemon_v2_movechange VALUES(11,35,15,NULL,NULL,NULL,NULL,15,22);
+INSERT INTO pokemon_v2_movechange VALUES(15,35,NULL,95,NULL,NULL,NULL,11,33);
+INSERT INTO pokemon_v2_movechange VALUES(16,50,NULL,NULL,NULL,NULL,NULL,17,33);
+INSERT INTO pokemon_v2_movechange VALUES(22,NULL,NULL,NULL,NULL,NULL,1,3,44);
+INSERT INTO pokemon_v2_movechange VALUES(26,95,NULL,NULL,NULL,NULL,NULL,15,53);
+INSERT INTO pokemon_v2_movechange VALUES(48,95,NULL,NULL,NULL,NULL,NULL,15,85);
+INSERT INTO pokemon_v2_movechange VALUES(57,NULL,NULL,60,NULL,NULL,NULL,8,95);
+INSERT INTO pokemon_v2_movechange VALUES(58,NULL,NULL,70,NULL,NULL,NULL,9,95);
+INSERT INTO pokemon_v2_movechange VALUES(66,NULL,NULL,NULL,NULL,NULL,NULL,3,126);
+INSERT INTO pokemon_v2_movechange VALUES(67,120,NULL,NULL,NULL,NULL,NULL,15,126);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_pokemonmove" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "order" integer NULL, "level" integer NOT NULL, "move_id" integer NULL REFERENCES "pokemon_v2_move" ("id") DEFERRABLE INITIALLY DEFERRED, "pokemon_id" integer NULL REFERENCES "pokemon_v2_pokemon" ("id") DEFERRABLE INITIALLY DEFERRED, "version_group_id" integer NULL REFERENCES "pokemon_v2_versiongroup" ("id") DEFERRABLE INITIALLY DEFERRED, "move_learn_method_id" integer NULL REFERENCES "pokemon_v2_movelearnmethod" ("id") DEFERRABLE INITIALLY DEFERRED);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3,NULL,13,22,1,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4,1,1,33,1,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(12,NULL,27,75,1,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(27,NULL,13,22,1,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(28,1,1,33,1,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(36,NULL,27,75,1,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(51,NULL,10,22,1,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(53,NULL,1,33,1,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(57,NULL,20,75,1,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(91,NULL,10,22,1,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(93,NULL,1,33,1,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(97,NULL,20,75,1,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(129,NULL,10,22,1,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(130,NULL,1,33,1,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(135,NULL,20,75,1,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(168,NULL,10,22,1,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(169,NULL,1,33,1,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(176,NULL,20,75,1,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(218,NULL,10,22,1,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(219,NULL,1,33,1,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(226,NULL,20,75,1,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(261,NULL,9,22,1,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(262,NULL,1,33,1,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(269,NULL,19,75,1,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(315,NULL,9,22,1,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(316,NULL,1,33,1,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(323,NULL,19,75,1,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(375,NULL,9,22,1,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(377,NULL,1,33,1,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(384,NULL,19,75,1,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(440,NULL,9,22,1,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(441,NULL,1,33,1,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(448,NULL,19,75,1,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(494,NULL,10,22,1,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(495,NULL,1,33,1,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(500,NULL,20,75,1,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(524,NULL,10,22,1,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(525,NULL,1,33,1,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(532,NULL,20,75,1,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(561,NULL,9,22,1,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(562,NULL,1,33,1,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(569,NULL,19,75,1,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(623,NULL,9,22,1,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(624,NULL,1,33,1,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(631,NULL,19,75,1,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(683,NULL,9,22,1,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(684,NULL,1,33,1,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(691,NULL,19,75,1,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(748,NULL,7,22,1,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(749,NULL,9,22,1,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(750,NULL,1,33,1,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(755,NULL,19,75,1,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(805,NULL,9,22,1,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(806,NULL,1,33,1,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(812,NULL,19,75,1,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(866,NULL,5,22,1,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(868,1,1,33,1,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(875,NULL,23,75,1,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(889,NULL,3,22,1,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(890,1,1,33,1,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(897,NULL,12,75,1,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(945,NULL,13,22,2,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(946,1,1,33,2,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(955,NULL,30,75,2,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(970,NULL,13,22,2,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(971,1,1,33,2,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(980,NULL,30,75,2,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(994,NULL,10,22,2,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(996,1,1,33,2,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1002,NULL,22,75,2,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1030,NULL,10,22,2,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1032,1,1,33,2,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1038,NULL,22,75,2,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1066,NULL,10,22,2,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1067,1,1,33,2,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1074,NULL,22,75,2,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1099,NULL,10,22,2,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1100,1,1,33,2,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1109,NULL,22,75,2,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1143,NULL,10,22,2,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1144,1,1,33,2,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1153,NULL,22,75,2,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1180,NULL,9,22,2,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1181,1,1,33,2,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1190,NULL,20,75,2,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1224,NULL,9,22,2,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1225,1,1,33,2,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1234,NULL,20,75,2,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1274,NULL,9,22,2,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1276,1,1,33,2,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1285,NULL,20,75,2,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1327,NULL,9,22,2,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1328,1,1,33,2,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1337,NULL,20,75,2,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1369,NULL,10,22,2,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1370,1,1,33,2,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1377,NULL,22,75,2,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1401,NULL,10,22,2,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1402,1,1,33,2,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1411,NULL,22,75,2,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1440,NULL,9,22,2,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1441,1,1,33,2,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1450,NULL,20,75,2,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1490,NULL,9,22,2,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1491,1,1,33,2,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1500,NULL,20,75,2,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1537,NULL,9,22,2,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1538,1,1,33,2,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1547,NULL,20,75,2,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1589,NULL,9,22,2,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1590,1,1,33,2,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1598,NULL,20,75,2,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1633,NULL,9,22,2,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1634,1,1,33,2,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1642,NULL,20,75,2,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1681,3,1,22,2,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1682,NULL,5,22,2,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1684,1,1,33,2,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1692,NULL,31,75,2,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1707,3,1,22,2,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1708,1,1,33,2,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1715,NULL,12,75,2,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1757,4,1,22,3,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1758,NULL,13,22,3,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1759,1,1,33,3,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1769,NULL,30,75,3,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1784,4,1,22,3,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1785,NULL,13,22,3,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1786,1,1,33,3,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1796,NULL,30,75,3,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1810,4,1,22,3,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1811,NULL,10,22,3,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1813,1,1,33,3,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1821,NULL,22,75,3,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1849,4,1,22,3,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1850,NULL,10,22,3,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1852,1,1,33,3,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1860,NULL,22,75,3,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1888,4,1,22,3,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1889,NULL,10,22,3,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1890,1,1,33,3,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1899,NULL,22,75,3,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1925,4,1,22,3,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1926,NULL,10,22,3,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1927,1,1,33,3,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1938,NULL,22,75,3,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1973,4,1,22,3,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1974,NULL,10,22,3,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1975,1,1,33,3,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(1986,NULL,22,75,3,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2015,4,1,22,3,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2016,NULL,9,22,3,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2017,1,1,33,3,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2028,NULL,20,75,3,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2067,4,1,22,3,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2068,NULL,9,22,3,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2069,1,1,33,3,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2080,NULL,20,75,3,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2126,4,1,22,3,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2127,NULL,9,22,3,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2129,1,1,33,3,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2140,NULL,20,75,3,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2189,4,1,22,3,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2190,NULL,9,22,3,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2191,1,1,33,3,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2202,NULL,20,75,3,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2239,4,1,22,3,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2240,NULL,10,22,3,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2241,1,1,33,3,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2250,NULL,22,75,3,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2275,4,1,22,3,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2276,NULL,10,22,3,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2277,1,1,33,3,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2288,NULL,22,75,3,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2318,4,1,22,3,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2319,NULL,9,22,3,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2320,1,1,33,3,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2331,NULL,20,75,3,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2378,4,1,22,3,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2379,NULL,9,22,3,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2380,1,1,33,3,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2391,NULL,20,75,3,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2434,4,1,22,3,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2435,NULL,9,22,3,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2436,1,1,33,3,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2447,NULL,20,75,3,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2497,5,1,22,3,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2498,NULL,9,22,3,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2499,2,1,33,3,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2509,NULL,20,75,3,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2551,5,1,22,3,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2552,NULL,9,22,3,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2553,2,1,33,3,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2563,NULL,20,75,3,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2612,6,1,22,3,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2613,NULL,5,22,3,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2615,4,1,33,3,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2624,NULL,31,75,3,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2644,5,1,22,3,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2645,3,1,33,3,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2653,NULL,12,75,3,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2714,NULL,9,52,4,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2715,NULL,38,53,4,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2730,NULL,0,126,4,1,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2746,NULL,9,52,4,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2747,NULL,38,53,4,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2762,NULL,0,126,4,2,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2768,NULL,0,7,4,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2772,NULL,0,44,4,3,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2774,NULL,7,52,4,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2775,NULL,31,53,4,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2785,NULL,0,126,4,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2812,NULL,0,7,4,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2816,NULL,0,44,4,4,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2818,NULL,7,52,4,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2819,NULL,31,53,4,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2820,NULL,0,53,4,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2830,NULL,0,126,4,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2860,NULL,0,44,4,5,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2862,NULL,7,52,4,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2863,NULL,31,53,4,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2864,NULL,0,53,4,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2873,NULL,0,126,4,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2899,NULL,0,7,4,6,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2907,NULL,0,44,4,6,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2909,NULL,7,52,4,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2910,NULL,31,53,4,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2911,NULL,0,53,4,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2924,NULL,0,126,4,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2967,NULL,0,44,4,7,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2969,NULL,7,52,4,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2970,NULL,31,53,4,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2971,NULL,0,53,4,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(2982,NULL,0,126,4,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3014,NULL,0,44,4,8,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3016,NULL,7,52,4,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3017,NULL,34,53,4,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3018,NULL,0,53,4,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3026,NULL,0,126,4,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3067,NULL,0,7,4,9,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3073,NULL,0,44,4,9,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3075,NULL,7,52,4,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3076,NULL,34,53,4,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3077,NULL,0,53,4,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3085,NULL,0,126,4,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3131,NULL,0,7,4,10,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3138,NULL,0,44,4,10,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3140,NULL,7,52,4,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3141,NULL,34,53,4,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3142,NULL,0,53,4,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3151,NULL,0,126,4,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3200,NULL,0,44,4,11,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3202,NULL,7,52,4,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3203,NULL,37,53,4,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3204,NULL,0,53,4,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3213,NULL,0,126,4,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3259,NULL,7,52,4,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3260,NULL,31,53,4,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3261,NULL,0,53,4,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3270,NULL,0,126,4,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3294,NULL,7,52,4,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3295,NULL,31,53,4,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3296,NULL,0,53,4,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3307,NULL,0,126,4,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3328,NULL,0,7,4,14,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3333,NULL,0,44,4,14,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3335,NULL,7,52,4,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3336,NULL,37,53,4,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3337,NULL,0,53,4,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3346,NULL,0,126,4,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3397,NULL,0,44,4,15,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3399,NULL,7,52,4,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3400,NULL,37,53,4,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3401,NULL,0,53,4,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3410,NULL,0,126,4,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3457,NULL,0,7,4,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3462,NULL,0,44,4,16,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3464,NULL,7,52,4,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3465,NULL,37,53,4,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3466,NULL,0,53,4,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3475,NULL,0,126,4,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3530,NULL,0,44,4,17,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3532,NULL,7,52,4,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3533,NULL,37,53,4,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3534,NULL,0,53,4,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3541,NULL,0,126,4,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3585,NULL,0,7,4,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3589,NULL,0,44,4,18,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3591,NULL,7,52,4,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3592,NULL,37,53,4,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3593,NULL,0,53,4,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3600,NULL,0,126,4,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3649,NULL,0,7,4,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3654,NULL,13,52,4,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3655,NULL,36,53,4,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3656,NULL,0,53,4,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3664,NULL,0,126,4,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3678,NULL,0,7,4,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3685,NULL,0,44,4,20,2);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3687,NULL,4,52,4,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3688,NULL,24,53,4,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3689,NULL,0,53,4,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3695,NULL,0,126,4,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3752,3,1,52,5,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3753,NULL,9,52,5,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3754,NULL,42,53,5,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3769,NULL,0,126,5,1,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3785,3,1,52,5,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3786,NULL,9,52,5,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3787,NULL,42,53,5,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3802,NULL,0,126,5,2,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3808,NULL,0,7,5,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3813,3,1,52,5,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3814,NULL,7,52,5,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3815,NULL,34,53,5,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3825,NULL,0,126,5,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3847,NULL,0,7,5,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3852,3,1,52,5,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3853,NULL,7,52,5,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3854,NULL,34,53,5,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3855,NULL,0,53,5,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3865,NULL,0,126,5,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3890,3,1,52,5,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3891,NULL,7,52,5,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3892,NULL,34,53,5,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3893,NULL,0,53,5,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3902,NULL,0,126,5,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3922,NULL,0,7,5,6,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3930,3,1,52,5,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3931,NULL,7,52,5,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3932,NULL,34,53,5,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3933,NULL,0,53,5,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3946,NULL,0,126,5,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3983,3,1,52,5,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3984,NULL,7,52,5,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3985,NULL,34,53,5,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3986,NULL,0,53,5,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(3997,NULL,0,126,5,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4023,3,1,52,5,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4024,NULL,7,52,5,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4025,NULL,39,53,5,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4026,NULL,0,53,5,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4034,NULL,0,126,5,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4065,NULL,0,7,5,9,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4071,3,1,52,5,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4072,NULL,7,52,5,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4073,NULL,39,53,5,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4074,NULL,0,53,5,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4082,NULL,0,126,5,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4118,NULL,0,7,5,10,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4125,3,1,52,5,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4126,NULL,7,52,5,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4127,NULL,39,53,5,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4128,NULL,0,53,5,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4136,NULL,0,126,5,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4176,3,1,52,5,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4177,NULL,7,52,5,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4178,NULL,43,53,5,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4179,NULL,0,53,5,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4187,NULL,0,126,5,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4222,3,1,52,5,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4223,NULL,7,52,5,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4224,NULL,34,53,5,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4225,NULL,0,53,5,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4234,NULL,0,126,5,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4258,3,1,52,5,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4259,NULL,7,52,5,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4260,NULL,34,53,5,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4261,NULL,0,53,5,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4272,NULL,0,126,5,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4293,NULL,0,7,5,14,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4299,3,1,52,5,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4300,NULL,7,52,5,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4301,NULL,43,53,5,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4302,NULL,0,53,5,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4310,NULL,0,126,5,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4351,3,1,52,5,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4352,NULL,7,52,5,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4353,NULL,43,53,5,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4354,NULL,0,53,5,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4362,NULL,0,126,5,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4397,NULL,0,7,5,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4403,3,1,52,5,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4404,NULL,7,52,5,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4405,NULL,43,53,5,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4406,NULL,0,53,5,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4414,NULL,0,126,5,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4458,3,1,52,5,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4459,NULL,7,52,5,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4460,NULL,43,53,5,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4461,NULL,0,53,5,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4467,NULL,0,126,5,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4499,NULL,0,7,5,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4504,3,1,52,5,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4505,NULL,7,52,5,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4506,NULL,43,53,5,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4507,NULL,0,53,5,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4513,NULL,0,126,5,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4550,NULL,0,7,5,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4556,4,1,52,5,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4557,NULL,13,52,5,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4558,NULL,46,53,5,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4559,NULL,0,53,5,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4568,NULL,0,126,5,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4582,NULL,0,7,5,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4589,3,1,52,5,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4590,NULL,30,53,5,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4591,NULL,0,53,5,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4596,NULL,0,126,5,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4649,3,1,52,6,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4650,NULL,9,52,6,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4651,NULL,46,53,6,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4669,NULL,0,126,6,1,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4687,3,1,52,6,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4688,NULL,9,52,6,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4689,NULL,46,53,6,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4707,NULL,0,126,6,2,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4713,NULL,0,7,6,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4721,3,1,52,6,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4722,NULL,7,52,6,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4723,NULL,34,53,6,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4736,NULL,0,126,6,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4760,NULL,0,7,6,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4768,3,1,52,6,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4769,NULL,7,52,6,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4770,NULL,34,53,6,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4771,NULL,0,53,6,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4784,NULL,0,126,6,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4814,3,1,52,6,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4815,NULL,7,52,6,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4816,NULL,34,53,6,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4817,NULL,0,53,6,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4829,NULL,0,126,6,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4850,NULL,0,7,6,6,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4861,3,1,52,6,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4862,NULL,7,52,6,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4863,NULL,34,53,6,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4864,NULL,0,53,6,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4880,NULL,0,126,6,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4921,4,1,52,6,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4922,NULL,7,52,6,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4923,NULL,34,53,6,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4924,NULL,0,53,6,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4937,NULL,0,126,6,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4970,6,1,52,6,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4971,NULL,7,52,6,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4972,NULL,42,53,6,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4973,NULL,0,53,6,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(4985,NULL,0,126,6,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5028,NULL,0,7,6,9,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5037,6,1,52,6,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5038,NULL,7,52,6,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5039,NULL,42,53,6,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5040,NULL,0,53,6,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5052,NULL,0,126,6,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5104,NULL,0,7,6,10,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5114,6,1,52,6,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5115,NULL,7,52,6,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5116,NULL,42,53,6,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5117,NULL,0,53,6,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5129,NULL,0,126,6,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5188,6,1,52,6,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5189,NULL,7,52,6,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5190,NULL,47,53,6,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5191,NULL,0,53,6,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5203,NULL,0,126,6,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5252,3,1,52,6,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5253,NULL,7,52,6,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5254,NULL,34,53,6,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5255,NULL,0,53,6,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5267,NULL,0,126,6,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5295,3,1,52,6,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5296,NULL,7,52,6,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5297,NULL,34,53,6,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5298,NULL,0,53,6,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5312,NULL,0,126,6,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5334,NULL,0,7,6,14,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5343,6,1,52,6,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5344,NULL,7,52,6,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5345,NULL,47,53,6,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5346,NULL,0,53,6,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5358,NULL,0,126,6,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5416,8,1,52,6,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5417,NULL,7,52,6,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5418,NULL,47,53,6,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5419,NULL,0,53,6,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5431,NULL,0,126,6,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5481,NULL,0,7,6,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5490,8,1,52,6,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5491,NULL,7,52,6,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5492,NULL,47,53,6,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5493,NULL,0,53,6,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5505,NULL,0,126,6,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5570,9,1,52,6,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5571,NULL,7,52,6,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5572,NULL,47,53,6,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5573,NULL,0,53,6,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5582,NULL,0,126,6,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5630,NULL,0,7,6,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5639,9,1,52,6,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5640,NULL,7,52,6,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5641,NULL,47,53,6,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5642,NULL,0,53,6,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5651,NULL,0,126,6,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5707,NULL,0,7,6,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5716,8,1,52,6,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5717,NULL,13,52,6,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5718,NULL,54,53,6,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5719,NULL,0,53,6,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5732,NULL,0,126,6,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5752,NULL,0,7,6,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5760,6,1,52,6,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5761,NULL,30,53,6,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5762,NULL,0,53,6,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(5770,NULL,0,126,6,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81934,NULL,0,85,94,1,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81938,NULL,29,95,94,1,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81966,NULL,0,85,94,2,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81970,NULL,29,95,94,2,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81987,NULL,0,7,94,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(81996,1,1,95,94,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82027,NULL,0,247,94,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82029,NULL,0,7,94,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82035,NULL,0,85,94,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82039,1,1,95,94,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82070,NULL,0,247,94,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82074,NULL,0,85,94,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82078,1,1,95,94,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82100,NULL,0,247,94,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82112,NULL,0,7,94,6,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82122,NULL,0,85,94,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82126,1,1,95,94,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82159,NULL,0,247,94,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82178,NULL,0,85,94,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82182,1,1,95,94,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82210,NULL,45,247,94,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82211,NULL,0,247,94,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82224,NULL,0,85,94,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82228,1,1,95,94,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82258,NULL,33,247,94,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82259,NULL,0,247,94,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82287,NULL,0,7,94,9,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82292,NULL,0,85,94,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82296,1,1,95,94,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82329,NULL,33,247,94,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82330,NULL,0,247,94,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82363,NULL,0,7,94,10,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82369,NULL,0,85,94,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82373,1,1,95,94,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82407,NULL,33,247,94,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82408,NULL,0,247,94,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82444,NULL,0,85,94,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82448,1,1,95,94,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82475,NULL,33,247,94,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82476,NULL,0,247,94,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82502,NULL,0,85,94,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82506,1,1,95,94,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82528,NULL,0,247,94,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82544,NULL,0,85,94,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82548,1,1,95,94,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82576,NULL,0,247,94,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82587,NULL,0,7,94,14,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82592,NULL,0,85,94,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82596,1,1,95,94,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82629,NULL,33,247,94,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82630,NULL,0,247,94,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82666,NULL,0,85,94,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82670,1,1,95,94,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82698,NULL,33,247,94,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82699,NULL,0,247,94,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82727,NULL,0,7,94,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82732,NULL,0,85,94,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82736,1,1,95,94,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82769,NULL,33,247,94,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82770,NULL,0,247,94,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82810,NULL,0,85,94,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82814,2,1,95,94,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82842,NULL,33,247,94,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82843,NULL,0,247,94,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82870,NULL,0,7,94,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82874,NULL,0,85,94,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82878,2,1,95,94,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82911,NULL,33,247,94,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82912,NULL,0,247,94,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82952,NULL,0,7,94,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82960,NULL,0,85,94,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82965,3,1,95,94,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82980,NULL,30,247,94,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82981,NULL,0,247,94,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(82994,NULL,0,7,94,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(83000,NULL,0,85,94,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(83003,6,1,95,94,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(83030,NULL,48,247,94,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(83031,NULL,0,247,94,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221397,NULL,44,126,250,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221398,NULL,0,126,250,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221420,NULL,1,221,250,3,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221428,NULL,0,247,250,3,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221435,NULL,0,53,250,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221439,NULL,0,85,250,4,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221446,NULL,44,126,250,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221447,NULL,0,126,250,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221469,NULL,1,221,250,4,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221477,NULL,0,247,250,4,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221484,NULL,0,53,250,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221488,NULL,0,85,250,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221497,NULL,44,126,250,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221498,NULL,0,126,250,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221510,NULL,77,221,250,5,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221516,NULL,0,247,250,5,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221530,NULL,0,53,250,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221534,NULL,0,85,250,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221545,NULL,44,126,250,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221546,NULL,0,126,250,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221566,NULL,77,221,250,6,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221573,NULL,0,247,250,6,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221587,NULL,0,53,250,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221591,NULL,0,85,250,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221602,NULL,44,126,250,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221603,NULL,0,126,250,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221617,NULL,77,221,250,7,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221623,NULL,0,247,250,7,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221636,NULL,0,53,250,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221640,NULL,0,85,250,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221650,NULL,29,126,250,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221651,NULL,0,126,250,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221669,NULL,85,221,250,8,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221676,NULL,0,247,250,8,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221700,NULL,0,53,250,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221704,NULL,0,85,250,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221714,NULL,29,126,250,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221715,NULL,0,126,250,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221736,NULL,85,221,250,9,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221745,NULL,0,247,250,9,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221776,NULL,0,53,250,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221780,NULL,0,85,250,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221790,NULL,37,126,250,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221791,NULL,0,126,250,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221812,NULL,43,221,250,10,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221821,NULL,0,247,250,10,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221854,NULL,0,53,250,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221858,NULL,0,85,250,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221868,NULL,37,126,250,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221869,NULL,0,126,250,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221882,NULL,43,221,250,11,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221889,NULL,0,247,250,11,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221916,NULL,0,53,250,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221920,NULL,0,85,250,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221929,NULL,44,126,250,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221930,NULL,0,126,250,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221942,NULL,77,221,250,12,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221948,NULL,0,247,250,12,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221962,NULL,0,53,250,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221966,NULL,0,85,250,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221977,NULL,44,126,250,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221978,NULL,0,126,250,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(221995,NULL,77,221,250,13,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222001,NULL,0,247,250,13,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222014,NULL,0,53,250,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222018,NULL,0,85,250,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222028,NULL,37,126,250,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222029,NULL,0,126,250,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222046,NULL,43,221,250,14,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222053,NULL,0,247,250,14,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222088,NULL,0,53,250,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222092,NULL,0,85,250,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222102,NULL,37,126,250,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222103,NULL,0,126,250,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222118,NULL,43,221,250,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222125,NULL,0,247,250,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222153,NULL,0,53,250,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222157,NULL,0,85,250,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222167,NULL,37,126,250,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222168,NULL,0,126,250,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222186,NULL,43,221,250,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222193,NULL,0,247,250,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222230,NULL,0,53,250,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222233,NULL,0,85,250,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222243,NULL,37,126,250,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222244,NULL,0,126,250,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222258,NULL,43,221,250,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222265,NULL,0,247,250,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222291,NULL,0,53,250,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222294,NULL,0,85,250,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222304,NULL,37,126,250,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222305,NULL,0,126,250,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222322,NULL,43,221,250,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222329,NULL,0,247,250,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222364,NULL,0,53,250,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222368,NULL,0,85,250,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222376,NULL,72,126,250,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222377,NULL,0,126,250,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222391,NULL,54,221,250,20,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(222396,NULL,0,247,250,20,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(501964,8,1,52,10034,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(501965,NULL,7,52,10034,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(501966,NULL,47,53,10034,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(501967,NULL,0,53,10034,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(501979,NULL,0,126,10034,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502029,NULL,0,7,10034,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502038,8,1,52,10034,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502039,NULL,7,52,10034,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502040,NULL,47,53,10034,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502041,NULL,0,53,10034,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502053,NULL,0,126,10034,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502118,9,1,52,10034,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502119,NULL,7,52,10034,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502120,NULL,47,53,10034,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502121,NULL,0,53,10034,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502130,NULL,0,126,10034,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502178,NULL,0,7,10034,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502187,9,1,52,10034,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502188,NULL,7,52,10034,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502189,NULL,47,53,10034,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502190,NULL,0,53,10034,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502199,NULL,0,126,10034,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502255,NULL,0,7,10034,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502264,8,1,52,10034,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502265,NULL,13,52,10034,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502266,NULL,54,53,10034,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502267,NULL,0,53,10034,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502280,NULL,0,126,10034,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502306,8,1,52,10035,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502307,NULL,7,52,10035,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502308,NULL,47,53,10035,15,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502309,NULL,0,53,10035,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502321,NULL,0,126,10035,15,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502371,NULL,0,7,10035,16,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502380,8,1,52,10035,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502381,NULL,7,52,10035,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502382,NULL,47,53,10035,16,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502383,NULL,0,53,10035,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502395,NULL,0,126,10035,16,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502460,9,1,52,10035,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502461,NULL,7,52,10035,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502462,NULL,47,53,10035,17,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502463,NULL,0,53,10035,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502472,NULL,0,126,10035,17,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502520,NULL,0,7,10035,18,3);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502529,9,1,52,10035,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502530,NULL,7,52,10035,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502531,NULL,47,53,10035,18,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502532,NULL,0,53,10035,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502541,NULL,0,126,10035,18,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502597,NULL,0,7,10035,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502606,8,1,52,10035,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502607,NULL,13,52,10035,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502608,NULL,54,53,10035,19,1);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502609,NULL,0,53,10035,19,4);
+INSERT INTO pokemon_v2_pokemonmove VALUES(502622,NULL,0,126,10035,19,4);
+CREATE TABLE IF NOT EXISTS "pokemon_v2_item" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "cost" integer NULL, "fling_power" integer NULL, "item_category_id" integer NULL REFERENCES "pokemon_v2_itemcategory" ("id") DEFERRABLE INITIALLY DEFERRED, "item_fling_effect_id" integer NULL REFERENCES "pokemon_v2_itemflingeffect" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_item VALUES(211,4000,10,12,NULL,'leftovers');
+INSERT INTO pokemon_v2_item VALUES(226,1000,30,19,NULL,'charcoal');
+INSERT INTO pokemon_v2_item VALUES(247,4000,30,12,NULL,'life-orb');
+CREATE TABLE IF NOT EXISTS "pokemon_v2_itemname" ("id" integer NOT NULL PRIMARY KEY AUTOINCREMENT, "item_id" integer NULL REFERENCES "pokemon_v2_item" ("id") DEFERRABLE INITIALLY DEFERRED, "language_id" integer NULL REFERENCES "pokemon_v2_language" ("id") DEFERRABLE INITIALLY DEFERRED, "name" varchar(100) NOT NULL);
+INSERT INTO pokemon_v2_itemname VALUES(2056,211,5,'Restes');
+INSERT INTO pokemon_v2_itemname VALUES(2060,211,9,'Leftovers');
+INSERT INTO pokemon_v2_itemname VALUES(2206,226,5,'Charbon');
+INSERT INTO pokemon_v2_itemname VALUES(2210,226,9,'Charcoal');
+INSERT INTO pokemon_v2_itemname VALUES(2416,247,5,'Orbe Vie');
+INSERT INTO pokemon_v2_itemname VALUES(2420,247,9,'Life Orb');
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_language',13);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_languagename',53);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_generation',8);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_generationname',54);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_versiongroup',24);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_version',39);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_versionname',346);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_movedamageclass',3);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_movedamageclassname',15);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_type',10002);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_typename',193);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_typeefficacy',324);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_typeefficacypast',6);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_stat',8);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_statname',68);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_movelearnmethod',11);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonspecies',250);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonspeciesname',2748);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemon',10035);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemontype',1415);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemontypepast',0);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonform',10135);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonformname',1508);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonstat',5640);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_ability',181);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_abilityname',1808);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonability',2216);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_move',247);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_movename',2468);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_movechange',67);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_pokemonmove',502622);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_item',247);
+INSERT INTO sqlite_sequence VALUES('pokemon_v2_itemname',2420);
+COMMIT;
@@ -0,0 +1,105 @@
+// Package modeltest provides a model backed by a small in-memory copy of the
+// PokeAPI database, so that tests do not depend on a full database on disk.
+package modeltest
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/notjagan/pokedex/pkg/model"
+)
+
+//go:embed fixture.sql
+var fixture string
+
+// DefaultVersion is the version New sets on the models it returns.
+const DefaultVersion = "sword"
+
+var databases atomic.Int64
+
+// New returns a model over a fresh in-memory database seeded with the fixture,
+// with its language set to English and its version to DefaultVersion. The
+// database is discarded when the test finishes.
+func New(tb testing.TB) *model.Model {
+	tb.Helper()
+	ctx := context.Background()
+
+	dsn := fmt.Sprintf("file:fixture%d?mode=memory&cache=shared", databases.Add(1))
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		tb.Fatalf("failed to open fixture database: %v", err)
+	}
+	// The in-memory database lives only as long as a connection to it, so
+	// hold the seeding connection until the model is closed.
+	seed, err := db.Conn(ctx)
+	if err != nil {
+		db.Close()
+		tb.Fatalf("failed to connect to fixture database: %v", err)
+	}
+	_, err = seed.ExecContext(ctx, fixture)
+	if err != nil {
+		seed.Close()
+		db.Close()
+		tb.Fatalf("failed to seed fixture database: %v", err)
+	}
+
+	mdl, err := model.Open(ctx, dsn)
+	if err != nil {
+		seed.Close()
+		db.Close()
+		tb.Fatalf("failed to open model: %v", err)
+	}
+	tb.Cleanup(func() {
+		mdl.Close()
+		seed.Close()
+		db.Close()
+	})
+
+	setDefaults(tb, mdl)
+	return mdl
+}
+
+// BenchDBVar names the environment variable holding the path of a full
+// database for benchmarks to run against instead of the fixture.
+const BenchDBVar = "POKEDEX_BENCH_DB"
+
+// Bench returns a model over the database at the path in BenchDBVar, or over
+// the fixture like New if it is unset, with the same language and version as
+// New.
+func Bench(b *testing.B) *model.Model {
+	b.Helper()
+
+	path := os.Getenv(BenchDBVar)
+	if path == "" {
+		return New(b)
+	}
+
+	mdl, err := model.New(context.Background(), path)
+	if err != nil {
+		b.Fatalf("failed to open model: %v", err)
+	}
+	b.Cleanup(func() { mdl.Close() })
+
+	setDefaults(b, mdl)
+	return mdl
+}
+
+func setDefaults(tb testing.TB, mdl *model.Model) {
+	tb.Helper()
+	ctx := context.Background()
+
+	err := mdl.SetLanguageByLocalizationCode(ctx, "en")
+	if err != nil {
+		tb.Fatalf("failed to set language: %v", err)
+	}
+	err = mdl.SetVersionByName(ctx, DefaultVersion)
+	if err != nil {
+		tb.Fatalf("failed to set version: %v", err)
+	}
+}
@@ -41,33 +41,57 @@ func (move *Move) applyChanges(changes []MoveChange) {
 }
 
 func (move *Move) Type(ctx context.Context) (*Type, error) {
-	if move.typ == nil {
+	return loadLazy(&move.typ, func() (*Type, error) {
 		typ, err := move.model.typeByID(ctx, move.TypeID)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting type: %w", err)
 		}
-		move.typ = typ
-	}
-
-	return move.typ, nil
+		return typ, nil
+	})
 }
 
 func (move *Move) DamageClass(ctx context.Context) (*DamageClass, error) {
-	if move.class == nil {
+	return loadLazy(&move.class, func() (*DamageClass, error) {
 		class, err := move.model.damageClassByID(ctx, move.DamageClassID)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting damage class: %w", err)
 		}
-		move.class = class
-	}
+		return class, nil
+	})
+}
 
-	return move.class, nil
+// AttackingDamageClass is the damage class the move uses in the model version.
+// Before the physical/special split, damaging moves take the class of their
+// type instead of their own.
+func (move *Move) AttackingDamageClass(ctx context.Context) (*DamageClass, error) {
+	return move.model.moveAttackingDamageClass(ctx, move)
 }
 
 func (move *Move) LocalizedName(ctx context.Context) (string, error) {
 	return move.model.localizedMoveName(ctx, move)
 }
 
+// FlavorText returns the in-game description of the move for the model's
+// version group, falling back to the nearest version group with one.
+func (move *Move) FlavorText(ctx context.Context) (string, error) {
+	return move.model.moveFlavorText(ctx, move)
+}
+
+// IntroducedGeneration returns the generation the move first appeared in.
+func (move *Move) IntroducedGeneration(ctx context.Context) (*Generation, error) {
+	return move.model.moveGeneration(ctx, move)
+}
+
+// History returns every change made to the move across all version groups,
+// oldest first, regardless of the model version. Each change includes the
+// stats the move changed to as well as the ones it had before.
+func (move *Move) History(ctx context.Context) ([]MoveChange, error) {
+	return move.model.moveHistory(ctx, move.ID)
+}
+
+// PokemonMove is a move in a pokemon's learnset. Learnset searches select the
+// move alongside the entry and embed it resolved to the model version, so its
+// fields can be used directly; ResolvedMove loads it for entries without one.
 type PokemonMove struct {
 	model *Model
 
@@ -79,14 +103,43 @@ type PokemonMove struct {
 	learnMethod *LearnMethod
 }
 
+// ResolvedMove returns the learned move as of the model version, looking it up
+// by ID if it was not selected with the learnset entry.
+func (pm *PokemonMove) ResolvedMove(ctx context.Context) (*Move, error) {
+	return loadLazy(&pm.Move, func() (*Move, error) {
+		move, err := pm.model.MoveByID(ctx, pm.MoveID, false)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting move for pokemon move: %w", err)
+		}
+		return move, nil
+	})
+}
+
+// LineMove is a move in the combined learnset of a pokemon and its
+// pre-evolutions, along with the stage that learns it earliest.
+type LineMove struct {
+	PokemonMove
+	StageID        int    `db:"stage_id"`
+	StageName      string `db:"stage_name"`
+	StageSpeciesID int    `db:"stage_species_id"`
+}
+
+// Stage returns the pokemon in the evolution line that learns the move.
+func (lm *LineMove) Stage() *Pokemon {
+	return &Pokemon{
+		model:     lm.model,
+		ID:        lm.StageID,
+		Name:      lm.StageName,
+		SpeciesID: lm.StageSpeciesID,
+	}
+}
+
 func (pm *PokemonMove) LearnMethod(ctx context.Context) (*LearnMethod, error) {
-	if pm.learnMethod == nil {
+	return loadLazy(&pm.learnMethod, func() (*LearnMethod, error) {
 		method, err := pm.model.learnMethodByID(ctx, pm.LearnMethodID)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting learn method for pokemon move: %w", err)
 		}
-		pm.learnMethod = method
-	}
-
-	return pm.learnMethod, nil
+		return method, nil
+	})
 }
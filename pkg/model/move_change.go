@@ -1,5 +1,12 @@
 package model
 
+import (
+	"context"
+	"fmt"
+)
+
+// MoveChange holds the stats a move had before its version group changed them.
+// Only the stats that changed are set.
 type MoveChange struct {
 	model *Model
 
@@ -9,4 +16,57 @@ type MoveChange struct {
 	TypeID         *int `db:"type_id"`
 	VersionGroupID int  `db:"version_group_id"`
 	MoveID         int  `db:"move_id"`
+
+	// the stats the move changed to, which are only filled in by Move.History
+	// and are nil for stats that were unset after the change
+	NewPower    *int `db:"-"`
+	NewPP       *int `db:"-"`
+	NewAccuracy *int `db:"-"`
+	NewTypeID   *int `db:"-"`
+
+	vg      *VersionGroup
+	typ     *Type
+	newType *Type
+}
+
+func (change *MoveChange) VersionGroup(ctx context.Context) (*VersionGroup, error) {
+	return loadLazy(&change.vg, func() (*VersionGroup, error) {
+		vg, err := change.model.versionGroupByID(ctx, change.VersionGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting version group for move change: %w", err)
+		}
+		return vg, nil
+	})
+}
+
+// Type returns the type the move had before the change, or nil if the change
+// did not affect its type.
+func (change *MoveChange) Type(ctx context.Context) (*Type, error) {
+	if change.TypeID == nil {
+		return nil, nil
+	}
+
+	return loadLazy(&change.typ, func() (*Type, error) {
+		typ, err := change.model.typeByID(ctx, *change.TypeID)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting type for move change: %w", err)
+		}
+		return typ, nil
+	})
+}
+
+// NewType returns the type the move had after the change, or nil if the change
+// did not affect its type.
+func (change *MoveChange) NewType(ctx context.Context) (*Type, error) {
+	if change.NewTypeID == nil {
+		return nil, nil
+	}
+
+	return loadLazy(&change.newType, func() (*Type, error) {
+		typ, err := change.model.typeByID(ctx, *change.NewTypeID)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting new type for move change: %w", err)
+		}
+		return typ, nil
+	})
 }
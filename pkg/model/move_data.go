@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+type MoveData struct {
+	Name          string `json:"name"`
+	LocalizedName string `json:"localized_name"`
+	Type          string `json:"type"`
+	DamageClass   string `json:"damage_class"`
+	Power         *int   `json:"power"`
+	PP            *int   `json:"pp"`
+	Accuracy      *int   `json:"accuracy"`
+}
+
+func (move *Move) MarshalData(ctx context.Context) (*MoveData, error) {
+	name, err := move.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for move: %w", err)
+	}
+
+	typ, err := move.Type(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting type for move: %w", err)
+	}
+
+	class, err := move.DamageClass(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting damage class for move: %w", err)
+	}
+
+	return &MoveData{
+		Name:          move.Name,
+		LocalizedName: name,
+		Type:          typ.Name,
+		DamageClass:   class.Name,
+		Power:         move.Power,
+		PP:            move.PP,
+		Accuracy:      move.Accuracy,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package model
+
+import "context"
+
+// MoveMeta holds the secondary mechanics of a move. Chances and drain/healing
+// are percentages, and the ailment name is localized.
+type MoveMeta struct {
+	MinHits       *int   `db:"min_hits"`
+	MaxHits       *int   `db:"max_hits"`
+	CritRate      int    `db:"crit_rate"`
+	AilmentChance int    `db:"ailment_chance"`
+	FlinchChance  int    `db:"flinch_chance"`
+	StatChance    int    `db:"stat_chance"`
+	Drain         int    `db:"drain"`
+	Healing       int    `db:"healing"`
+	AilmentID     int    `db:"move_meta_ailment_id"`
+	Ailment       string `db:"ailment"`
+}
+
+// HasAilment reports whether the move can inflict a status condition.
+func (meta *MoveMeta) HasAilment() bool {
+	return meta.AilmentID > 0
+}
+
+// MoveContest holds the contest type and the appeal and jam of the move's
+// contest effect, with the contest type name localized.
+type MoveContest struct {
+	Type   string `db:"type"`
+	Appeal int    `db:"appeal"`
+	Jam    int    `db:"jam"`
+}
+
+func (move *Move) Meta(ctx context.Context) (*MoveMeta, error) {
+	return move.model.moveMeta(ctx, move)
+}
+
+// Flags returns the localized names of the move's attributes, such as whether it
+// makes contact.
+func (move *Move) Flags(ctx context.Context) ([]string, error) {
+	return move.model.moveFlags(ctx, move)
+}
+
+func (move *Move) Contest(ctx context.Context) (*MoveContest, error) {
+	return move.model.moveContest(ctx, move)
+}
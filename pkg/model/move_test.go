@@ -0,0 +1,311 @@
+package model_test
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestAttackingDamageClass(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		version string
+		move    string
+		want    string
+	}{
+		{version: "sword", move: "fire-punch", want: "physical"},
+		{version: "ruby", move: "fire-punch", want: "special"},
+		{version: "diamond", move: "fire-punch", want: "physical"},
+		{version: "sword", move: "shadow-ball", want: "special"},
+		{version: "ruby", move: "shadow-ball", want: "physical"},
+		{version: "red", move: "karate-chop", want: "physical"},
+		{version: "red", move: "hypnosis", want: "status"},
+	}
+	for _, test := range tests {
+		mdl := modeltest.New(t)
+		err := mdl.SetVersionByName(ctx, test.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := mdl.MoveByName(ctx, test.move)
+		if err != nil {
+			t.Fatalf("MoveByName(%q): %v", test.move, err)
+		}
+		class, err := move.AttackingDamageClass(ctx)
+		if err != nil {
+			t.Fatalf("AttackingDamageClass of %q: %v", test.move, err)
+		}
+		if class.Name != test.want {
+			t.Errorf("AttackingDamageClass of %q in %s = %q, want %q", test.move, test.version, class.Name, test.want)
+		}
+	}
+}
+
+func TestLearnableMovesInVersion(t *testing.T) {
+	ctx := context.Background()
+
+	type stats struct {
+		power    int
+		accuracy int
+	}
+	tests := []struct {
+		version string
+		want    map[string]stats
+	}{
+		{version: "black", want: map[string]stats{
+			"flamethrower": {power: 95, accuracy: 100},
+			"fire-blast":   {power: 120, accuracy: 85},
+		}},
+		{version: "sun", want: map[string]stats{
+			"flamethrower": {power: 90, accuracy: 100},
+			"fire-blast":   {power: 110, accuracy: 85},
+		}},
+		{version: "sword", want: map[string]stats{
+			"flamethrower": {power: 90, accuracy: 100},
+			"fire-blast":   {power: 110, accuracy: 85},
+		}},
+	}
+	for _, test := range tests {
+		mdl := modeltest.New(t)
+		err := mdl.SetVersionByName(ctx, test.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pokemon, err := mdl.PokemonByName(ctx, "charmander")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		moves, err := pokemon.LearnableMoves(ctx)
+		if err != nil {
+			t.Fatalf("LearnableMoves in %s: %v", test.version, err)
+		}
+		found := make(map[string]bool)
+		for _, move := range moves {
+			want, ok := test.want[move.Name]
+			if !ok {
+				continue
+			}
+			found[move.Name] = true
+			if *move.Power != want.power || *move.Accuracy != want.accuracy {
+				t.Errorf("%s in %s has power %d and accuracy %d, want %d and %d",
+					move.Name, test.version, *move.Power, *move.Accuracy, want.power, want.accuracy)
+			}
+		}
+		for name := range test.want {
+			if !found[name] {
+				t.Errorf("charmander cannot learn %s in %s", name, test.version)
+			}
+		}
+	}
+}
+
+func TestSearchPokemonMoves(t *testing.T) {
+	ctx := context.Background()
+
+	type entry struct {
+		level int
+		name  string
+		power int
+	}
+	tests := []struct {
+		name     string
+		version  string
+		method   model.LearnMethodName
+		maxLevel *int
+		top      *int
+		limit    int
+		want     []entry
+		hasNext  bool
+	}{
+		{
+			name:    "level up",
+			version: "sword",
+			method:  model.LevelUp,
+			limit:   10,
+			want:    []entry{{level: 4, name: "ember", power: 40}, {level: 24, name: "flamethrower", power: 90}},
+		},
+		{
+			name:    "level up before move changes",
+			version: "black",
+			method:  model.LevelUp,
+			limit:   10,
+			want:    []entry{{level: 7, name: "ember", power: 40}, {level: 37, name: "flamethrower", power: 95}},
+		},
+		{
+			name:     "max level",
+			version:  "sword",
+			method:   model.LevelUp,
+			maxLevel: intPtr(23),
+			limit:    10,
+			want:     []entry{{level: 4, name: "ember", power: 40}},
+		},
+		{
+			name:    "top",
+			version: "sword",
+			method:  model.LevelUp,
+			top:     intPtr(1),
+			limit:   10,
+			want:    []entry{{level: 24, name: "flamethrower", power: 90}},
+		},
+		{
+			name:    "next page",
+			version: "sword",
+			method:  model.LevelUp,
+			limit:   1,
+			want:    []entry{{level: 4, name: "ember", power: 40}},
+			hasNext: true,
+		},
+		{
+			name:    "egg",
+			version: "sword",
+			method:  model.Egg,
+			limit:   10,
+			want:    []entry{{level: 0, name: "bite", power: 60}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetVersionByName(ctx, test.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pokemon, err := mdl.PokemonByName(ctx, "charmander")
+			if err != nil {
+				t.Fatal(err)
+			}
+			methods, err := mdl.LearnMethodsByName(ctx, []model.LearnMethodName{test.method})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pms, hasNext, err := pokemon.SearchPokemonMoves(ctx, methods, test.maxLevel, test.top, test.limit, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasNext != test.hasNext {
+				t.Errorf("SearchPokemonMoves has next page = %t, want %t", hasNext, test.hasNext)
+			}
+
+			got := make([]entry, len(pms))
+			for i := range pms {
+				move, err := pms[i].ResolvedMove(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if move != pms[i].Move {
+					t.Errorf("ResolvedMove of %s looked up the move again", move.Name)
+				}
+				method, err := pms[i].LearnMethod(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if method.Name != string(test.method) {
+					t.Errorf("learn method of %s = %q, want %q", move.Name, method.Name, test.method)
+				}
+				got[i] = entry{level: pms[i].Level, name: move.Name, power: *move.Power}
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SearchPokemonMoves = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestMoveStatsInVersion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		move     string
+		version  string
+		power    *int
+		pp       int
+		accuracy int
+	}{
+		// tackle changed in black-white and again in sun-moon
+		{move: "tackle", version: "platinum", power: intPtr(35), pp: 35, accuracy: 95},
+		{move: "tackle", version: "black", power: intPtr(50), pp: 35, accuracy: 100},
+		{move: "tackle", version: "x", power: intPtr(50), pp: 35, accuracy: 100},
+		{move: "tackle", version: "sun", power: intPtr(40), pp: 35, accuracy: 100},
+		{move: "tackle", version: "sword", power: intPtr(40), pp: 35, accuracy: 100},
+		// xd has a later version group id than black-white but comes before it
+		{move: "tackle", version: "xd", power: intPtr(35), pp: 35, accuracy: 95},
+		// hypnosis changed in diamond-pearl and back in platinum
+		{move: "hypnosis", version: "ruby", power: nil, pp: 20, accuracy: 60},
+		{move: "hypnosis", version: "diamond", power: nil, pp: 20, accuracy: 70},
+		{move: "hypnosis", version: "platinum", power: nil, pp: 20, accuracy: 60},
+		// sacred fire never changed
+		{move: "sacred-fire", version: "gold", power: intPtr(100), pp: 5, accuracy: 95},
+		{move: "sacred-fire", version: "sword", power: intPtr(100), pp: 5, accuracy: 95},
+	}
+	for _, test := range tests {
+		mdl := modeltest.New(t)
+		err := mdl.SetVersionByName(ctx, test.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := mdl.MoveByName(ctx, test.move)
+		if err != nil {
+			t.Fatalf("MoveByName(%q): %v", test.move, err)
+		}
+		if (move.Power == nil) != (test.power == nil) || move.Power != nil && *move.Power != *test.power {
+			t.Errorf("power of %s in %s = %v, want %v", test.move, test.version, formatInt(move.Power), formatInt(test.power))
+		}
+		if *move.PP != test.pp {
+			t.Errorf("PP of %s in %s = %d, want %d", test.move, test.version, *move.PP, test.pp)
+		}
+		if *move.Accuracy != test.accuracy {
+			t.Errorf("accuracy of %s in %s = %d, want %d", test.move, test.version, *move.Accuracy, test.accuracy)
+		}
+	}
+}
+
+func TestMoveByID(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	err := mdl.SetVersionByName(ctx, "red")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// tackle had 35 power and 95% accuracy before black-white
+	move, err := mdl.MoveByID(ctx, 33, true)
+	if err != nil {
+		t.Fatalf("MoveByID(tackle): %v", err)
+	}
+	if *move.Power != 35 || *move.Accuracy != 95 {
+		t.Errorf("tackle in red = %d power, %d accuracy, want 35 power, 95 accuracy", *move.Power, *move.Accuracy)
+	}
+
+	// shadow ball was introduced in gold-silver
+	_, err = mdl.MoveByID(ctx, 247, true)
+	if err == nil {
+		t.Error("MoveByID(shadow-ball) in red with validation succeeded, want error")
+	}
+	move, err = mdl.MoveByID(ctx, 247, false)
+	if err != nil {
+		t.Fatalf("MoveByID(shadow-ball) without validation: %v", err)
+	}
+	if move.Name != "shadow-ball" {
+		t.Errorf("MoveByID(247) = %q, want %q", move.Name, "shadow-ball")
+	}
+}
+
+func formatInt(i *int) string {
+	if i == nil {
+		return "none"
+	}
+	return strconv.Itoa(*i)
+}
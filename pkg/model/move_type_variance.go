@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TypeVariance is what decides the type of a move whose type is not fixed.
+type TypeVariance string
+
+const (
+	// TypeVarianceIVs is Hidden Power, whose type comes from the user's IVs.
+	TypeVarianceIVs     TypeVariance = "ivs"
+	TypeVarianceWeather TypeVariance = "weather"
+	TypeVarianceTerrain TypeVariance = "terrain"
+	// TypeVarianceItem is set by a held plate, memory, drive or berry.
+	TypeVarianceItem TypeVariance = "item"
+	// TypeVarianceUser is set by the user's own type or form.
+	TypeVarianceUser TypeVariance = "user"
+)
+
+var variableTypeMoves = map[string]TypeVariance{
+	"hidden-power":     TypeVarianceIVs,
+	"weather-ball":     TypeVarianceWeather,
+	"terrain-pulse":    TypeVarianceTerrain,
+	"judgment":         TypeVarianceItem,
+	"multi-attack":     TypeVarianceItem,
+	"techno-blast":     TypeVarianceItem,
+	"natural-gift":     TypeVarianceItem,
+	"revelation-dance": TypeVarianceUser,
+	"aura-wheel":       TypeVarianceUser,
+}
+
+var weatherBallTypes = map[Weather]string{
+	WeatherSun:  "fire",
+	WeatherRain: "water",
+	WeatherSand: "rock",
+	WeatherSnow: "ice",
+}
+
+var terrainPulseTypes = map[Terrain]string{
+	TerrainElectric: "electric",
+	TerrainGrassy:   "grass",
+	TerrainPsychic:  "psychic",
+	TerrainMisty:    "fairy",
+}
+
+// TypeVariance reports what decides the move's type, if its type is not fixed.
+func (move *Move) TypeVariance() (TypeVariance, bool) {
+	variance, ok := variableTypeMoves[move.Name]
+	return variance, ok
+}
+
+// HasMeaningfulDefaultType reports whether the move's stored type is one it can
+// actually have in battle. Hidden Power and Natural Gift are stored as normal,
+// which they never are.
+func (move *Move) HasMeaningfulDefaultType() bool {
+	return move.Name != "hidden-power" && move.Name != "natural-gift"
+}
+
+// TypeInWeather is the type of the move in the given weather, which only differs
+// from its stored type for Weather Ball.
+func (move *Move) TypeInWeather(ctx context.Context, weather Weather) (*Type, error) {
+	name, ok := weatherBallTypes[weather]
+	if move.Name != "weather-ball" || !ok {
+		return move.Type(ctx)
+	}
+
+	return move.model.TypeByName(ctx, name)
+}
+
+// TypeOnTerrain is the type of the move on the given terrain, which only differs
+// from its stored type for Terrain Pulse.
+func (move *Move) TypeOnTerrain(ctx context.Context, terrain Terrain) (*Type, error) {
+	name, ok := terrainPulseTypes[terrain]
+	if move.Name != "terrain-pulse" || !ok {
+		return move.Type(ctx)
+	}
+
+	return move.model.TypeByName(ctx, name)
+}
+
+var ErrInvalidVariableType = errors.New("move cannot have the given type")
+
+// ValidateVariableType checks that the move can take on the given type through
+// its user's IVs, its held item or its user.
+func (move *Move) ValidateVariableType(typ *Type) error {
+	variance, ok := move.TypeVariance()
+	if !ok || variance == TypeVarianceWeather || variance == TypeVarianceTerrain {
+		return fmt.Errorf("type of move %q is not set directly: %w", move.Name, ErrInvalidVariableType)
+	}
+
+	// no combination of IVs gives a normal or fairy Hidden Power
+	if variance == TypeVarianceIVs && (typ.Name == "normal" || typ.Name == "fairy") {
+		return fmt.Errorf("hidden power cannot be type %q: %w", typ.Name, ErrInvalidVariableType)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package model
+
+type nameKey struct {
+	resource string
+	id       int
+	language int
+}
+
+// nameCache holds localized names. Names are static for a given language, so
+// entries only expire if the caches are configured with a TTL.
+type nameCache = cache[nameKey, string]
+
+// ClearNameCache drops every cached localized name. It is only intended for
+// tests that swap out the underlying data.
+func (m *Model) ClearNameCache() {
+	m.names.clear()
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var nameReplacer = strings.NewReplacer(
+	"♀", "-f",
+	"♂", "-m",
+	"'", "",
+	"’", "",
+)
+
+// RemoveAccents strips diacritics from a string, e.g. "Flabébé" becomes "Flabebe".
+func RemoveAccents(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	res, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+
+	return res
+}
+
+// NormalizeName converts user input such as "Mr. Mime", "Farfetch'd", "Nidoran♀" or
+// "Type: Null" into the lowercase hyphenated form used by the name columns.
+func NormalizeName(name string) string {
+	name = nameReplacer.Replace(strings.ToLower(RemoveAccents(name)))
+
+	var sb strings.Builder
+	sep := false
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if sep && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(r)
+			sep = false
+		} else {
+			sep = true
+		}
+	}
+
+	return sb.String()
+}
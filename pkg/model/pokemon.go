@@ -15,7 +15,7 @@ type Pokemon struct {
 	SpeciesID int    `db:"pokemon_species_id"`
 
 	sprites   *sprite.PokemonSprites
-	abilities []PokemonAbility
+	abilities *[]PokemonAbility
 	stats     *PokemonStats
 }
 
@@ -23,6 +23,24 @@ func (pokemon *Pokemon) LocalizedName(ctx context.Context) (string, error) {
 	return pokemon.model.localizedPokemonName(ctx, pokemon)
 }
 
+// LocalizedFormName returns the name of the pokemon's form (e.g. "Wash Rotom"),
+// falling back to the species name for forms without one.
+func (pokemon *Pokemon) LocalizedFormName(ctx context.Context) (string, error) {
+	return pokemon.model.localizedPokemonFormName(ctx, pokemon)
+}
+
+// Forms returns every form of the pokemon's species that exists in the model's
+// generation, including the pokemon itself.
+func (pokemon *Pokemon) Forms(ctx context.Context) ([]*Pokemon, error) {
+	return pokemon.model.pokemonForms(ctx, pokemon)
+}
+
+// MegaForms returns the mega evolutions of the pokemon, which only exist in
+// generations 6 and 7.
+func (pokemon *Pokemon) MegaForms(ctx context.Context) ([]*Pokemon, error) {
+	return pokemon.model.pokemonMegaForms(ctx, pokemon)
+}
+
 func (pokemon *Pokemon) SearchPokemonMoves(
 	ctx context.Context,
 	methods []*LearnMethod,
@@ -34,42 +52,104 @@ func (pokemon *Pokemon) SearchPokemonMoves(
 	return pokemon.model.searchPokemonMoves(ctx, pokemon, methods, maxLevel, top, limit, offset)
 }
 
+// SearchLineMoves is like SearchPokemonMoves, but includes moves learned by the
+// pokemon's pre-evolutions. Each move appears once, at the lowest level it can be
+// learned by any stage.
+func (pokemon *Pokemon) SearchLineMoves(
+	ctx context.Context,
+	methods []*LearnMethod,
+	maxLevel *int,
+	limit int,
+	offset int,
+) ([]LineMove, bool, error) {
+	return pokemon.model.searchLineMoves(ctx, pokemon, methods, maxLevel, limit, offset)
+}
+
+// TutorMoves returns the moves the pokemon can learn from move tutors in the
+// model's version group, ordered by name.
+func (pokemon *Pokemon) TutorMoves(ctx context.Context) ([]PokemonMove, error) {
+	return pokemon.model.pokemonTutorMoves(ctx, pokemon)
+}
+
+// LearnableMoves returns every move the pokemon can learn by any method in the
+// model's version group, ordered by name.
+func (pokemon *Pokemon) LearnableMoves(ctx context.Context) ([]*Move, error) {
+	return pokemon.model.pokemonLearnableMoves(ctx, pokemon)
+}
+
 func (pokemon *Pokemon) TypeCombo(ctx context.Context) (*TypeCombo, error) {
 	return pokemon.model.pokemonTypeCombo(ctx, pokemon)
 }
 
 func (pokemon *Pokemon) Sprites(ctx context.Context) (*sprite.PokemonSprites, error) {
-	if pokemon.sprites == nil {
+	return loadLazy(&pokemon.sprites, func() (*sprite.PokemonSprites, error) {
 		sprites, err := pokemon.model.pokemonSprites(ctx, pokemon)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting sprites for pokemon: %w", err)
 		}
-		pokemon.sprites = sprites
-	}
-
-	return pokemon.sprites, nil
+		return sprites, nil
+	})
 }
 
 func (pokemon *Pokemon) Abilities(ctx context.Context) ([]PokemonAbility, error) {
-	if pokemon.abilities == nil {
+	abilities, err := loadLazy(&pokemon.abilities, func() (*[]PokemonAbility, error) {
 		abilities, err := pokemon.model.pokemonAbilities(ctx, pokemon)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting abilities for pokemon: %w", err)
 		}
-		pokemon.abilities = abilities
+		return &abilities, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return pokemon.abilities, nil
+	return *abilities, nil
 }
 
-func (pokemon *Pokemon) BaseStat(ctx context.Context, stat Stat) (int, error) {
-	if pokemon.stats == nil {
+// UnavailableAbilities are the pokemon's current abilities that it could not
+// have in the generation of the model version, because they did not exist yet,
+// were assigned later or are hidden abilities from before Generation V.
+func (pokemon *Pokemon) UnavailableAbilities(ctx context.Context) ([]PokemonAbility, error) {
+	return pokemon.model.pokemonUnavailableAbilities(ctx, pokemon)
+}
+
+func (pokemon *Pokemon) Stats(ctx context.Context) (*PokemonStats, error) {
+	return loadLazy(&pokemon.stats, func() (*PokemonStats, error) {
 		stats, err := pokemon.model.pokemonStats(ctx, pokemon)
 		if err != nil {
-			return 0, fmt.Errorf("could not get stats for pokemon: %w", err)
+			return nil, fmt.Errorf("could not get stats for pokemon: %w", err)
 		}
-		pokemon.stats = stats
+		return stats, nil
+	})
+}
+
+func (pokemon *Pokemon) BaseStat(ctx context.Context, stat Stat) (int, error) {
+	stats, err := pokemon.Stats(ctx)
+	if err != nil {
+		return 0, err
 	}
 
-	return pokemon.stats.baseStat(stat)
+	return stats.baseStat(stat)
+}
+
+func (pokemon *Pokemon) Effort(ctx context.Context, stat Stat) (int, error) {
+	stats, err := pokemon.Stats(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return stats.effort(stat)
+}
+
+func (pokemon *Pokemon) StatRange(ctx context.Context, stat Stat, level int) (*StatRange, error) {
+	stats, err := pokemon.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats.statRange(stat, level)
+}
+
+func (pokemon *Pokemon) StatRank(ctx context.Context, stat Stat) (*StatRank, error) {
+	return pokemon.model.pokemonStatRank(ctx, pokemon, stat)
 }
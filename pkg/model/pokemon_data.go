@@ -0,0 +1,114 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+type PokemonAbilityData struct {
+	Name          string `json:"name"`
+	LocalizedName string `json:"localized_name"`
+	IsHidden      bool   `json:"is_hidden"`
+}
+
+type PokemonStatData struct {
+	Name          string `json:"name"`
+	LocalizedName string `json:"localized_name"`
+	BaseStat      int    `json:"base_stat"`
+	Effort        int    `json:"effort"`
+}
+
+type PokemonData struct {
+	Name          string               `json:"name"`
+	LocalizedName string               `json:"localized_name"`
+	DexNumber     int                  `json:"dex_number"`
+	Version       string               `json:"version"`
+	Generation    string               `json:"generation"`
+	Types         []string             `json:"types"`
+	Abilities     []PokemonAbilityData `json:"abilities"`
+	Stats         []PokemonStatData    `json:"stats"`
+}
+
+func (pokemon *Pokemon) MarshalData(ctx context.Context) (*PokemonData, error) {
+	m := pokemon.model
+	if m.Version == nil {
+		return nil, ErrUnsetVersion
+	}
+
+	name, err := pokemon.LocalizedName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting localized name for pokemon: %w", err)
+	}
+
+	gen, err := m.Version.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting generation for model version: %w", err)
+	}
+
+	data := PokemonData{
+		Name:          pokemon.Name,
+		LocalizedName: name,
+		DexNumber:     pokemon.SpeciesID,
+		Version:       m.Version.Name,
+		Generation:    gen.Name,
+	}
+
+	combo, err := pokemon.TypeCombo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get type combo for pokemon: %w", err)
+	}
+	for _, typ := range combo.Types() {
+		data.Types = append(data.Types, typ.Name)
+	}
+
+	abilities, err := pokemon.Abilities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting abilities for pokemon: %w", err)
+	}
+
+	data.Abilities = make([]PokemonAbilityData, len(abilities))
+	for i, ability := range abilities {
+		name, err := ability.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for ability: %w", err)
+		}
+
+		data.Abilities[i] = PokemonAbilityData{
+			Name:          ability.Name,
+			LocalizedName: name,
+			IsHidden:      ability.IsHidden,
+		}
+	}
+
+	is, err := m.IntrinsicStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting all intrinsic stats: %w", err)
+	}
+
+	data.Stats = make([]PokemonStatData, len(is))
+	for i, stat := range is {
+		bs, err := pokemon.BaseStat(ctx, stat)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting base stat for pokemon: %w", err)
+		}
+
+		effort, err := pokemon.Effort(ctx, stat)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting effort yield for pokemon: %w", err)
+		}
+
+		name, err := stat.LocalizedName(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting localized name for stat: %w", err)
+		}
+
+		data.Stats[i] = PokemonStatData{
+			Name:          stat.Name,
+			LocalizedName: name,
+			BaseStat:      bs,
+			Effort:        effort,
+		}
+	}
+
+	return &data, nil
+}
@@ -0,0 +1,80 @@
+package model_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestPokemonByName(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+		types []string
+	}{
+		{name: "exact", input: "charizard", want: "charizard", types: []string{"fire", "flying"}},
+		{name: "normalized", input: "  Ho Oh ", want: "ho-oh", types: []string{"fire", "flying"}},
+		{name: "lowercase", input: "GENGAR", want: "gengar", types: []string{"ghost", "poison"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pokemon, err := mdl.PokemonByName(ctx, test.input)
+			if err != nil {
+				t.Fatalf("PokemonByName(%q): %v", test.input, err)
+			}
+			if pokemon.Name != test.want {
+				t.Errorf("PokemonByName(%q).Name = %q, want %q", test.input, pokemon.Name, test.want)
+			}
+
+			combo, err := pokemon.TypeCombo(ctx)
+			if err != nil {
+				t.Fatalf("TypeCombo: %v", err)
+			}
+			var types []string
+			for _, typ := range combo.Types() {
+				types = append(types, typ.Name)
+			}
+			if len(types) != len(test.types) {
+				t.Fatalf("types of %s = %v, want %v", pokemon.Name, types, test.types)
+			}
+			for i := range types {
+				if types[i] != test.types[i] {
+					t.Errorf("types of %s = %v, want %v", pokemon.Name, types, test.types)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestPokemonByNameErrors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown", func(t *testing.T) {
+		mdl := modeltest.New(t)
+		_, err := mdl.PokemonByName(ctx, "missingno")
+		if !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("PokemonByName(missingno) error = %v, want %v", err, sql.ErrNoRows)
+		}
+	})
+
+	t.Run("wrong generation", func(t *testing.T) {
+		mdl := modeltest.New(t)
+		err := mdl.SetVersionByName(ctx, "red")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = mdl.PokemonByName(ctx, "ho-oh")
+		if !errors.Is(err, model.ErrWrongGeneration) {
+			t.Errorf("PokemonByName(ho-oh) in red error = %v, want %v", err, model.ErrWrongGeneration)
+		}
+	})
+}
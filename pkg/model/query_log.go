@@ -0,0 +1,103 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// maxLoggedArgLength is how much of a string argument is kept when logging a
+// slow query.
+const maxLoggedArgLength = 32
+
+// loggedDB logs queries that take longer than a threshold, along with their
+// arguments. A threshold of zero disables logging.
+type loggedDB struct {
+	*sqlx.DB
+
+	threshold atomic.Int64
+	recorder  atomic.Pointer[queryRecorder]
+}
+
+func (db *loggedDB) logQuery(start time.Time, query string, args []any) {
+	if rec := db.recorder.Load(); rec != nil {
+		rec.record(query, args)
+	}
+
+	threshold := time.Duration(db.threshold.Load())
+	elapsed := time.Since(start)
+	if threshold <= 0 || elapsed < threshold {
+		return
+	}
+
+	log.Printf("slow query (%v): %s %s", elapsed, strings.Join(strings.Fields(query), " "), sanitizeArgs(args))
+}
+
+// sanitizeArgs formats query arguments for logging, truncating long strings so
+// user input cannot flood the log.
+func sanitizeArgs(args []any) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			if runes := []rune(v); len(runes) > maxLoggedArgLength {
+				v = string(runes[:maxLoggedArgLength]) + "…"
+			}
+			formatted[i] = fmt.Sprintf("%q", v)
+		case []byte:
+			formatted[i] = fmt.Sprintf("<%d bytes>", len(v))
+		default:
+			formatted[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(formatted, ", "))
+}
+
+func (db *loggedDB) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	defer db.logQuery(time.Now(), query, args)
+	return db.DB.SelectContext(ctx, dest, query, args...)
+}
+
+// QueryRowxContext times the query through to its scan, since sqlite only runs
+// the query once the row is read.
+func (db *loggedDB) QueryRowxContext(ctx context.Context, query string, args ...any) *loggedRow {
+	start := time.Now()
+	return &loggedRow{
+		Row:   db.DB.QueryRowxContext(ctx, query, args...),
+		db:    db,
+		start: start,
+		query: query,
+		args:  args,
+	}
+}
+
+type loggedRow struct {
+	*sqlx.Row
+
+	db    *loggedDB
+	start time.Time
+	query string
+	args  []any
+}
+
+func (row *loggedRow) Scan(dest ...any) error {
+	defer row.db.logQuery(row.start, row.query, row.args)
+	return row.Row.Scan(dest...)
+}
+
+func (row *loggedRow) StructScan(dest any) error {
+	defer row.db.logQuery(row.start, row.query, row.args)
+	return row.Row.StructScan(dest)
+}
+
+// LogSlowQueries logs every query on the model and its forks that takes at
+// least the given threshold. A threshold of zero disables logging.
+func (m *Model) LogSlowQueries(threshold time.Duration) {
+	m.db.threshold.Store(int64(threshold))
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// queryRecorder collects the distinct queries run on a database, keeping the
+// arguments of the first run of each.
+type queryRecorder struct {
+	mu      sync.Mutex
+	queries []recordedQuery
+	seen    map[string]bool
+}
+
+type recordedQuery struct {
+	query string
+	args  []any
+}
+
+func (rec *queryRecorder) record(query string, args []any) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if rec.seen[query] {
+		return
+	}
+	rec.seen[query] = true
+	rec.queries = append(rec.queries, recordedQuery{query: query, args: args})
+}
+
+// QueryPlan is the plan sqlite chose for a query, as reported by EXPLAIN QUERY
+// PLAN.
+type QueryPlan struct {
+	Query string
+	Steps []string
+
+	// FullScans lists the steps that read every row of a table, whether
+	// directly or by walking one of its indexes.
+	FullScans []string
+}
+
+type queryPlanStep struct {
+	ID      int    `db:"id"`
+	Parent  int    `db:"parent"`
+	NotUsed int    `db:"notused"`
+	Detail  string `db:"detail"`
+}
+
+var ErrRecordingQueries = errors.New("queries are already being recorded")
+
+// ExplainQueries runs the workload while recording every distinct query it
+// issues, then returns the plan of each query in the order they first ran. The
+// workload should run on the model or a fork of it, and no other work should
+// use the model meanwhile.
+func (m *Model) ExplainQueries(ctx context.Context, workload func(context.Context) error) ([]QueryPlan, error) {
+	rec := &queryRecorder{seen: make(map[string]bool)}
+	if !m.db.recorder.CompareAndSwap(nil, rec) {
+		return nil, ErrRecordingQueries
+	}
+	err := workload(ctx)
+	m.db.recorder.Store(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while running query workload: %w", err)
+	}
+
+	plans := make([]QueryPlan, len(rec.queries))
+	for i, q := range rec.queries {
+		var steps []queryPlanStep
+		err := m.db.DB.SelectContext(ctx, &steps, "EXPLAIN QUERY PLAN "+q.query, q.args...)
+		if err != nil {
+			return nil, fmt.Errorf("error while explaining query: %w", err)
+		}
+
+		plans[i].Query = strings.Join(strings.Fields(q.query), " ")
+		subqueries := make(map[string]bool)
+		for _, step := range steps {
+			plans[i].Steps = append(plans[i].Steps, step.Detail)
+
+			fields := strings.Fields(step.Detail)
+			if len(fields) < 2 {
+				continue
+			}
+			switch fields[0] {
+			case "MATERIALIZE", "CO-ROUTINE":
+				subqueries[fields[1]] = true
+			case "SCAN":
+				if isFullScan(fields, subqueries) {
+					plans[i].FullScans = append(plans[i].FullScans, step.Detail)
+				}
+			}
+		}
+	}
+
+	return plans, nil
+}
+
+// isFullScan reports whether a SCAN step reads every row of a table, whether
+// directly or by walking one of its indexes. Scans of subqueries and CTEs are
+// not counted, since their cost is in the steps that build them.
+func isFullScan(fields []string, subqueries map[string]bool) bool {
+	if fields[1] == "TABLE" && len(fields) > 2 {
+		fields = fields[1:]
+	}
+	name := fields[1]
+
+	return name != "CONSTANT" && !strings.HasPrefix(name, "(") && !subqueries[name]
+}
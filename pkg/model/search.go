@@ -0,0 +1,149 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+type SearchCategory string
+
+const (
+	SearchCategoryPokemon SearchCategory = "pokemon"
+	SearchCategoryMove    SearchCategory = "move"
+	SearchCategoryType    SearchCategory = "type"
+	SearchCategoryItem    SearchCategory = "item"
+)
+
+var AllSearchCategories = []SearchCategory{
+	SearchCategoryPokemon,
+	SearchCategoryMove,
+	SearchCategoryType,
+	SearchCategoryItem,
+}
+
+type PokemonOrder string
+
+const (
+	PokemonOrderName PokemonOrder = "name"
+	PokemonOrderDex  PokemonOrder = "dex"
+)
+
+// species IDs follow national dex order, and break ties between forms sharing a
+// localized name
+var pokemonOrderClauses = map[PokemonOrder]string{
+	PokemonOrderName: "n.name ASC, p.pokemon_species_id ASC",
+	PokemonOrderDex:  "p.pokemon_species_id ASC",
+}
+
+// MoveFilters constrains a move search. Unset filters match every move. Moves
+// that never miss have no accuracy and pass any accuracy filter.
+type MoveFilters struct {
+	Type        *Type
+	DamageClass *DamageClass
+	MinPower    *int
+	MinAccuracy *int
+	Priority    *int
+}
+
+type SearchResult struct {
+	Category SearchCategory
+	Name     string
+	Resource Localizer
+}
+
+func (res *SearchResult) LocalizedName(ctx context.Context) (string, error) {
+	return res.Resource.LocalizedName(ctx)
+}
+
+// prefixQuery describes a search over localized names. The query receives the
+// name pattern (with accents removed, to be matched against remove_accents of the
+// name column) and language ID, then the generation ID if byGeneration is set,
+// and finally the result limit.
+type prefixQuery struct {
+	resource     string
+	query        string
+	byGeneration bool
+}
+
+func prefixSearch[T any](
+	ctx context.Context,
+	m *Model,
+	q prefixQuery,
+	prefix string,
+	limit int,
+	bind func(*T),
+) ([]*T, error) {
+	if m.Language == nil {
+		return nil, ErrUnsetLanguage
+	}
+
+	args := []any{fmt.Sprintf("%s%%", RemoveAccents(prefix)), m.Language.ID}
+	if q.byGeneration {
+		if m.Version == nil {
+			return nil, ErrUnsetVersion
+		}
+
+		gen, err := m.Version.Generation(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get generation for model version: %w", err)
+		}
+		args = append(args, gen.ID)
+	}
+	args = append(args, limit)
+
+	var resources []*T
+	err := m.db.SelectContext(ctx, &resources, q.query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting %s with prefix: %w", q.resource, err)
+	}
+
+	for _, res := range resources {
+		bind(res)
+	}
+
+	return resources, nil
+}
+
+func searchResults[T Localizer](category SearchCategory, resources []T, name func(T) string) []SearchResult {
+	results := make([]SearchResult, len(resources))
+	for i, res := range resources {
+		results[i] = SearchResult{
+			Category: category,
+			Name:     name(res),
+			Resource: CacheLocalizer(res),
+		}
+	}
+
+	return results
+}
+
+func (m *Model) searchCategory(ctx context.Context, category SearchCategory, prefix string, limit int) ([]SearchResult, error) {
+	switch category {
+	case SearchCategoryPokemon:
+		ps, err := m.SearchPokemon(ctx, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		return searchResults(category, ps, func(p *Pokemon) string { return p.Name }), nil
+	case SearchCategoryMove:
+		moves, err := m.SearchMoves(ctx, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		return searchResults(category, moves, func(move *Move) string { return move.Name }), nil
+	case SearchCategoryType:
+		types, err := m.SearchTypes(ctx, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		return searchResults(category, types, func(typ *Type) string { return typ.Name }), nil
+	case SearchCategoryItem:
+		items, err := m.SearchItems(ctx, prefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		return searchResults(category, items, func(item *Item) string { return item.Name }), nil
+	default:
+		return nil, fmt.Errorf("unknown search category %q: %w", category, ErrUnknownSearchCategory)
+	}
+}
@@ -0,0 +1,185 @@
+package model
+
+import (
+	"context"
+	"fmt"
+)
+
+// ShinyMethod is a way of encountering shiny pokemon along with its odds, given
+// as Chance in Out.
+type ShinyMethod struct {
+	Name   string
+	Chance int
+	Out    int
+
+	// version groups the method is available in, or every version group of the
+	// generation if empty
+	versionGroups []string
+}
+
+func (sm ShinyMethod) availableIn(vg *VersionGroup) bool {
+	if len(sm.versionGroups) == 0 {
+		return true
+	}
+
+	for _, name := range sm.versionGroups {
+		if name == vg.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	alolaVersionGroups        = []string{"sun-moon", "ultra-sun-ultra-moon"}
+	gen8BreedingVersionGroups = []string{"sword-shield", "the-isle-of-armor", "the-crown-tundra", "brilliant-diamond-and-shining-pearl"}
+	galarVersionGroups        = []string{"sword-shield", "the-isle-of-armor", "the-crown-tundra"}
+	hisuiVersionGroups        = []string{"legends-arceus"}
+)
+
+var shinyMethods = map[int][]ShinyMethod{
+	2: {
+		{Name: "Full Odds", Chance: 1, Out: 8192},
+		{Name: "Breeding with a Shiny Parent", Chance: 1, Out: 64},
+	},
+	3: {
+		{Name: "Full Odds", Chance: 1, Out: 8192},
+	},
+	4: {
+		{Name: "Full Odds", Chance: 1, Out: 8192},
+		{Name: "Masuda Method", Chance: 5, Out: 8192},
+		{
+			Name:          "Poké Radar (Chain of 40)",
+			Chance:        41,
+			Out:           8192,
+			versionGroups: []string{"diamond-pearl", "platinum"},
+		},
+	},
+	5: {
+		{Name: "Full Odds", Chance: 1, Out: 8192},
+		{Name: "Masuda Method", Chance: 6, Out: 8192},
+		{
+			Name:          "Shiny Charm",
+			Chance:        3,
+			Out:           8192,
+			versionGroups: []string{"black-2-white-2"},
+		},
+		{
+			Name:          "Masuda Method with Shiny Charm",
+			Chance:        8,
+			Out:           8192,
+			versionGroups: []string{"black-2-white-2"},
+		},
+	},
+	6: {
+		{Name: "Full Odds", Chance: 1, Out: 4096},
+		{Name: "Masuda Method", Chance: 6, Out: 4096},
+		{Name: "Shiny Charm", Chance: 3, Out: 4096},
+		{Name: "Masuda Method with Shiny Charm", Chance: 8, Out: 4096},
+		{
+			Name:          "Chain Fishing (Chain of 20)",
+			Chance:        41,
+			Out:           4096,
+			versionGroups: []string{"x-y", "omega-ruby-alpha-sapphire"},
+		},
+		{
+			Name:          "Poké Radar (Chain of 40)",
+			Chance:        41,
+			Out:           4096,
+			versionGroups: []string{"x-y"},
+		},
+		{
+			Name:          "Friend Safari",
+			Chance:        5,
+			Out:           4096,
+			versionGroups: []string{"x-y"},
+		},
+	},
+	7: {
+		{Name: "Full Odds", Chance: 1, Out: 4096},
+		{Name: "Masuda Method", Chance: 6, Out: 4096, versionGroups: alolaVersionGroups},
+		{Name: "Shiny Charm", Chance: 3, Out: 4096},
+		{Name: "Masuda Method with Shiny Charm", Chance: 8, Out: 4096, versionGroups: alolaVersionGroups},
+		{
+			Name:          "SOS Chain (Chain of 31+)",
+			Chance:        13,
+			Out:           4096,
+			versionGroups: alolaVersionGroups,
+		},
+		{
+			Name:          "Catch Combo (Combo of 31+) with Shiny Charm and Lure",
+			Chance:        15,
+			Out:           4096,
+			versionGroups: []string{"lets-go-pikachu-lets-go-eevee"},
+		},
+	},
+	8: {
+		{Name: "Full Odds", Chance: 1, Out: 4096},
+		{Name: "Masuda Method", Chance: 6, Out: 4096, versionGroups: gen8BreedingVersionGroups},
+		{Name: "Shiny Charm", Chance: 3, Out: 4096, versionGroups: gen8BreedingVersionGroups},
+		{Name: "Masuda Method with Shiny Charm", Chance: 8, Out: 4096, versionGroups: gen8BreedingVersionGroups},
+		{
+			Name:          "Number Battled (500+)",
+			Chance:        6,
+			Out:           4096,
+			versionGroups: galarVersionGroups,
+		},
+		{
+			Name:          "Dynamax Adventures",
+			Chance:        1,
+			Out:           300,
+			versionGroups: []string{"the-crown-tundra"},
+		},
+		{
+			Name:          "Dynamax Adventures with Shiny Charm",
+			Chance:        1,
+			Out:           100,
+			versionGroups: []string{"the-crown-tundra"},
+		},
+		// Legends: Arceus rolls the 1/4096 check once per bonus: one for research
+		// level 10 (two when perfected), three for the Shiny Charm, 25 in a mass
+		// outbreak and 12 in a massive mass outbreak.
+		{Name: "Research Level 10", Chance: 2, Out: 4096, versionGroups: hisuiVersionGroups},
+		{Name: "Perfect Research", Chance: 3, Out: 4096, versionGroups: hisuiVersionGroups},
+		{Name: "Research Level 10 with Shiny Charm", Chance: 5, Out: 4096, versionGroups: hisuiVersionGroups},
+		{Name: "Perfect Research with Shiny Charm", Chance: 6, Out: 4096, versionGroups: hisuiVersionGroups},
+		{Name: "Mass Outbreak", Chance: 26, Out: 4096, versionGroups: hisuiVersionGroups},
+		{
+			Name:          "Mass Outbreak with Perfect Research and Shiny Charm",
+			Chance:        31,
+			Out:           4096,
+			versionGroups: hisuiVersionGroups,
+		},
+		{Name: "Massive Mass Outbreak", Chance: 13, Out: 4096, versionGroups: hisuiVersionGroups},
+		{
+			Name:          "Massive Mass Outbreak with Perfect Research and Shiny Charm",
+			Chance:        18,
+			Out:           4096,
+			versionGroups: hisuiVersionGroups,
+		},
+	},
+}
+
+// ShinyMethods returns the shiny hunting methods available in the version. The
+// result is empty for generation 1, which had no shiny pokemon.
+func (ver *Version) ShinyMethods(ctx context.Context) ([]ShinyMethod, error) {
+	vg, err := ver.VersionGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gen, err := vg.Generation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting generation for version %q: %w", ver.Name, err)
+	}
+
+	var methods []ShinyMethod
+	for _, sm := range shinyMethods[gen.ID] {
+		if sm.availableIn(vg) {
+			methods = append(methods, sm)
+		}
+	}
+
+	return methods, nil
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestShinyMethods(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		version string
+		has     []string
+		hasNot  []string
+	}{
+		{
+			version: "sword",
+			has:     []string{"Full Odds", "Masuda Method", "Shiny Charm", "Number Battled (500+)"},
+			hasNot:  []string{"Mass Outbreak", "Perfect Research with Shiny Charm"},
+		},
+		{
+			version: "legends-arceus",
+			has:     []string{"Full Odds", "Perfect Research with Shiny Charm", "Mass Outbreak", "Massive Mass Outbreak"},
+			hasNot:  []string{"Masuda Method", "Shiny Charm", "Number Battled (500+)"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetVersionByName(ctx, test.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			methods, err := mdl.Version.ShinyMethods(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			names := make(map[string]bool, len(methods))
+			for _, sm := range methods {
+				names[sm.Name] = true
+			}
+			for _, name := range test.has {
+				if !names[name] {
+					t.Errorf("ShinyMethods() is missing %q", name)
+				}
+			}
+			for _, name := range test.hasNot {
+				if names[name] {
+					t.Errorf("ShinyMethods() has %q, which is not available in %s", name, test.version)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package sprite
 
 import (
+	"bytes"
+	_ "embed"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -9,6 +12,18 @@ import (
 
 type Sprite string
 
+// pokemonPlaceholder is bundled into the binary so it is available even where
+// the media directory is incomplete.
+//
+//go:embed placeholder.png
+var pokemonPlaceholder []byte
+
+// PokemonPlaceholder returns the image shown in place of pokemon without a
+// sprite.
+func PokemonPlaceholder() io.Reader {
+	return bytes.NewReader(pokemonPlaceholder)
+}
+
 func (s *Sprite) Filepath() (string, error) {
 	exe, err := os.Executable()
 	if err != nil {
@@ -6,6 +6,17 @@ import (
 	"fmt"
 )
 
+type StatName string
+
+const (
+	StatNameHP             StatName = "hp"
+	StatNameAttack         StatName = "attack"
+	StatNameDefense        StatName = "defense"
+	StatNameSpecialAttack  StatName = "special-attack"
+	StatNameSpecialDefense StatName = "special-defense"
+	StatNameSpeed          StatName = "speed"
+)
+
 type Stat struct {
 	model *Model
 
@@ -17,15 +28,105 @@ func (stat *Stat) LocalizedName(ctx context.Context) (string, error) {
 	return stat.model.statLocalizedName(ctx, stat)
 }
 
-type PokemonStats map[int]int
+type PokemonStat struct {
+	StatID   int      `db:"stat_id"`
+	StatName StatName `db:"stat_name"`
+	BaseStat int      `db:"base_stat"`
+	Effort   int      `db:"effort"`
+}
+
+type PokemonStats map[int]PokemonStat
 
 var ErrNoStatFound = errors.New("could not find stat")
 
-func (ps PokemonStats) baseStat(stat Stat) (int, error) {
-	baseStat, ok := ps[stat.ID]
+func (ps PokemonStats) stat(stat Stat) (*PokemonStat, error) {
+	s, ok := ps[stat.ID]
 	if !ok {
-		return 0, fmt.Errorf("pokemon has no stat with id %q: %w", stat.ID, ErrNoStatFound)
+		return nil, fmt.Errorf("pokemon has no stat with id %d: %w", stat.ID, ErrNoStatFound)
+	}
+
+	return &s, nil
+}
+
+func (ps PokemonStats) baseStat(stat Stat) (int, error) {
+	s, err := ps.stat(stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.BaseStat, nil
+}
+
+func (ps PokemonStats) effort(stat Stat) (int, error) {
+	s, err := ps.stat(stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.Effort, nil
+}
+
+// maxStatCache holds the highest base stat of each generation by generation ID.
+type maxStatCache = cache[int, int]
+
+// StatRank is the position of a pokemon's base stat among the default forms of
+// every species in a generation, where 1 is the highest.
+type StatRank struct {
+	Rank  int `db:"rank"`
+	Total int `db:"total"`
+}
+
+const (
+	MaxIV = 31
+	MaxEV = 252
+)
+
+// NatureEffect is how a nature affects a stat.
+type NatureEffect int
+
+const (
+	NatureHindering NatureEffect = -1
+	NatureNeutral   NatureEffect = 0
+	NatureBoosting  NatureEffect = 1
+)
+
+// CalculateStat applies the stat formula used since generation 3. Natures do not
+// affect HP, and a base HP of 1 (Shedinja) always gives 1 HP.
+func CalculateStat(name StatName, base int, iv int, ev int, level int, nature NatureEffect) int {
+	value := (2*base + iv + ev/4) * level / 100
+	if name == StatNameHP {
+		if base == 1 {
+			return 1
+		}
+		return value + level + 10
+	}
+
+	value += 5
+	switch nature {
+	case NatureHindering:
+		return value * 90 / 100
+	case NatureBoosting:
+		return value * 110 / 100
+	default:
+		return value
+	}
+}
+
+// StatRange is the lowest and highest value a stat can reach at a level, from 0
+// IVs, 0 EVs and a hindering nature to max IVs, max EVs and a boosting nature.
+type StatRange struct {
+	Min int
+	Max int
+}
+
+func (ps PokemonStats) statRange(stat Stat, level int) (*StatRange, error) {
+	s, err := ps.stat(stat)
+	if err != nil {
+		return nil, err
 	}
 
-	return baseStat, nil
+	return &StatRange{
+		Min: CalculateStat(s.StatName, s.BaseStat, 0, 0, level, NatureHindering),
+		Max: CalculateStat(s.StatName, s.BaseStat, MaxIV, MaxEV, level, NatureBoosting),
+	}, nil
 }
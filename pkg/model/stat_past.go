@@ -0,0 +1,87 @@
+package model
+
+// pastBaseStat records the value a base stat had before the given generation.
+// The database only stores current base stats, so changes are curated here.
+type pastBaseStat struct {
+	GenerationID int
+	Stat         StatName
+	BaseStat     int
+}
+
+var pastBaseStats = map[string][]pastBaseStat{
+	// generation 6
+	"butterfree": {{6, StatNameSpecialAttack, 80}},
+	"beedrill":   {{6, StatNameAttack, 80}},
+	"pidgeot":    {{6, StatNameSpeed, 91}},
+	"pikachu":    {{6, StatNameDefense, 30}, {6, StatNameSpecialDefense, 40}},
+	"raichu":     {{6, StatNameSpeed, 100}},
+	"nidoqueen":  {{6, StatNameAttack, 82}},
+	"nidoking":   {{6, StatNameAttack, 92}},
+	"clefable":   {{6, StatNameSpecialAttack, 85}},
+	"wigglytuff": {{6, StatNameSpecialAttack, 75}},
+	"vileplume":  {{6, StatNameSpecialAttack, 100}},
+	"poliwrath":  {{6, StatNameAttack, 85}},
+	"alakazam":   {{6, StatNameSpecialDefense, 85}},
+	"victreebel": {{6, StatNameSpecialDefense, 60}},
+	"golem":      {{6, StatNameAttack, 110}},
+	"ampharos":   {{6, StatNameDefense, 75}},
+	"bellossom":  {{6, StatNameDefense, 85}},
+	"azumarill":  {{6, StatNameSpecialAttack, 50}},
+	"jumpluff":   {{6, StatNameSpecialDefense, 85}},
+	"beautifly":  {{6, StatNameSpecialAttack, 90}},
+	"exploud":    {{6, StatNameSpecialDefense, 63}},
+	"staraptor":  {{6, StatNameSpecialDefense, 50}},
+	"roserade":   {{6, StatNameDefense, 55}},
+	"stoutland":  {{6, StatNameAttack, 100}},
+	"unfezant":   {{6, StatNameAttack, 105}},
+	"gigalith":   {{6, StatNameSpecialDefense, 70}},
+	"seismitoad": {{6, StatNameAttack, 85}},
+	"leavanny":   {{6, StatNameSpecialDefense, 70}},
+	"scolipede":  {{6, StatNameAttack, 90}},
+	"krookodile": {{6, StatNameDefense, 70}},
+	// generation 7
+	"arbok":      {{7, StatNameAttack, 85}},
+	"dugtrio":    {{7, StatNameAttack, 80}},
+	"farfetchd":  {{7, StatNameAttack, 65}},
+	"dodrio":     {{7, StatNameSpeed, 100}},
+	"electrode":  {{7, StatNameSpeed, 140}},
+	"exeggutor":  {{7, StatNameSpecialDefense, 65}},
+	"noctowl":    {{7, StatNameSpecialAttack, 76}},
+	"ariados":    {{7, StatNameSpecialDefense, 60}},
+	"qwilfish":   {{7, StatNameDefense, 75}},
+	"magcargo":   {{7, StatNameHP, 50}, {7, StatNameSpecialAttack, 80}},
+	"corsola":    {{7, StatNameHP, 55}, {7, StatNameDefense, 85}, {7, StatNameSpecialDefense, 85}},
+	"mantine":    {{7, StatNameHP, 65}},
+	"swellow":    {{7, StatNameSpecialAttack, 50}},
+	"pelipper":   {{7, StatNameSpecialAttack, 85}},
+	"masquerain": {{7, StatNameSpecialAttack, 80}, {7, StatNameSpeed, 60}},
+	"delcatty":   {{7, StatNameSpeed, 70}},
+	"volbeat":    {{7, StatNameDefense, 55}, {7, StatNameSpecialDefense, 75}},
+	"illumise":   {{7, StatNameDefense, 55}, {7, StatNameSpecialDefense, 75}},
+	"lunatone":   {{7, StatNameHP, 70}},
+	"solrock":    {{7, StatNameHP, 70}},
+	"chimecho":   {{7, StatNameHP, 65}, {7, StatNameDefense, 70}, {7, StatNameSpecialDefense, 80}},
+	"woobat":     {{7, StatNameHP, 55}},
+	"crustle":    {{7, StatNameAttack, 95}},
+	"beartic":    {{7, StatNameAttack, 110}},
+	"cryogonal":  {{7, StatNameHP, 70}, {7, StatNameDefense, 30}},
+	// generation 8
+	"aegislash-shield": {{8, StatNameDefense, 150}, {8, StatNameSpecialDefense, 150}},
+	"aegislash-blade":  {{8, StatNameAttack, 150}, {8, StatNameSpecialAttack, 150}},
+}
+
+// pastBaseStatIn returns the base stat a pokemon had in the generation, if it
+// has changed since then.
+func pastBaseStatIn(name string, stat StatName, gen *Generation) (int, bool) {
+	var (
+		base int
+		ok   bool
+	)
+	for _, past := range pastBaseStats[name] {
+		if past.Stat == stat && gen.ID < past.GenerationID {
+			base, ok = past.BaseStat, true
+		}
+	}
+
+	return base, ok
+}
@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestIntrinsicStats(t *testing.T) {
+	ctx := context.Background()
+
+	wantNames := []model.StatName{
+		model.StatNameHP,
+		model.StatNameAttack,
+		model.StatNameDefense,
+		model.StatNameSpecialAttack,
+		model.StatNameSpecialDefense,
+		model.StatNameSpeed,
+	}
+	english := []string{"HP", "Attack", "Defense", "Special Attack", "Special Defense", "Speed"}
+	tests := []struct {
+		language model.LocalizationCode
+		want     []string
+	}{
+		{language: "en", want: english},
+		{language: "fr", want: []string{"PV", "Attaque", "Défense", "Attaque Spéciale", "Défense Spéciale", "Vitesse"}},
+		// languages without stat names fall back to english
+		{language: "de", want: english},
+		{language: "cs", want: english},
+	}
+	for _, test := range tests {
+		t.Run(string(test.language), func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetLanguageByLocalizationCode(ctx, test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			stats, err := mdl.IntrinsicStats(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stats) != len(wantNames) {
+				t.Fatalf("IntrinsicStats returned %d stats, want %d", len(stats), len(wantNames))
+			}
+
+			for i, stat := range stats {
+				if stat.Name != string(wantNames[i]) {
+					t.Errorf("stat %d = %q, want %q", i, stat.Name, wantNames[i])
+				}
+				name, err := stat.LocalizedName(ctx)
+				if err != nil {
+					t.Fatalf("LocalizedName of %q: %v", stat.Name, err)
+				}
+				if name != test.want[i] {
+					t.Errorf("LocalizedName of %q = %q, want %q", stat.Name, name, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStatRank(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		version string
+		pokemon string
+		want    model.StatRank
+	}{
+		// butterfree had 80 special attack before generation 6, tying ivysaur
+		{version: "red", pokemon: "ivysaur", want: model.StatRank{Rank: 4, Total: 8}},
+		{version: "red", pokemon: "butterfree", want: model.StatRank{Rank: 4, Total: 8}},
+		{version: "sword", pokemon: "ivysaur", want: model.StatRank{Rank: 6, Total: 9}},
+		{version: "sword", pokemon: "butterfree", want: model.StatRank{Rank: 5, Total: 9}},
+		// forms are ranked in place of their species
+		{version: "sword", pokemon: "charizard-mega-y", want: model.StatRank{Rank: 1, Total: 9}},
+	}
+	for _, test := range tests {
+		t.Run(test.version+"/"+test.pokemon, func(t *testing.T) {
+			mdl := modeltest.New(t)
+			err := mdl.SetVersionByName(ctx, test.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stat, err := mdl.StatByName(ctx, model.StatNameSpecialAttack)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pokemon, err := mdl.PokemonByName(ctx, test.pokemon)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rank, err := pokemon.StatRank(ctx, *stat)
+			if err != nil {
+				t.Fatalf("StatRank: %v", err)
+			}
+			if *rank != test.want {
+				t.Errorf("StatRank = %+v, want %+v", *rank, test.want)
+			}
+		})
+	}
+}
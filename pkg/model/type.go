@@ -14,21 +14,89 @@ func (typ *Type) LocalizedName(ctx context.Context) (string, error) {
 	return typ.model.localizedTypeName(ctx, typ)
 }
 
+// Generation is the generation the type was introduced in.
+func (typ *Type) Generation(ctx context.Context) (*Generation, error) {
+	return typ.model.GenerationByID(ctx, typ.GenerationID)
+}
+
+// MatchupChanges lists the matchups of the type, attacking and then defending,
+// that differ between the model's generation and the other generation. Types
+// missing from either generation are left out.
+func (typ *Type) MatchupChanges(ctx context.Context, other *Generation) ([]MatchupChange, error) {
+	return typ.model.typeMatchupChanges(ctx, typ, other)
+}
+
 func (typ *Type) IsUnknown() bool {
 	return typ.Name == "unknown"
 }
 
+// IsPseudo reports whether the type is one of the pseudo-types, "unknown" (the ???
+// type) or "shadow", which only appear on a few moves and have no efficacies.
+func (typ *Type) IsPseudo() bool {
+	return typ.IsUnknown() || typ.Name == "shadow"
+}
+
 type TypeCombo struct {
 	model *Model
 
 	Type1 *Type
 	Type2 *Type
+	// Type3 is a third type, either hypothetical for "what if" analysis or from a
+	// custom dataset with a third type slot. No canonical pokemon has three types,
+	// so efficacies for combos with it set are non-canonical.
+	Type3 *Type
+}
+
+// Types lists the distinct types in the combo in slot order.
+func (combo *TypeCombo) Types() []*Type {
+	types := make([]*Type, 0, 3)
+	for _, typ := range []*Type{combo.Type1, combo.Type2, combo.Type3} {
+		if typ == nil {
+			continue
+		}
+
+		duplicate := false
+		for _, t := range types {
+			duplicate = duplicate || t.ID == typ.ID
+		}
+		if !duplicate {
+			types = append(types, typ)
+		}
+	}
+
+	return types
 }
 
 func (m *Model) NewTypeCombo() *TypeCombo {
 	return &TypeCombo{model: m}
 }
 
+// IsMonoType reports whether the combo has a single distinct type, including
+// combos where both slots hold the same type.
+func (combo *TypeCombo) IsMonoType() bool {
+	return (combo.Type2 == nil || combo.Type2.ID == combo.Type1.ID) &&
+		(combo.Type3 == nil || combo.Type3.ID == combo.Type1.ID)
+}
+
+// IsHypothetical reports whether the combo has a third type on top of its
+// first two.
+func (combo *TypeCombo) IsHypothetical() bool {
+	return combo.Type2 != nil && combo.Type3 != nil
+}
+
+// AddHypotheticalType resolves the named type and sets it in the combo's first
+// empty slot, so that it is only a third type when the combo already has two.
+// Naming a type already in the combo leaves it unchanged.
+func (combo *TypeCombo) AddHypotheticalType(ctx context.Context, name string) error {
+	return combo.model.addHypotheticalType(ctx, combo, name)
+}
+
+// TypeComboByNames resolves a combo from type names. A second type that
+// resolves to the first is kept, and the combo is treated as mono-type.
+func (m *Model) TypeComboByNames(ctx context.Context, name1 string, name2 *string) (*TypeCombo, error) {
+	return m.typeComboByNames(ctx, name1, name2)
+}
+
 func (combo *TypeCombo) DefendingEfficacies(ctx context.Context) ([]TypeEfficacy, error) {
 	return combo.model.defendingTypeEfficacies(ctx, combo)
 }
@@ -36,3 +104,17 @@ func (combo *TypeCombo) DefendingEfficacies(ctx context.Context) ([]TypeEfficacy
 func (typ *Type) AttackingEfficacies(ctx context.Context) ([]TypeEfficacy, error) {
 	return typ.model.attackingTypeEfficacies(ctx, typ)
 }
+
+func (m *Model) BestAttackingEfficacies(ctx context.Context, types []*Type) ([]TypeEfficacy, error) {
+	return m.bestAttackingTypeEfficacies(ctx, types)
+}
+
+// DexCoverage reports how effective the type is against the fully evolved
+// pokemon of the model's generation.
+func (typ *Type) DexCoverage(ctx context.Context) (*DexCoverage, error) {
+	return typ.model.dexCoverage(ctx, typ)
+}
+
+func (m *Model) CoverageGaps(ctx context.Context, attacking []*Type) ([]*TypeCombo, error) {
+	return m.coverageGaps(ctx, attacking)
+}
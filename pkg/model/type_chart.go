@@ -0,0 +1,27 @@
+package model
+
+// typeChart maps an attacking type ID and a defending type ID to a damage factor.
+// Pairs missing from the chart are neutral.
+type typeChart map[int]map[int]int
+
+func (chart typeChart) factor(attacking int, defending int) int {
+	if factor, ok := chart[attacking][defending]; ok {
+		return factor
+	}
+
+	return int(NormalEffective)
+}
+
+// typeChartCache holds the type chart of each generation by generation ID, which
+// is fixed for a given database.
+type typeChartCache = cache[int, typeChart]
+
+// DexCoverage counts the fully evolved pokemon in a generation by how effective
+// an attacking type is against them.
+type DexCoverage struct {
+	Total          int
+	SuperEffective int
+	Neutral        int
+	Resisted       int
+	Immune         int
+}
@@ -2,17 +2,21 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 )
 
 type EfficacyLevel int
 
 const (
+	TripleSuperEffective   EfficacyLevel = 800
 	DoubleSuperEffective   EfficacyLevel = 400
 	SuperEffective         EfficacyLevel = 200
 	NormalEffective        EfficacyLevel = 100
 	NotVeryEffective       EfficacyLevel = 50
 	DoubleNotVeryEffective EfficacyLevel = 25
+	TripleNotVeryEffective EfficacyLevel = 12
 	Immune                 EfficacyLevel = 0
 )
 
@@ -30,13 +34,146 @@ func (te *TypeEfficacy) EfficacyLevel() EfficacyLevel {
 }
 
 func (te *TypeEfficacy) OpposingType(ctx context.Context) (*Type, error) {
-	if te.opposingType == nil {
+	return loadLazy(&te.opposingType, func() (*Type, error) {
 		typ, err := te.model.typeByID(ctx, te.OpposingTypeID)
 		if err != nil {
 			return nil, fmt.Errorf("could not get type for type efficacy: %w", err)
 		}
-		te.opposingType = typ
+		return typ, nil
+	})
+}
+
+// EfficacyBuckets groups the opposing types of a set of type efficacies by how
+// effective they are, keeping the order the efficacies were given in.
+type EfficacyBuckets struct {
+	TripleStrong []*Type
+	DoubleStrong []*Type
+	Strong       []*Type
+	Neutral      []*Type
+	Weak         []*Type
+	DoubleWeak   []*Type
+	TripleWeak   []*Type
+	Immune       []*Type
+}
+
+// SuperEffective counts the types in every super effective bucket.
+func (buckets *EfficacyBuckets) SuperEffective() int {
+	return len(buckets.TripleStrong) + len(buckets.DoubleStrong) + len(buckets.Strong)
+}
+
+// NotVeryEffective counts the types in every not very effective bucket, not
+// including immunities.
+func (buckets *EfficacyBuckets) NotVeryEffective() int {
+	return len(buckets.Weak) + len(buckets.DoubleWeak) + len(buckets.TripleWeak)
+}
+
+var ErrUnknownEfficacyLevel = errors.New("unknown efficacy level")
+
+// efficacyLevels lists the efficacy levels other than immunity, from most to
+// least effective.
+var efficacyLevels = []EfficacyLevel{
+	TripleSuperEffective,
+	DoubleSuperEffective,
+	SuperEffective,
+	NormalEffective,
+	NotVeryEffective,
+	DoubleNotVeryEffective,
+	TripleNotVeryEffective,
+}
+
+// nearestEfficacyLevel finds the efficacy level closest to a damage factor by
+// ratio. Abilities and hypothetical types can give factors between the levels,
+// such as 6 for a triple resistance halved again by Thick Fat. Only a factor
+// of 0 is an immunity.
+func nearestEfficacyLevel(factor int) (EfficacyLevel, error) {
+	if factor < 0 {
+		return 0, fmt.Errorf("damage factor %d: %w", factor, ErrUnknownEfficacyLevel)
+	}
+	if factor == 0 {
+		return Immune, nil
+	}
+
+	nearest := efficacyLevels[0]
+	distance := math.Inf(1)
+	for _, level := range efficacyLevels {
+		d := math.Abs(math.Log2(float64(factor) / float64(level)))
+		if d < distance {
+			nearest, distance = level, d
+		}
 	}
 
-	return te.opposingType, nil
+	return nearest, nil
+}
+
+// BucketEfficacies sorts the opposing type of each efficacy into the bucket for
+// the efficacy level nearest its damage factor.
+func BucketEfficacies(ctx context.Context, effs []TypeEfficacy) (*EfficacyBuckets, error) {
+	var buckets EfficacyBuckets
+	for i := range effs {
+		te := &effs[i]
+		typ, err := te.OpposingType(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not bucket type efficacies: %w", err)
+		}
+
+		level, err := nearestEfficacyLevel(te.DamageFactor)
+		if err != nil {
+			return nil, err
+		}
+		switch level {
+		case TripleSuperEffective:
+			buckets.TripleStrong = append(buckets.TripleStrong, typ)
+		case DoubleSuperEffective:
+			buckets.DoubleStrong = append(buckets.DoubleStrong, typ)
+		case SuperEffective:
+			buckets.Strong = append(buckets.Strong, typ)
+		case NormalEffective:
+			buckets.Neutral = append(buckets.Neutral, typ)
+		case NotVeryEffective:
+			buckets.Weak = append(buckets.Weak, typ)
+		case DoubleNotVeryEffective:
+			buckets.DoubleWeak = append(buckets.DoubleWeak, typ)
+		case TripleNotVeryEffective:
+			buckets.TripleWeak = append(buckets.TripleWeak, typ)
+		case Immune:
+			buckets.Immune = append(buckets.Immune, typ)
+		default:
+			return nil, fmt.Errorf("damage factor %d: %w", te.DamageFactor, ErrUnknownEfficacyLevel)
+		}
+	}
+
+	return &buckets, nil
+}
+
+// MatchupChange is a matchup of a type against an opposing type whose damage
+// factor in the model's generation differs from another generation.
+type MatchupChange struct {
+	model *Model
+
+	// Attacking is set when the type attacks the opposing type, rather than
+	// defending against it.
+	Attacking         bool
+	OpposingTypeID    int
+	DamageFactor      int
+	OtherDamageFactor int
+
+	opposingType *Type
+}
+
+func (mc *MatchupChange) EfficacyLevel() EfficacyLevel {
+	return EfficacyLevel(mc.DamageFactor)
+}
+
+func (mc *MatchupChange) OtherEfficacyLevel() EfficacyLevel {
+	return EfficacyLevel(mc.OtherDamageFactor)
+}
+
+func (mc *MatchupChange) OpposingType(ctx context.Context) (*Type, error) {
+	return loadLazy(&mc.opposingType, func() (*Type, error) {
+		typ, err := mc.model.typeByID(ctx, mc.OpposingTypeID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get type for matchup change: %w", err)
+		}
+		return typ, nil
+	})
 }
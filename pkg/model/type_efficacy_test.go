@@ -0,0 +1,80 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestBucketEfficacies(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+	combo, err := mdl.TypeComboByNames(ctx, "fire", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := func(buckets *model.EfficacyBuckets) string {
+		for name, types := range map[string][]*model.Type{
+			"triple strong": buckets.TripleStrong,
+			"double strong": buckets.DoubleStrong,
+			"strong":        buckets.Strong,
+			"neutral":       buckets.Neutral,
+			"weak":          buckets.Weak,
+			"double weak":   buckets.DoubleWeak,
+			"triple weak":   buckets.TripleWeak,
+			"immune":        buckets.Immune,
+		} {
+			if len(types) > 0 {
+				return name
+			}
+		}
+		return ""
+	}
+
+	tests := []struct {
+		factor int
+		want   string
+	}{
+		{factor: 1600, want: "triple strong"},
+		{factor: 800, want: "triple strong"},
+		{factor: 400, want: "double strong"},
+		{factor: 300, want: "double strong"},
+		{factor: 200, want: "strong"},
+		{factor: 100, want: "neutral"},
+		{factor: 50, want: "weak"},
+		{factor: 37, want: "weak"},
+		{factor: 25, want: "double weak"},
+		{factor: 12, want: "triple weak"},
+		// a triple resistance halved again by Thick Fat
+		{factor: 6, want: "triple weak"},
+		{factor: 1, want: "triple weak"},
+		{factor: 0, want: "immune"},
+	}
+	for _, test := range tests {
+		te := effs[0]
+		te.DamageFactor = test.factor
+		buckets, err := model.BucketEfficacies(ctx, []model.TypeEfficacy{te})
+		if err != nil {
+			t.Errorf("BucketEfficacies(%d): %v", test.factor, err)
+			continue
+		}
+		if got := bucket(buckets); got != test.want {
+			t.Errorf("BucketEfficacies(%d) put the type in %q, want %q", test.factor, got, test.want)
+		}
+	}
+
+	te := effs[0]
+	te.DamageFactor = -1
+	_, err = model.BucketEfficacies(ctx, []model.TypeEfficacy{te})
+	if !errors.Is(err, model.ErrUnknownEfficacyLevel) {
+		t.Errorf("BucketEfficacies(-1) = %v, want %v", err, model.ErrUnknownEfficacyLevel)
+	}
+}
@@ -0,0 +1,158 @@
+package model_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func defendingFactors(ctx context.Context, t *testing.T, combo *model.TypeCombo) map[int]int {
+	t.Helper()
+
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factors := make(map[int]int, len(effs))
+	for _, eff := range effs {
+		factors[eff.OpposingTypeID] = eff.DamageFactor
+	}
+	return factors
+}
+
+func TestDefendingEfficaciesRepeatedType(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	fire, err := mdl.TypeByName(ctx, "fire")
+	if err != nil {
+		t.Fatal(err)
+	}
+	water, err := mdl.TypeByName(ctx, "water")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mono := mdl.NewTypeCombo()
+	mono.Type1 = fire
+	want := defendingFactors(ctx, t, mono)
+	if want[fire.ID] != 50 || want[water.ID] != 200 {
+		t.Fatalf("fire takes %d from fire and %d from water, want 50 and 200", want[fire.ID], want[water.ID])
+	}
+
+	repeated := mdl.NewTypeCombo()
+	repeated.Type1 = fire
+	repeated.Type2 = fire
+	named, err := mdl.TypeComboByNames(ctx, "fire", &fire.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, combo := range map[string]*model.TypeCombo{"repeated": repeated, "by names": named} {
+		if !combo.IsMonoType() {
+			t.Errorf("%s fire/fire combo is not mono-type", name)
+		}
+		if types := combo.Types(); len(types) != 1 || types[0].ID != fire.ID {
+			t.Errorf("%s fire/fire combo has types %v, want [fire]", name, types)
+		}
+
+		got := defendingFactors(ctx, t, combo)
+		if len(got) != len(want) {
+			t.Errorf("%s fire/fire combo has %d efficacies, want %d", name, len(got), len(want))
+		}
+		for id, factor := range want {
+			if got[id] != factor {
+				t.Errorf("%s fire/fire combo takes %d from type %d, want %d", name, got[id], id, factor)
+			}
+		}
+	}
+}
+
+func TestDefendingEfficaciesDualType(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	name2 := "flying"
+	combo, err := mdl.TypeComboByNames(ctx, "fire", &name2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if combo.IsMonoType() {
+		t.Fatal("fire/flying combo is mono-type")
+	}
+
+	factors := defendingFactors(ctx, t, combo)
+	for name, want := range map[string]int{"rock": 400, "water": 200, "grass": 25, "ground": 0, "fire": 50} {
+		typ, err := mdl.TypeByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if factors[typ.ID] != want {
+			t.Errorf("fire/flying takes %d from %s, want %d", factors[typ.ID], name, want)
+		}
+	}
+}
+
+func TestAddHypotheticalType(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	fireName, flyingName := "fire", "flying"
+	tests := []struct {
+		name1        string
+		name2        *string
+		name3        string
+		types        []string
+		hypothetical bool
+	}{
+		{name1: "fire", name3: "water", types: []string{"fire", "water"}},
+		{name1: "fire", name2: &fireName, name3: "water", types: []string{"fire", "water"}},
+		{name1: "fire", name2: &flyingName, name3: "fire", types: []string{"fire", "flying"}},
+		{name1: "fire", name2: &flyingName, name3: "water", types: []string{"fire", "flying", "water"}, hypothetical: true},
+	}
+	for _, test := range tests {
+		combo, err := mdl.TypeComboByNames(ctx, test.name1, test.name2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = combo.AddHypotheticalType(ctx, test.name3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var types []string
+		for _, typ := range combo.Types() {
+			types = append(types, typ.Name)
+		}
+		if strings.Join(types, "/") != strings.Join(test.types, "/") {
+			t.Errorf("adding %s to a %s combo gave types %v, want %v", test.name3, test.name1, types, test.types)
+		}
+		if combo.IsHypothetical() != test.hypothetical {
+			t.Errorf("%v is hypothetical: %t, want %t", types, combo.IsHypothetical(), test.hypothetical)
+		}
+	}
+
+	// a third type next to an empty second slot, as from a custom dataset, is
+	// still multiplied in without making the combo hypothetical
+	fire, err := mdl.TypeByName(ctx, "fire")
+	if err != nil {
+		t.Fatal(err)
+	}
+	water, err := mdl.TypeByName(ctx, "water")
+	if err != nil {
+		t.Fatal(err)
+	}
+	combo := mdl.NewTypeCombo()
+	combo.Type1 = fire
+	combo.Type3 = water
+	if combo.IsHypothetical() {
+		t.Error("combo with an empty second slot is hypothetical")
+	}
+	factors := defendingFactors(ctx, t, combo)
+	if factors[fire.ID] != 25 {
+		t.Errorf("fire with a third water type takes %d from fire, want 25", factors[fire.ID])
+	}
+}
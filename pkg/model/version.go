@@ -22,15 +22,13 @@ type Version struct {
 }
 
 func (ver *Version) VersionGroup(ctx context.Context) (*VersionGroup, error) {
-	if ver.vg == nil {
+	return loadLazy(&ver.vg, func() (*VersionGroup, error) {
 		vg, err := ver.model.versionGroupByID(ctx, ver.VersionGroupID)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting version group for version: %w", err)
 		}
-		ver.vg = vg
-	}
-
-	return ver.vg, nil
+		return vg, nil
+	})
 }
 
 func (ver *Version) Generation(ctx context.Context) (*Generation, error) {
@@ -58,3 +56,10 @@ func (ver *Version) HasPokemon(ctx context.Context, pokemon *Pokemon) (bool, err
 func (ver *Version) HasMove(ctx context.Context, move *Move) (bool, error) {
 	return ver.model.versionHasMove(ctx, ver, move)
 }
+
+// HasLearnset reports whether the pokemon learns any moves in the version's
+// group. Pokemon from the version's generation that cannot be caught or used in
+// the version, such as those only available by transfer, have no learnset.
+func (ver *Version) HasLearnset(ctx context.Context, pokemon *Pokemon) (bool, error) {
+	return ver.model.versionHasLearnset(ctx, ver, pokemon)
+}
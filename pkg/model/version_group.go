@@ -11,18 +11,22 @@ type VersionGroup struct {
 	ID           int    `db:"id"`
 	GenerationID int    `db:"generation_id"`
 	Name         string `db:"name"`
+	Order        int    `db:"order"`
 
 	gen *Generation
 }
 
 func (vg *VersionGroup) Generation(ctx context.Context) (*Generation, error) {
-	if vg.gen == nil {
+	return loadLazy(&vg.gen, func() (*Generation, error) {
 		gen, err := vg.model.GenerationByID(ctx, vg.GenerationID)
 		if err != nil {
 			return nil, fmt.Errorf("error while getting generation for version group %q: %w", vg.Name, err)
 		}
-		vg.gen = gen
-	}
+		return gen, nil
+	})
+}
 
-	return vg.gen, nil
+// Versions returns the versions in the version group.
+func (vg *VersionGroup) Versions(ctx context.Context) ([]Version, error) {
+	return vg.model.versionGroupVersions(ctx, vg)
 }
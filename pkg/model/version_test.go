@@ -0,0 +1,63 @@
+package model_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/model/modeltest"
+)
+
+func TestVersionByLocalizedName(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Sword", want: "sword"},
+		{input: "sword", want: "sword"},
+		{input: "Pokémon Sword", want: "sword"},
+		{input: "pokemon   sword", want: "sword"},
+		{input: "Épée", want: "sword"},
+		{input: "epee", want: "sword"},
+		{input: "Omega Ruby", want: "omega-ruby"},
+	}
+	for _, test := range tests {
+		ver, err := mdl.VersionByLocalizedName(ctx, test.input)
+		if err != nil {
+			t.Errorf("VersionByLocalizedName(%q): %v", test.input, err)
+			continue
+		}
+		if ver.Name != test.want {
+			t.Errorf("VersionByLocalizedName(%q) = %q, want %q", test.input, ver.Name, test.want)
+		}
+	}
+}
+
+func TestVersionByLocalizedNameWildcards(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	for _, input := range []string{"%", "_", "Sw_rd", "S%", `\`} {
+		ver, err := mdl.VersionByLocalizedName(ctx, input)
+		if !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("VersionByLocalizedName(%q) = %v, %v, want %v", input, ver, err, sql.ErrNoRows)
+		}
+	}
+}
+
+func TestSetVersionByNameFallsBackToTitle(t *testing.T) {
+	ctx := context.Background()
+	mdl := modeltest.New(t)
+
+	err := mdl.SetVersionByName(ctx, "Pokémon Omega Ruby")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mdl.Version.Name != "omega-ruby" {
+		t.Errorf("version = %q, want %q", mdl.Version.Name, "omega-ruby")
+	}
+}
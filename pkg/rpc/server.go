@@ -0,0 +1,324 @@
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/notjagan/pokedex/pkg/config"
+	"github.com/notjagan/pokedex/pkg/model"
+	"github.com/notjagan/pokedex/pkg/rpc/pokedexpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultVersion     = model.VersionNameSword
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+type Server struct {
+	pokedexpb.UnimplementedPokedexServer
+
+	config config.GRPCConfig
+	model  *model.Model
+	server *grpc.Server
+}
+
+func New(ctx context.Context, cfg config.Config) (*Server, error) {
+	mdl, err := model.New(ctx, cfg.DB.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating model for grpc server: %w", err)
+	}
+	mdl.LogSlowQueries(time.Duration(cfg.DB.SlowQueryThreshold) * time.Millisecond)
+	mdl.ConfigureCaches(model.CacheOptions{
+		Disabled:   cfg.DB.Cache.Disabled,
+		TTL:        time.Duration(cfg.DB.Cache.TTL) * time.Second,
+		MaxEntries: cfg.DB.Cache.MaxEntries,
+	})
+
+	srv := &Server{
+		config: cfg.GRPC,
+		model:  mdl,
+		server: grpc.NewServer(),
+	}
+	pokedexpb.RegisterPokedexServer(srv.server, srv)
+
+	return srv, nil
+}
+
+func (srv *Server) Close() {
+	err := srv.model.Close()
+	if err != nil {
+		log.Printf("error while closing grpc server model: %v", err)
+	}
+}
+
+func (srv *Server) Run(ctx context.Context) error {
+	defer srv.Close()
+
+	lis, err := net.Listen("tcp", srv.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %q: %w", srv.config.Address, err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("Serving gRPC API on %s.", srv.config.Address)
+		errs <- srv.server.Serve(lis)
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("grpc server stopped unexpectedly: %w", err)
+	case <-ctx.Done():
+	}
+
+	srv.server.GracefulStop()
+
+	return nil
+}
+
+func (srv *Server) requestModel(ctx context.Context, settings *pokedexpb.Settings) (*model.Model, error) {
+	mdl := srv.model.Fork()
+
+	code := model.LocalizationCodeEnglish
+	if lang := settings.GetLanguage(); lang != "" {
+		code = model.LocalizationCode(lang)
+	}
+	err := mdl.SetLanguageByLocalizationCode(ctx, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown language %q", code)
+	} else if err != nil {
+		return nil, internalError(err)
+	}
+
+	version := defaultVersion
+	if v := settings.GetVersion(); v != "" {
+		version = v
+	}
+	err = mdl.SetVersionByName(ctx, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown version %q", version)
+	} else if err != nil {
+		return nil, internalError(err)
+	}
+
+	return mdl, nil
+}
+
+func lookupError(resource string, err error) error {
+	switch {
+	case errors.Is(err, model.ErrWrongGeneration):
+		return status.Errorf(codes.NotFound, "%s does not exist in this generation", resource)
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "no %s found with that name", resource)
+	default:
+		return internalError(err)
+	}
+}
+
+func internalError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "request timed out")
+	}
+
+	log.Printf("error while handling grpc request: %v", err)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+
+	i := int32(*v)
+	return &i
+}
+
+func (srv *Server) GetPokemon(ctx context.Context, req *pokedexpb.GetPokemonRequest) (*pokedexpb.Pokemon, error) {
+	mdl, err := srv.requestModel(ctx, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	pokemon, err := mdl.PokemonByName(ctx, req.GetName())
+	if err != nil {
+		return nil, lookupError("pokemon", err)
+	}
+
+	data, err := pokemon.MarshalData(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	resp := &pokedexpb.Pokemon{
+		Name:          data.Name,
+		LocalizedName: data.LocalizedName,
+		DexNumber:     int32(data.DexNumber),
+		Version:       data.Version,
+		Generation:    data.Generation,
+		Types:         data.Types,
+	}
+	for _, ability := range data.Abilities {
+		resp.Abilities = append(resp.Abilities, &pokedexpb.Ability{
+			Name:          ability.Name,
+			LocalizedName: ability.LocalizedName,
+			IsHidden:      ability.IsHidden,
+		})
+	}
+	for _, stat := range data.Stats {
+		resp.Stats = append(resp.Stats, &pokedexpb.Stat{
+			Name:          stat.Name,
+			LocalizedName: stat.LocalizedName,
+			BaseStat:      int32(stat.BaseStat),
+			Effort:        int32(stat.Effort),
+		})
+	}
+
+	return resp, nil
+}
+
+func (srv *Server) GetMove(ctx context.Context, req *pokedexpb.GetMoveRequest) (*pokedexpb.Move, error) {
+	mdl, err := srv.requestModel(ctx, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	move, err := mdl.MoveByName(ctx, req.GetName())
+	if err != nil {
+		return nil, lookupError("move", err)
+	}
+
+	data, err := move.MarshalData(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &pokedexpb.Move{
+		Name:          data.Name,
+		LocalizedName: data.LocalizedName,
+		Type:          data.Type,
+		DamageClass:   data.DamageClass,
+		Power:         optionalInt32(data.Power),
+		Pp:            optionalInt32(data.PP),
+		Accuracy:      optionalInt32(data.Accuracy),
+	}, nil
+}
+
+func efficacies(ctx context.Context, effs []model.TypeEfficacy) (*pokedexpb.Efficacies, error) {
+	resp := &pokedexpb.Efficacies{
+		Efficacies: make([]*pokedexpb.Efficacy, len(effs)),
+	}
+	for i, te := range effs {
+		typ, err := te.OpposingType(ctx)
+		if err != nil {
+			return nil, internalError(err)
+		}
+
+		resp.Efficacies[i] = &pokedexpb.Efficacy{
+			Type:         typ.Name,
+			DamageFactor: int32(te.DamageFactor),
+		}
+	}
+
+	return resp, nil
+}
+
+func (srv *Server) GetDefendingEfficacies(
+	ctx context.Context,
+	req *pokedexpb.GetDefendingEfficaciesRequest,
+) (*pokedexpb.Efficacies, error) {
+	mdl, err := srv.requestModel(ctx, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	var name2 *string
+	if req.GetType_2() != "" {
+		t := req.GetType_2()
+		name2 = &t
+	}
+
+	combo, err := mdl.TypeComboByNames(ctx, req.GetType_1(), name2)
+	if err != nil {
+		return nil, lookupError("type", err)
+	}
+
+	effs, err := combo.DefendingEfficacies(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return efficacies(ctx, effs)
+}
+
+func (srv *Server) GetAttackingEfficacies(
+	ctx context.Context,
+	req *pokedexpb.GetAttackingEfficaciesRequest,
+) (*pokedexpb.Efficacies, error) {
+	mdl, err := srv.requestModel(ctx, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := mdl.TypeByName(ctx, req.GetType())
+	if err != nil {
+		return nil, lookupError("type", err)
+	}
+
+	effs, err := typ.AttackingEfficacies(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return efficacies(ctx, effs)
+}
+
+func (srv *Server) Search(ctx context.Context, req *pokedexpb.SearchRequest) (*pokedexpb.SearchResponse, error) {
+	if req.GetPrefix() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing search prefix")
+	}
+
+	limit := int(req.GetLimitPerCategory())
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit < 1 || limit > maxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxSearchLimit)
+	}
+
+	mdl, err := srv.requestModel(ctx, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := mdl.SearchAll(ctx, req.GetPrefix(), limit)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	resp := &pokedexpb.SearchResponse{
+		Results: make([]*pokedexpb.SearchResult, len(results)),
+	}
+	for i, res := range results {
+		name, err := res.LocalizedName(ctx)
+		if err != nil {
+			return nil, internalError(err)
+		}
+
+		resp.Results[i] = &pokedexpb.SearchResult{
+			Category:      string(res.Category),
+			Name:          res.Name,
+			LocalizedName: name,
+		}
+	}
+
+	return resp, nil
+}
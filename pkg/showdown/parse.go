@@ -0,0 +1,201 @@
+package showdown
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrParse = errors.New("could not parse showdown set")
+
+var statAliases = map[string]Stat{
+	"hp":      StatHP,
+	"atk":     StatAttack,
+	"attack":  StatAttack,
+	"def":     StatDefense,
+	"defense": StatDefense,
+	"spa":     StatSpecialAttack,
+	"satk":    StatSpecialAttack,
+	"spatk":   StatSpecialAttack,
+	"spd":     StatSpecialDefense,
+	"sdef":    StatSpecialDefense,
+	"spdef":   StatSpecialDefense,
+	"spe":     StatSpeed,
+	"speed":   StatSpeed,
+}
+
+func parseStat(s string) (Stat, bool) {
+	key := strings.ToLower(strings.Join(strings.Fields(s), ""))
+	key = strings.ReplaceAll(key, ".", "")
+	stat, ok := statAliases[key]
+	return stat, ok
+}
+
+func parseSpread(s string) (Spread, error) {
+	spread := make(Spread, len(AllStats))
+	for _, part := range strings.Split(s, "/") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		fields := strings.Fields(part)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("malformed stat value %q: %w", part, ErrParse)
+		}
+
+		value, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("malformed stat value %q: %w", part, ErrParse)
+		}
+
+		stat, ok := parseStat(strings.Join(fields[1:], " "))
+		if !ok {
+			return nil, fmt.Errorf("unknown stat in %q: %w", part, ErrParse)
+		}
+		spread[stat] = value
+	}
+
+	return spread, nil
+}
+
+func isGender(s string) bool {
+	return s == "M" || s == "F"
+}
+
+func parseHeader(line string, set *Set) error {
+	name := line
+	if i := strings.LastIndex(line, "@"); i >= 0 {
+		set.Item = strings.TrimSpace(line[i+1:])
+		name = strings.TrimSpace(line[:i])
+	}
+
+	if strings.HasSuffix(name, ")") {
+		if i := strings.LastIndex(name, "("); i >= 0 {
+			inner := strings.TrimSpace(name[i+1 : len(name)-1])
+			if isGender(strings.ToUpper(inner)) {
+				set.Gender = strings.ToUpper(inner)
+				name = strings.TrimSpace(name[:i])
+			}
+		}
+	}
+
+	if strings.HasSuffix(name, ")") {
+		if i := strings.LastIndex(name, "("); i >= 0 {
+			set.Nickname = strings.TrimSpace(name[:i])
+			set.Species = strings.TrimSpace(name[i+1 : len(name)-1])
+		}
+	}
+	if set.Species == "" && set.Nickname == "" {
+		set.Species = name
+	}
+
+	if set.Species == "" {
+		return fmt.Errorf("missing species in %q: %w", line, ErrParse)
+	}
+
+	return nil
+}
+
+func parseLine(line string, set *Set) error {
+	if strings.HasPrefix(line, "-") || strings.HasPrefix(line, "~") {
+		move := strings.TrimSpace(line[1:])
+		if move != "" {
+			set.Moves = append(set.Moves, move)
+		}
+		return nil
+	}
+
+	if key, value, ok := strings.Cut(line, ":"); ok {
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch key {
+		case "ability":
+			set.Ability = value
+		case "level":
+			level, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("malformed level %q: %w", value, ErrParse)
+			}
+			set.Level = level
+		case "shiny":
+			set.Shiny = strings.EqualFold(value, "yes")
+		case "evs":
+			evs, err := parseSpread(value)
+			if err != nil {
+				return fmt.Errorf("error while parsing EVs: %w", err)
+			}
+			set.EVs = evs
+		case "ivs":
+			ivs, err := parseSpread(value)
+			if err != nil {
+				return fmt.Errorf("error while parsing IVs: %w", err)
+			}
+			set.IVs = ivs
+		}
+		return nil
+	}
+
+	if strings.HasSuffix(strings.ToLower(line), "nature") {
+		set.Nature = strings.TrimSpace(line[:len(line)-len("nature")])
+		return nil
+	}
+
+	return nil
+}
+
+func isTeamHeader(line string) bool {
+	return strings.HasPrefix(line, "===") && strings.HasSuffix(line, "===")
+}
+
+func Parse(r io.Reader) ([]Set, error) {
+	var sets []Set
+	var set *Set
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || isTeamHeader(line):
+			if set != nil {
+				sets = append(sets, *set)
+				set = nil
+			}
+		case set == nil:
+			set = &Set{}
+			err := parseHeader(line, set)
+			if err != nil {
+				return nil, fmt.Errorf("error on line %d: %w", n, err)
+			}
+		default:
+			err := parseLine(line, set)
+			if err != nil {
+				return nil, fmt.Errorf("error on line %d: %w", n, err)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error while reading showdown sets: %w", err)
+	}
+
+	if set != nil {
+		sets = append(sets, *set)
+	}
+
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("no sets found: %w", ErrParse)
+	}
+
+	return sets, nil
+}
+
+func ParseSet(s string) (*Set, error) {
+	sets, err := Parse(strings.NewReader(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sets[0], nil
+}
@@ -0,0 +1,190 @@
+package showdown_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/notjagan/pokedex/pkg/showdown"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []showdown.Set
+	}{
+		{
+			name:  "species only",
+			input: "Pikachu",
+			want:  []showdown.Set{{Species: "Pikachu"}},
+		},
+		{
+			name: "full set",
+			input: `Sparky (Pikachu) (F) @ Light Ball
+Ability: Static
+Level: 50
+Shiny: Yes
+EVs: 252 Atk / 4 SpD / 252 Spe
+IVs: 0 HP
+Jolly Nature
+- Volt Tackle
+- Iron Tail
+~ Quick Attack`,
+			want: []showdown.Set{{
+				Nickname: "Sparky",
+				Species:  "Pikachu",
+				Gender:   "F",
+				Item:     "Light Ball",
+				Ability:  "Static",
+				Level:    50,
+				Shiny:    true,
+				EVs: showdown.Spread{
+					showdown.StatAttack:         252,
+					showdown.StatSpecialDefense: 4,
+					showdown.StatSpeed:          252,
+				},
+				IVs:    showdown.Spread{showdown.StatHP: 0},
+				Nature: "Jolly",
+				Moves:  []string{"Volt Tackle", "Iron Tail", "Quick Attack"},
+			}},
+		},
+		{
+			name: "whitespace and ordering",
+			input: `
+   Charizard   (m)@Charizardite X
+  - Flare Blitz
+ability:   Blaze
+  Adamant Nature
+evs: 252 atk/4 hp /252 Spe
+
+`,
+			want: []showdown.Set{{
+				Species: "Charizard",
+				Gender:  "M",
+				Item:    "Charizardite X",
+				Ability: "Blaze",
+				EVs: showdown.Spread{
+					showdown.StatHP:     4,
+					showdown.StatAttack: 252,
+					showdown.StatSpeed:  252,
+				},
+				Nature: "Adamant",
+				Moves:  []string{"Flare Blitz"},
+			}},
+		},
+		{
+			name:  "stat aliases",
+			input: "Gengar\nEVs: 4 HP / 252 Sp. Atk / 252 Speed\nIVs: 0 Attack / 30 Sp. Def",
+			want: []showdown.Set{{
+				Species: "Gengar",
+				EVs: showdown.Spread{
+					showdown.StatHP:            4,
+					showdown.StatSpecialAttack: 252,
+					showdown.StatSpeed:         252,
+				},
+				IVs: showdown.Spread{
+					showdown.StatAttack:         0,
+					showdown.StatSpecialDefense: 30,
+				},
+			}},
+		},
+		{
+			name:  "nickname with parentheses",
+			input: "Mr. (Mime) (Mr. Mime)",
+			want:  []showdown.Set{{Nickname: "Mr. (Mime)", Species: "Mr. Mime"}},
+		},
+		{
+			name:  "unknown lines",
+			input: "Bulbasaur\nTera Type: Grass\nHappiness: 0\nsomething else\n- Vine Whip",
+			want:  []showdown.Set{{Species: "Bulbasaur", Moves: []string{"Vine Whip"}}},
+		},
+		{
+			name: "team with headers",
+			input: `=== [gen9ou] Team ===
+
+Charmander @ Life Orb
+- Ember
+
+Squirtle
+- Tackle
+=== [gen9ou] Other ===
+Gengar`,
+			want: []showdown.Set{
+				{Species: "Charmander", Item: "Life Orb", Moves: []string{"Ember"}},
+				{Species: "Squirtle", Moves: []string{"Tackle"}},
+				{Species: "Gengar"},
+			},
+		},
+		{
+			name:  "windows line endings",
+			input: "Charmander\r\n- Ember\r\n\r\nSquirtle\r\n",
+			want: []showdown.Set{
+				{Species: "Charmander", Moves: []string{"Ember"}},
+				{Species: "Squirtle"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sets, err := showdown.Parse(strings.NewReader(test.input))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(sets, test.want) {
+				t.Errorf("Parse = %#v, want %#v", sets, test.want)
+			}
+		})
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "only whitespace", input: "  \n\t\n"},
+		{name: "only team header", input: "=== [gen9ou] Team ==="},
+		{name: "missing species", input: "@ Leftovers"},
+		{name: "missing nickname species", input: "Sparky () @ Light Ball"},
+		{name: "bad level", input: "Pikachu\nLevel: fifty"},
+		{name: "bad EV value", input: "Pikachu\nEVs: lots Atk"},
+		{name: "EV without stat", input: "Pikachu\nEVs: 252"},
+		{name: "unknown EV stat", input: "Pikachu\nEVs: 252 Luck"},
+		{name: "unknown IV stat", input: "Pikachu\nIVs: 0 Charm / 31 HP"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sets, err := showdown.Parse(strings.NewReader(test.input))
+			if !errors.Is(err, showdown.ErrParse) {
+				t.Errorf("Parse = %#v, %v, want %v", sets, err, showdown.ErrParse)
+			}
+		})
+	}
+}
+
+func TestParseSetRoundTrip(t *testing.T) {
+	want := showdown.Set{
+		Nickname: "Sparky",
+		Species:  "Pikachu",
+		Gender:   "F",
+		Item:     "Light Ball",
+		Ability:  "Static",
+		Level:    50,
+		Shiny:    true,
+		EVs:      showdown.Spread{showdown.StatAttack: 252, showdown.StatSpeed: 252},
+		IVs:      showdown.Spread{showdown.StatHP: 0},
+		Nature:   "Jolly",
+		Moves:    []string{"Volt Tackle", "Iron Tail"},
+	}
+
+	set, err := showdown.ParseSet(want.String())
+	if err != nil {
+		t.Fatalf("ParseSet(%q): %v", want.String(), err)
+	}
+	if !reflect.DeepEqual(*set, want) {
+		t.Errorf("ParseSet(%q) = %#v, want %#v", want.String(), *set, want)
+	}
+}
@@ -0,0 +1,108 @@
+package showdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Stat string
+
+const (
+	StatHP             Stat = "HP"
+	StatAttack         Stat = "Atk"
+	StatDefense        Stat = "Def"
+	StatSpecialAttack  Stat = "SpA"
+	StatSpecialDefense Stat = "SpD"
+	StatSpeed          Stat = "Spe"
+)
+
+var AllStats = []Stat{
+	StatHP,
+	StatAttack,
+	StatDefense,
+	StatSpecialAttack,
+	StatSpecialDefense,
+	StatSpeed,
+}
+
+type Spread map[Stat]int
+
+func (spread Spread) format(skip int) string {
+	values := make([]string, 0, len(AllStats))
+	for _, stat := range AllStats {
+		value, ok := spread[stat]
+		if !ok || value == skip {
+			continue
+		}
+		values = append(values, fmt.Sprintf("%d %s", value, stat))
+	}
+
+	return strings.Join(values, " / ")
+}
+
+const (
+	DefaultLevel = 100
+	DefaultEV    = 0
+	DefaultIV    = 31
+)
+
+type Set struct {
+	Nickname string
+	Species  string
+	Gender   string
+	Item     string
+	Ability  string
+	Level    int
+	Shiny    bool
+	EVs      Spread
+	IVs      Spread
+	Nature   string
+	Moves    []string
+}
+
+func (set Set) String() string {
+	var b strings.Builder
+
+	if set.Nickname != "" && set.Nickname != set.Species {
+		fmt.Fprintf(&b, "%s (%s)", set.Nickname, set.Species)
+	} else {
+		b.WriteString(set.Species)
+	}
+	if set.Gender != "" {
+		fmt.Fprintf(&b, " (%s)", set.Gender)
+	}
+	if set.Item != "" {
+		fmt.Fprintf(&b, " @ %s", set.Item)
+	}
+	b.WriteString("\n")
+
+	if set.Ability != "" {
+		fmt.Fprintf(&b, "Ability: %s\n", set.Ability)
+	}
+
+	if set.Level != 0 && set.Level != DefaultLevel {
+		fmt.Fprintf(&b, "Level: %d\n", set.Level)
+	}
+
+	if set.Shiny {
+		b.WriteString("Shiny: Yes\n")
+	}
+
+	if evs := set.EVs.format(DefaultEV); evs != "" {
+		fmt.Fprintf(&b, "EVs: %s\n", evs)
+	}
+
+	if set.Nature != "" {
+		fmt.Fprintf(&b, "%s Nature\n", set.Nature)
+	}
+
+	if ivs := set.IVs.format(DefaultIV); ivs != "" {
+		fmt.Fprintf(&b, "IVs: %s\n", ivs)
+	}
+
+	for _, move := range set.Moves {
+		fmt.Fprintf(&b, "- %s\n", move)
+	}
+
+	return b.String()
+}
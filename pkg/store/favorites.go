@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MaxFavorites is the most pokemon a user can favorite, which keeps favorites
+// within discord's limit of 25 autocomplete choices.
+const MaxFavorites = 25
+
+var ErrTooManyFavorites = errors.New("too many favorites")
+
+// AddFavoritePokemon favorites a species for a user, returning false if it was
+// already a favorite.
+func (store *UserStore) AddFavoritePokemon(ctx context.Context, userID string, speciesID int) (bool, error) {
+	tx, err := store.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error while starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	err = tx.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT COUNT(*)
+		FROM favorite_pokemon
+		WHERE user_id = ?
+	`, userID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("error while counting favorites: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx,
+		/* sql */ `
+		INSERT OR IGNORE INTO favorite_pokemon (user_id, species_id)
+		VALUES (?, ?)
+	`, userID, speciesID)
+	if err != nil {
+		return false, fmt.Errorf("error while adding favorite: %w", err)
+	}
+	added, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error while checking whether favorite was added: %w", err)
+	}
+	if added > 0 && count >= MaxFavorites {
+		return false, ErrTooManyFavorites
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("error while committing favorite: %w", err)
+	}
+
+	return added > 0, nil
+}
+
+// RemoveFavoritePokemon removes a species from a user's favorites, returning
+// false if it was not a favorite.
+func (store *UserStore) RemoveFavoritePokemon(ctx context.Context, userID string, speciesID int) (bool, error) {
+	res, err := store.db.ExecContext(ctx,
+		/* sql */ `
+		DELETE FROM favorite_pokemon
+		WHERE user_id = ? AND species_id = ?
+	`, userID, speciesID)
+	if err != nil {
+		return false, fmt.Errorf("error while removing favorite: %w", err)
+	}
+
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error while checking whether favorite was removed: %w", err)
+	}
+
+	return removed > 0, nil
+}
+
+// FavoritePokemon returns the species IDs a user has favorited, in the order
+// they were added.
+func (store *UserStore) FavoritePokemon(ctx context.Context, userID string) ([]int, error) {
+	var ids []int
+	err := store.db.SelectContext(ctx, &ids,
+		/* sql */ `
+		SELECT species_id
+		FROM favorite_pokemon
+		WHERE user_id = ?
+		ORDER BY created_at ASC, rowid ASC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting favorites: %w", err)
+	}
+
+	return ids, nil
+}
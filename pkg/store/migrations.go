@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// migrations are applied in order, each in its own transaction. The schema
+// version is tracked in sqlite's user_version, which is the number of
+// migrations applied. Never edit or reorder a migration once released; append a
+// new one instead.
+var migrations = []string{
+	/* sql */ `
+	CREATE TABLE settings (
+		scope_id TEXT PRIMARY KEY,
+		language TEXT,
+		version TEXT
+	);
+
+	CREATE TABLE favorite_pokemon (
+		user_id TEXT NOT NULL,
+		species_id INTEGER NOT NULL,
+		created_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now')),
+		PRIMARY KEY (user_id, species_id)
+	);
+
+	CREATE TABLE teams (
+		id INTEGER PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		showdown TEXT NOT NULL,
+		created_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now')),
+		updated_at INTEGER NOT NULL DEFAULT (strftime('%s', 'now')),
+		UNIQUE (user_id, name)
+	);
+	`,
+}
+
+// migrate applies any migrations newer than the database's schema version.
+func (store *UserStore) migrate(ctx context.Context) error {
+	var version int
+	err := store.db.QueryRowxContext(ctx /* sql */, `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		return fmt.Errorf("error while reading schema version: %w", err)
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("schema version %d is newer than the latest known version %d: %w",
+			version,
+			len(migrations),
+			ErrUnknownSchema,
+		)
+	}
+
+	for i := version; i < len(migrations); i++ {
+		err := store.applyMigration(ctx, i+1, migrations[i])
+		if err != nil {
+			return fmt.Errorf("error while applying migration %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (store *UserStore) applyMigration(ctx context.Context, version int, migration string) error {
+	tx, err := store.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error while starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, migration)
+	if err != nil {
+		return err
+	}
+
+	// pragmas cannot take bound parameters
+	_, err = tx.ExecContext(ctx, fmt.Sprintf( /* sql */ `PRAGMA user_version = %d`, version))
+	if err != nil {
+		return fmt.Errorf("error while updating schema version: %w", err)
+	}
+
+	return tx.Commit()
+}
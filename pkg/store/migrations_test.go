@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func schemaVersion(t *testing.T, db *sqlx.DB) int {
+	t.Helper()
+
+	var version int
+	err := db.QueryRowx( /* sql */ `PRAGMA user_version`).Scan(&version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return version
+}
+
+func TestMigrateFresh(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := Open(ctx, filepath.Join(t.TempDir(), "user.sqlite3"))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer store.Close()
+
+	if version := schemaVersion(t, store.db); version != len(migrations) {
+		t.Errorf("schema version = %d, want %d", version, len(migrations))
+	}
+	for _, table := range []string{"settings", "favorite_pokemon", "teams"} {
+		var count int
+		err := store.db.QueryRowx( /* sql */ `SELECT COUNT(*) FROM ` + table).Scan(&count)
+		if err != nil {
+			t.Errorf("table %s is not usable: %v", table, err)
+		}
+	}
+}
+
+func TestMigrateReopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "user.sqlite3")
+
+	store, err := Open(ctx, path)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	language := "en"
+	err = store.SaveSettings(ctx, "guild", Settings{Language: &language})
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	store, err = Open(ctx, path)
+	if err != nil {
+		t.Fatalf("Open at the current version: %v", err)
+	}
+	defer store.Close()
+
+	if version := schemaVersion(t, store.db); version != len(migrations) {
+		t.Errorf("schema version = %d, want %d", version, len(migrations))
+	}
+	settings, err := store.Settings(ctx, "guild")
+	if err != nil {
+		t.Fatalf("Settings after reopening: %v", err)
+	}
+	if settings.Language == nil || *settings.Language != language {
+		t.Errorf("language after reopening = %v, want %q", settings.Language, language)
+	}
+}
+
+func TestMigrateNewerSchema(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "user.sqlite3")
+
+	db, err := sqlx.Open("sqlite3", fmt.Sprintf("file:%s?mode=rwc", path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(fmt.Sprintf( /* sql */ `PRAGMA user_version = %d`, len(migrations)+1))
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Open(ctx, path)
+	if err == nil {
+		store.Close()
+		t.Fatal("Open of a newer schema succeeded, want error")
+	}
+	if !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("Open of a newer schema: got error %v, want %v", err, ErrUnknownSchema)
+	}
+}
@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// Settings are the language and version used in a scope, which is a guild, or
+// a user outside of guilds.
+type Settings struct {
+	Language *string `db:"language"`
+	Version  *string `db:"version"`
+}
+
+// SaveSettings saves the settings for a scope, replacing any saved before.
+func (store *UserStore) SaveSettings(ctx context.Context, scopeID string, settings Settings) error {
+	_, err := store.db.ExecContext(ctx,
+		/* sql */ `
+		INSERT INTO settings (scope_id, language, version)
+		VALUES (?, ?, ?)
+		ON CONFLICT (scope_id) DO UPDATE
+		SET language = excluded.language, version = excluded.version
+	`, scopeID, settings.Language, settings.Version)
+	if err != nil {
+		return fmt.Errorf("error while saving settings: %w", err)
+	}
+
+	return nil
+}
+
+// Settings returns the settings saved for a scope, or an error wrapping
+// sql.ErrNoRows if there are none.
+func (store *UserStore) Settings(ctx context.Context, scopeID string) (*Settings, error) {
+	var settings Settings
+	err := store.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT language, version
+		FROM settings
+		WHERE scope_id = ?
+	`, scopeID).StructScan(&settings)
+	if err != nil {
+		return nil, fmt.Errorf("settings for %q not found: %w", scopeID, err)
+	}
+
+	return &settings, nil
+}
@@ -0,0 +1,47 @@
+// Package store holds state written by users, such as preferences, favorites and
+// saved teams. It is kept in its own database so the dex database can stay
+// read-only.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type UserStore struct {
+	db *sqlx.DB
+}
+
+var ErrUnknownSchema = errors.New("unknown user database schema")
+
+// Open opens the user database at path, creating it if needed, and migrates it
+// to the latest schema.
+func Open(ctx context.Context, path string) (*UserStore, error) {
+	db, err := sqlx.Open("sqlite3", fmt.Sprintf("file:%s?mode=rwc&_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL", path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open user database: %w", err)
+	}
+	// sqlite allows one writer at a time
+	db.SetMaxOpenConns(1)
+
+	store := &UserStore{db: db}
+	err = store.migrate(ctx)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error while migrating user database: %w", err)
+	}
+
+	return store, nil
+}
+
+func (store *UserStore) Close() error {
+	return store.db.Close()
+}
+
+func (store *UserStore) Ping(ctx context.Context) error {
+	return store.db.PingContext(ctx)
+}
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxTeams is the most teams a user can save, which keeps their teams within
+// discord's limit of 25 autocomplete choices.
+const MaxTeams = 25
+
+var ErrTooManyTeams = errors.New("too many teams")
+
+// Team is a named team saved by a user, stored as a showdown export.
+type Team struct {
+	Name     string `db:"name"`
+	Showdown string `db:"showdown"`
+}
+
+// SaveTeam saves a team for a user, replacing any team they saved with the same
+// name. It returns whether an existing team was replaced.
+func (store *UserStore) SaveTeam(ctx context.Context, userID string, team Team) (bool, error) {
+	tx, err := store.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error while starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	var exists bool
+	err = tx.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT COUNT(*), COALESCE(SUM(name = ?), 0) > 0
+		FROM teams
+		WHERE user_id = ?
+	`, team.Name, userID).Scan(&count, &exists)
+	if err != nil {
+		return false, fmt.Errorf("error while counting teams: %w", err)
+	}
+	if !exists && count >= MaxTeams {
+		return false, ErrTooManyTeams
+	}
+
+	_, err = tx.ExecContext(ctx,
+		/* sql */ `
+		INSERT INTO teams (user_id, name, showdown)
+		VALUES (?, ?, ?)
+		ON CONFLICT (user_id, name) DO UPDATE
+		SET showdown = excluded.showdown, updated_at = strftime('%s', 'now')
+	`, userID, team.Name, team.Showdown)
+	if err != nil {
+		return false, fmt.Errorf("error while saving team: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("error while committing team: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Team returns the team a user saved with the given name, or an error wrapping
+// sql.ErrNoRows if there is none.
+func (store *UserStore) Team(ctx context.Context, userID string, name string) (*Team, error) {
+	var team Team
+	err := store.db.QueryRowxContext(ctx,
+		/* sql */ `
+		SELECT name, showdown
+		FROM teams
+		WHERE user_id = ? AND name = ?
+	`, userID, name).StructScan(&team)
+	if err != nil {
+		return nil, fmt.Errorf("team %q not found: %w", name, err)
+	}
+
+	return &team, nil
+}
+
+// SearchTeams returns the names of a user's teams starting with the prefix,
+// most recently saved first.
+func (store *UserStore) SearchTeams(ctx context.Context, userID string, prefix string, limit int) ([]string, error) {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+
+	var names []string
+	err := store.db.SelectContext(ctx, &names,
+		/* sql */ `
+		SELECT name
+		FROM teams
+		WHERE user_id = ? AND name LIKE ? ESCAPE '\'
+		ORDER BY updated_at DESC, id DESC
+		LIMIT ?
+	`, userID, escaped+"%", limit)
+	if err != nil {
+		return nil, fmt.Errorf("error while searching teams: %w", err)
+	}
+
+	return names, nil
+}
+
+// DeleteTeam deletes a user's team, returning false if they had no team with
+// that name.
+func (store *UserStore) DeleteTeam(ctx context.Context, userID string, name string) (bool, error) {
+	res, err := store.db.ExecContext(ctx,
+		/* sql */ `
+		DELETE FROM teams
+		WHERE user_id = ? AND name = ?
+	`, userID, name)
+	if err != nil {
+		return false, fmt.Errorf("error while deleting team: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error while checking whether team was deleted: %w", err)
+	}
+
+	return deleted > 0, nil
+}